@@ -1779,12 +1779,15 @@ func (x *fastReflection_ModuleCredential) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Params                           protoreflect.MessageDescriptor
-	fd_Params_max_memo_characters       protoreflect.FieldDescriptor
-	fd_Params_tx_sig_limit              protoreflect.FieldDescriptor
-	fd_Params_tx_size_cost_per_byte     protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_ed25519   protoreflect.FieldDescriptor
-	fd_Params_sig_verify_cost_secp256k1 protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_max_memo_characters            protoreflect.FieldDescriptor
+	fd_Params_tx_sig_limit                   protoreflect.FieldDescriptor
+	fd_Params_tx_size_cost_per_byte          protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_ed25519        protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_secp256k1      protoreflect.FieldDescriptor
+	fd_Params_fee_market                     protoreflect.FieldDescriptor
+	fd_Params_unordered_tx_max_timeout_delta protoreflect.FieldDescriptor
+	fd_Params_sig_verify_cost_webauthn       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1795,6 +1798,9 @@ func init() {
 	fd_Params_tx_size_cost_per_byte = md_Params.Fields().ByName("tx_size_cost_per_byte")
 	fd_Params_sig_verify_cost_ed25519 = md_Params.Fields().ByName("sig_verify_cost_ed25519")
 	fd_Params_sig_verify_cost_secp256k1 = md_Params.Fields().ByName("sig_verify_cost_secp256k1")
+	fd_Params_fee_market = md_Params.Fields().ByName("fee_market")
+	fd_Params_unordered_tx_max_timeout_delta = md_Params.Fields().ByName("unordered_tx_max_timeout_delta")
+	fd_Params_sig_verify_cost_webauthn = md_Params.Fields().ByName("sig_verify_cost_webauthn")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -1892,6 +1898,763 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.FeeMarket != nil {
+		value := protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
+		if !f(fd_Params_fee_market, value) {
+			return
+		}
+	}
+	if x.UnorderedTxMaxTimeoutDelta != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UnorderedTxMaxTimeoutDelta)
+		if !f(fd_Params_unordered_tx_max_timeout_delta, value) {
+			return
+		}
+	}
+	if x.SigVerifyCostWebauthn != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SigVerifyCostWebauthn)
+		if !f(fd_Params_sig_verify_cost_webauthn, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		return x.MaxMemoCharacters != uint64(0)
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		return x.TxSigLimit != uint64(0)
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		return x.TxSizeCostPerByte != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		return x.SigVerifyCostEd25519 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		return x.FeeMarket != nil
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		return x.UnorderedTxMaxTimeoutDelta != uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		return x.SigVerifyCostWebauthn != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		x.MaxMemoCharacters = uint64(0)
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		x.TxSigLimit = uint64(0)
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		x.TxSizeCostPerByte = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		x.SigVerifyCostEd25519 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		x.FeeMarket = nil
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		x.UnorderedTxMaxTimeoutDelta = uint64(0)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		x.SigVerifyCostWebauthn = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		value := x.MaxMemoCharacters
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		value := x.TxSigLimit
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		value := x.TxSizeCostPerByte
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		value := x.SigVerifyCostEd25519
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		value := x.SigVerifyCostSecp256K1
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		value := x.FeeMarket
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		value := x.UnorderedTxMaxTimeoutDelta
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		value := x.SigVerifyCostWebauthn
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		x.MaxMemoCharacters = value.Uint()
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		x.TxSigLimit = value.Uint()
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		x.TxSizeCostPerByte = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		x.SigVerifyCostEd25519 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		x.FeeMarket = value.Message().Interface().(*FeeMarketParams)
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		x.UnorderedTxMaxTimeoutDelta = value.Uint()
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		x.SigVerifyCostWebauthn = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		if x.FeeMarket == nil {
+			x.FeeMarket = new(FeeMarketParams)
+		}
+		return protoreflect.ValueOfMessage(x.FeeMarket.ProtoReflect())
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		panic(fmt.Errorf("field max_memo_characters of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		panic(fmt.Errorf("field tx_sig_limit of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		panic(fmt.Errorf("field tx_size_cost_per_byte of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		panic(fmt.Errorf("field unordered_tx_max_timeout_delta of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		panic(fmt.Errorf("field sig_verify_cost_webauthn of message cosmos.auth.v1beta1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "cosmos.auth.v1beta1.Params.max_memo_characters":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.fee_market":
+		m := new(FeeMarketParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "cosmos.auth.v1beta1.Params.unordered_tx_max_timeout_delta":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.auth.v1beta1.Params.sig_verify_cost_webauthn":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+		}
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.auth.v1beta1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxMemoCharacters != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxMemoCharacters))
+		}
+		if x.TxSigLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxSigLimit))
+		}
+		if x.TxSizeCostPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.TxSizeCostPerByte))
+		}
+		if x.SigVerifyCostEd25519 != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostEd25519))
+		}
+		if x.SigVerifyCostSecp256K1 != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
+		}
+		if x.FeeMarket != nil {
+			l = options.Size(x.FeeMarket)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UnorderedTxMaxTimeoutDelta != 0 {
+			n += 1 + runtime.Sov(uint64(x.UnorderedTxMaxTimeoutDelta))
+		}
+		if x.SigVerifyCostWebauthn != 0 {
+			n += 1 + runtime.Sov(uint64(x.SigVerifyCostWebauthn))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SigVerifyCostWebauthn != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostWebauthn))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.UnorderedTxMaxTimeoutDelta != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UnorderedTxMaxTimeoutDelta))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FeeMarket != nil {
+			encoded, err := options.Marshal(x.FeeMarket)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.SigVerifyCostSecp256K1 != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SigVerifyCostEd25519 != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostEd25519))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TxSizeCostPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSizeCostPerByte))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.TxSigLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSigLimit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MaxMemoCharacters != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMemoCharacters))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+				}
+				x.MaxMemoCharacters = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxMemoCharacters |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+				}
+				x.TxSigLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxSigLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+				}
+				x.TxSizeCostPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TxSizeCostPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostEd25519", wireType)
+				}
+				x.SigVerifyCostEd25519 = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostEd25519 |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256K1", wireType)
+				}
+				x.SigVerifyCostSecp256K1 = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostSecp256K1 |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.FeeMarket == nil {
+					x.FeeMarket = &FeeMarketParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeMarket); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnorderedTxMaxTimeoutDelta", wireType)
+				}
+				x.UnorderedTxMaxTimeoutDelta = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UnorderedTxMaxTimeoutDelta |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostWebauthn", wireType)
+				}
+				x.SigVerifyCostWebauthn = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SigVerifyCostWebauthn |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeMarketParams                             protoreflect.MessageDescriptor
+	fd_FeeMarketParams_denom                       protoreflect.FieldDescriptor
+	fd_FeeMarketParams_min_base_fee                protoreflect.FieldDescriptor
+	fd_FeeMarketParams_target_block_gas            protoreflect.FieldDescriptor
+	fd_FeeMarketParams_base_fee_change_denominator protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_cosmos_auth_v1beta1_auth_proto_init()
+	md_FeeMarketParams = File_cosmos_auth_v1beta1_auth_proto.Messages().ByName("FeeMarketParams")
+	fd_FeeMarketParams_denom = md_FeeMarketParams.Fields().ByName("denom")
+	fd_FeeMarketParams_min_base_fee = md_FeeMarketParams.Fields().ByName("min_base_fee")
+	fd_FeeMarketParams_target_block_gas = md_FeeMarketParams.Fields().ByName("target_block_gas")
+	fd_FeeMarketParams_base_fee_change_denominator = md_FeeMarketParams.Fields().ByName("base_fee_change_denominator")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeMarketParams)(nil)
+
+type fastReflection_FeeMarketParams FeeMarketParams
+
+func (x *FeeMarketParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(x)
+}
+
+func (x *FeeMarketParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_cosmos_auth_v1beta1_auth_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeMarketParams_messageType fastReflection_FeeMarketParams_messageType
+var _ protoreflect.MessageType = fastReflection_FeeMarketParams_messageType{}
+
+type fastReflection_FeeMarketParams_messageType struct{}
+
+func (x fastReflection_FeeMarketParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeMarketParams)(nil)
+}
+func (x fastReflection_FeeMarketParams_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
+}
+func (x fastReflection_FeeMarketParams_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeMarketParams) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeMarketParams
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeMarketParams) Type() protoreflect.MessageType {
+	return _fastReflection_FeeMarketParams_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeMarketParams) New() protoreflect.Message {
+	return new(fastReflection_FeeMarketParams)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeMarketParams) Interface() protoreflect.ProtoMessage {
+	return (*FeeMarketParams)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeMarketParams) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FeeMarketParams_denom, value) {
+			return
+		}
+	}
+	if x.MinBaseFee != "" {
+		value := protoreflect.ValueOfString(x.MinBaseFee)
+		if !f(fd_FeeMarketParams_min_base_fee, value) {
+			return
+		}
+	}
+	if x.TargetBlockGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TargetBlockGas)
+		if !f(fd_FeeMarketParams_target_block_gas, value) {
+			return
+		}
+	}
+	if x.BaseFeeChangeDenominator != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseFeeChangeDenominator)
+		if !f(fd_FeeMarketParams_base_fee_change_denominator, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1905,23 +2668,21 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_FeeMarketParams) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		return x.MaxMemoCharacters != uint64(0)
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		return x.TxSigLimit != uint64(0)
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		return x.TxSizeCostPerByte != uint64(0)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
-		return x.SigVerifyCostEd25519 != uint64(0)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
-		return x.SigVerifyCostSecp256K1 != uint64(0)
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		return x.Denom != ""
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		return x.MinBaseFee != ""
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
+		return x.TargetBlockGas != uint64(0)
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		return x.BaseFeeChangeDenominator != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1931,23 +2692,21 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_FeeMarketParams) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		x.MaxMemoCharacters = uint64(0)
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		x.TxSigLimit = uint64(0)
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		x.TxSizeCostPerByte = uint64(0)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
-		x.SigVerifyCostEd25519 = uint64(0)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
-		x.SigVerifyCostSecp256K1 = uint64(0)
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		x.Denom = ""
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = ""
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
+		x.TargetBlockGas = uint64(0)
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1957,28 +2716,25 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		value := x.MaxMemoCharacters
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		value := x.TxSigLimit
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		value := x.TxSizeCostPerByte
-		return protoreflect.ValueOfUint64(value)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
-		value := x.SigVerifyCostEd25519
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		value := x.MinBaseFee
+		return protoreflect.ValueOfString(value)
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
+		value := x.TargetBlockGas
 		return protoreflect.ValueOfUint64(value)
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
-		value := x.SigVerifyCostSecp256K1
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		value := x.BaseFeeChangeDenominator
 		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1992,23 +2748,21 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_FeeMarketParams) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		x.MaxMemoCharacters = value.Uint()
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		x.TxSigLimit = value.Uint()
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		x.TxSizeCostPerByte = value.Uint()
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
-		x.SigVerifyCostEd25519 = value.Uint()
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
-		x.SigVerifyCostSecp256K1 = value.Uint()
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		x.Denom = value.Interface().(string)
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		x.MinBaseFee = value.Interface().(string)
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
+		x.TargetBlockGas = value.Uint()
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		x.BaseFeeChangeDenominator = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2022,56 +2776,52 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		panic(fmt.Errorf("field max_memo_characters of message cosmos.auth.v1beta1.Params is not mutable"))
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		panic(fmt.Errorf("field tx_sig_limit of message cosmos.auth.v1beta1.Params is not mutable"))
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		panic(fmt.Errorf("field tx_size_cost_per_byte of message cosmos.auth.v1beta1.Params is not mutable"))
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
-		panic(fmt.Errorf("field sig_verify_cost_ed25519 of message cosmos.auth.v1beta1.Params is not mutable"))
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
-		panic(fmt.Errorf("field sig_verify_cost_secp256k1 of message cosmos.auth.v1beta1.Params is not mutable"))
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		panic(fmt.Errorf("field denom of message cosmos.auth.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		panic(fmt.Errorf("field min_base_fee of message cosmos.auth.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
+		panic(fmt.Errorf("field target_block_gas of message cosmos.auth.v1beta1.FeeMarketParams is not mutable"))
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
+		panic(fmt.Errorf("field base_fee_change_denominator of message cosmos.auth.v1beta1.FeeMarketParams is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_FeeMarketParams) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "cosmos.auth.v1beta1.Params.max_memo_characters":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.auth.v1beta1.Params.tx_sig_limit":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.auth.v1beta1.Params.tx_size_cost_per_byte":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_ed25519":
+	case "cosmos.auth.v1beta1.FeeMarketParams.denom":
+		return protoreflect.ValueOfString("")
+	case "cosmos.auth.v1beta1.FeeMarketParams.min_base_fee":
+		return protoreflect.ValueOfString("")
+	case "cosmos.auth.v1beta1.FeeMarketParams.target_block_gas":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "cosmos.auth.v1beta1.Params.sig_verify_cost_secp256k1":
+	case "cosmos.auth.v1beta1.FeeMarketParams.base_fee_change_denominator":
 		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.auth.v1beta1.FeeMarketParams"))
 		}
-		panic(fmt.Errorf("message cosmos.auth.v1beta1.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message cosmos.auth.v1beta1.FeeMarketParams does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_FeeMarketParams) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in cosmos.auth.v1beta1.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in cosmos.auth.v1beta1.FeeMarketParams", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2079,7 +2829,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_FeeMarketParams) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2090,7 +2840,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_FeeMarketParams) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2102,7 +2852,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_FeeMarketParams) IsValid() bool {
 	return x != nil
 }
 
@@ -2112,9 +2862,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_FeeMarketParams) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2126,20 +2876,19 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.MaxMemoCharacters != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxMemoCharacters))
-		}
-		if x.TxSigLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.TxSigLimit))
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.TxSizeCostPerByte != 0 {
-			n += 1 + runtime.Sov(uint64(x.TxSizeCostPerByte))
+		l = len(x.MinBaseFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SigVerifyCostEd25519 != 0 {
-			n += 1 + runtime.Sov(uint64(x.SigVerifyCostEd25519))
+		if x.TargetBlockGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.TargetBlockGas))
 		}
-		if x.SigVerifyCostSecp256K1 != 0 {
-			n += 1 + runtime.Sov(uint64(x.SigVerifyCostSecp256K1))
+		if x.BaseFeeChangeDenominator != 0 {
+			n += 1 + runtime.Sov(uint64(x.BaseFeeChangeDenominator))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2151,7 +2900,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2170,30 +2919,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.SigVerifyCostSecp256K1 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostSecp256K1))
-			i--
-			dAtA[i] = 0x28
-		}
-		if x.SigVerifyCostEd25519 != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SigVerifyCostEd25519))
+		if x.BaseFeeChangeDenominator != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseFeeChangeDenominator))
 			i--
 			dAtA[i] = 0x20
 		}
-		if x.TxSizeCostPerByte != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSizeCostPerByte))
+		if x.TargetBlockGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TargetBlockGas))
 			i--
 			dAtA[i] = 0x18
 		}
-		if x.TxSigLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.TxSigLimit))
+		if len(x.MinBaseFee) > 0 {
+			i -= len(x.MinBaseFee)
+			copy(dAtA[i:], x.MinBaseFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinBaseFee)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if x.MaxMemoCharacters != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxMemoCharacters))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2206,7 +2954,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*FeeMarketParams)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2238,17 +2986,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxMemoCharacters", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
-				x.MaxMemoCharacters = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2258,16 +3006,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MaxMemoCharacters |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSigLimit", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
 				}
-				x.TxSigLimit = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2277,35 +3038,29 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.TxSigLimit |= uint64(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxSizeCostPerByte", wireType)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				x.TxSizeCostPerByte = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.TxSizeCostPerByte |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-			case 4:
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinBaseFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostEd25519", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
 				}
-				x.SigVerifyCostEd25519 = 0
+				x.TargetBlockGas = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2315,16 +3070,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SigVerifyCostEd25519 |= uint64(b&0x7F) << shift
+					x.TargetBlockGas |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 5:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostSecp256K1", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
 				}
-				x.SigVerifyCostSecp256K1 = 0
+				x.BaseFeeChangeDenominator = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2334,7 +3089,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SigVerifyCostSecp256K1 |= uint64(b&0x7F) << shift
+					x.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -2561,6 +3316,19 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostEd25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256K1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// fee_market defines the parameters of the consensus-level base fee charged
+	// per unit of gas. The base fee is not enforced if unset.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,6,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// unordered_tx_max_timeout_delta defines the maximum number of blocks ahead
+	// of the current block height the timeout height of an unordered tx may be
+	// set to, which bounds how long its hash is kept to prevent replays.
+	// Unordered txs are rejected if zero.
+	UnorderedTxMaxTimeoutDelta uint64 `protobuf:"varint,7,opt,name=unordered_tx_max_timeout_delta,json=unorderedTxMaxTimeoutDelta,proto3" json:"unordered_tx_max_timeout_delta,omitempty"`
+	// sig_verify_cost_webauthn defines the gas charged to verify the secp256r1
+	// signature of a WebAuthn assertion, including decoding the assertion and
+	// hashing its client and authenticator data. WebAuthn public keys are
+	// rejected if zero.
+	SigVerifyCostWebauthn uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_webauthn,json=sigVerifyCostWebauthn,proto3" json:"sig_verify_cost_webauthn,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -2618,6 +3386,97 @@ func (x *Params) GetSigVerifyCostSecp256K1() uint64 {
 	return 0
 }
 
+func (x *Params) GetFeeMarket() *FeeMarketParams {
+	if x != nil {
+		return x.FeeMarket
+	}
+	return nil
+}
+
+func (x *Params) GetUnorderedTxMaxTimeoutDelta() uint64 {
+	if x != nil {
+		return x.UnorderedTxMaxTimeoutDelta
+	}
+	return 0
+}
+
+func (x *Params) GetSigVerifyCostWebauthn() uint64 {
+	if x != nil {
+		return x.SigVerifyCostWebauthn
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the EIP-1559 style base fee, which
+// every tx must pay per unit of gas and which adjusts at the end of each block
+// based on the gas used by the block compared to the target block gas.
+type FeeMarketParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom defines the denomination the base fee is charged in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee defines the lowest base fee the base fee can decrease to,
+	// which is also the initial base fee.
+	MinBaseFee string `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3" json:"min_base_fee,omitempty"`
+	// target_block_gas defines the gas used by a block for which the base fee
+	// remains unchanged. The base fee increases if a block uses more gas and
+	// decreases if it uses less.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee from one
+	// block to the next to 1/base_fee_change_denominator of the base fee.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,4,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+}
+
+func (x *FeeMarketParams) Reset() {
+	*x = FeeMarketParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cosmos_auth_v1beta1_auth_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeMarketParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeMarketParams) ProtoMessage() {}
+
+// Deprecated: Use FeeMarketParams.ProtoReflect.Descriptor instead.
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return file_cosmos_auth_v1beta1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FeeMarketParams) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FeeMarketParams) GetMinBaseFee() string {
+	if x != nil {
+		return x.MinBaseFee
+	}
+	return ""
+}
+
+func (x *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if x != nil {
+		return x.TargetBlockGas
+	}
+	return 0
+}
+
+func (x *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if x != nil {
+		return x.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
 var File_cosmos_auth_v1beta1_auth_proto protoreflect.FileDescriptor
 
 var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
@@ -2671,8 +3530,8 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x73, 0x3a, 0x26, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xd7,
-	0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xb4,
+	0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78,
 	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x43,
 	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x78, 0x5f,
@@ -2691,9 +3550,38 @@ var file_cosmos_auth_v1beta1_auth_proto_rawDesc = []byte{
 	0x04, 0x42, 0x1a, 0xe2, 0xde, 0x1f, 0x16, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
 	0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70, 0x32, 0x35, 0x36, 0x6b, 0x31, 0x52, 0x16, 0x73,
 	0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x63, 0x70,
-	0x32, 0x35, 0x36, 0x6b, 0x31, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74,
-	0x68, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
+	0x32, 0x35, 0x36, 0x6b, 0x31, 0x12, 0x43, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x46, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
+	0x09, 0x66, 0x65, 0x65, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x42, 0x0a, 0x1e, 0x75, 0x6e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x1a, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x54, 0x78, 0x4d,
+	0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x52,
+	0x0a, 0x18, 0x73, 0x69, 0x67, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x5f, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x19, 0xe2, 0xde, 0x1f, 0x15, 0x53, 0x69, 0x67, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x73, 0x74, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x52, 0x15, 0x73, 0x69, 0x67,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x73, 0x74, 0x57, 0x65, 0x62, 0x61, 0x75, 0x74,
+	0x68, 0x6e, 0x3a, 0x21, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x18, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x78, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65, 0x4d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x58, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d,
+	0x69, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x47, 0x61, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d,
 	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x62,
 	0x65, 0x74, 0x61, 0x31, 0x42, 0x09, 0x41, 0x75, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
 	0x01, 0x5a, 0x30, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
@@ -2721,22 +3609,24 @@ func file_cosmos_auth_v1beta1_auth_proto_rawDescGZIP() []byte {
 	return file_cosmos_auth_v1beta1_auth_proto_rawDescData
 }
 
-var file_cosmos_auth_v1beta1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_cosmos_auth_v1beta1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_cosmos_auth_v1beta1_auth_proto_goTypes = []interface{}{
 	(*BaseAccount)(nil),      // 0: cosmos.auth.v1beta1.BaseAccount
 	(*ModuleAccount)(nil),    // 1: cosmos.auth.v1beta1.ModuleAccount
 	(*ModuleCredential)(nil), // 2: cosmos.auth.v1beta1.ModuleCredential
 	(*Params)(nil),           // 3: cosmos.auth.v1beta1.Params
-	(*anypb.Any)(nil),        // 4: google.protobuf.Any
+	(*FeeMarketParams)(nil),  // 4: cosmos.auth.v1beta1.FeeMarketParams
+	(*anypb.Any)(nil),        // 5: google.protobuf.Any
 }
 var file_cosmos_auth_v1beta1_auth_proto_depIdxs = []int32{
-	4, // 0: cosmos.auth.v1beta1.BaseAccount.pub_key:type_name -> google.protobuf.Any
+	5, // 0: cosmos.auth.v1beta1.BaseAccount.pub_key:type_name -> google.protobuf.Any
 	0, // 1: cosmos.auth.v1beta1.ModuleAccount.base_account:type_name -> cosmos.auth.v1beta1.BaseAccount
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: cosmos.auth.v1beta1.Params.fee_market:type_name -> cosmos.auth.v1beta1.FeeMarketParams
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_cosmos_auth_v1beta1_auth_proto_init() }
@@ -2793,6 +3683,18 @@ func file_cosmos_auth_v1beta1_auth_proto_init() {
 				return nil
 			}
 		}
+		file_cosmos_auth_v1beta1_auth_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeMarketParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2800,7 +3702,7 @@ func file_cosmos_auth_v1beta1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cosmos_auth_v1beta1_auth_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
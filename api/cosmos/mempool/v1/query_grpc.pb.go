@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: cosmos/mempool/v1/query.proto
+
+package mempoolv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_PendingTxs_FullMethodName  = "/cosmos.mempool.v1.Query/PendingTxs"
+	Query_WatchEvents_FullMethodName = "/cosmos.mempool.v1.Query/WatchEvents"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingTxs queries the txs held by the mempool, optionally filtered by
+	// sender, ordered by sender and nonce.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// WatchEvents streams the insertion, removal and eviction events of the
+	// mempool, optionally filtered by sender. It is only available over gRPC.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Query_WatchEventsClient, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingTxs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Query_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_WatchEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type queryWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// PendingTxs queries the txs held by the mempool, optionally filtered by
+	// sender, ordered by sender and nonce.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// WatchEvents streams the insertion, removal and eviction events of the
+	// mempool, optionally filtered by sender. It is only available over gRPC.
+	WatchEvents(*WatchEventsRequest, Query_WatchEventsServer) error
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (UnimplementedQueryServer) WatchEvents(*WatchEventsRequest, Query_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingTxs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchEvents(m, &queryWatchEventsServer{stream})
+}
+
+type Query_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type queryWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Query_PendingTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Query_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/mempool/v1/query.proto",
+}
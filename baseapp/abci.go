@@ -793,39 +793,9 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 
 	events = append(events, beginBlock.Events...)
 
-	// Iterate over all raw transactions in the proposal and attempt to execute
-	// them, gathering the execution results.
-	//
-	// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
-	// vote extensions, so skip those.
-	txResults := make([]*abci.ExecTxResult, 0, len(req.Txs))
-	for _, rawTx := range req.Txs {
-		var response *abci.ExecTxResult
-
-		if _, err := app.txDecoder(rawTx); err == nil {
-			response = app.deliverTx(rawTx)
-		} else {
-			// In the case where a transaction included in a block proposal is malformed,
-			// we still want to return a default response to comet. This is because comet
-			// expects a response for each transaction included in a block proposal.
-			response = sdkerrors.ResponseExecTxResultWithEvents(
-				sdkerrors.ErrTxDecode,
-				0,
-				0,
-				nil,
-				false,
-			)
-		}
-
-		// check after every tx if we should abort
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			// continue
-		}
-
-		txResults = append(txResults, response)
+	txResults, err := app.executeTxs(ctx, req.Txs)
+	if err != nil {
+		return nil, err
 	}
 
 	if app.finalizeBlockState.ms.TracingEnabled() {
@@ -856,6 +826,62 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 	}, nil
 }
 
+// executeTxs executes all raw transactions in the proposal, gathering the
+// execution results. Transactions are executed in parallel using Block-STM if
+// enabled via SetBlockSTMWorkers, and serially otherwise.
+func (app *BaseApp) executeTxs(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	// tracing relies on txs being executed in order
+	if app.blockSTMWorkers > 0 && !app.finalizeBlockState.ms.TracingEnabled() {
+		return app.executeTxsParallel(ctx, txs)
+	}
+
+	return app.executeTxsSerially(ctx, txs)
+}
+
+// executeTxsSerially iterates over the raw transactions and executes them one
+// after the other.
+//
+// NOTE: Not all raw transactions may adhere to the sdk.Tx interface, e.g.
+// vote extensions, so skip those.
+func (app *BaseApp) executeTxsSerially(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	txResults := make([]*abci.ExecTxResult, 0, len(txs))
+	for _, rawTx := range txs {
+		var response *abci.ExecTxResult
+
+		if _, err := app.txDecoder(rawTx); err == nil {
+			response = app.deliverTxReusingOE(rawTx)
+		} else {
+			response = txDecodeErrorResult()
+		}
+
+		// check after every tx if we should abort
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+			// continue
+		}
+
+		txResults = append(txResults, response)
+	}
+
+	return txResults, nil
+}
+
+// txDecodeErrorResult returns the execution result of a malformed transaction.
+// In the case where a transaction included in a block proposal is malformed,
+// we still want to return a default response to comet. This is because comet
+// expects a response for each transaction included in a block proposal.
+func txDecodeErrorResult() *abci.ExecTxResult {
+	return sdkerrors.ResponseExecTxResultWithEvents(
+		sdkerrors.ErrTxDecode,
+		0,
+		0,
+		nil,
+		false,
+	)
+}
+
 // FinalizeBlock will execute the block proposal provided by RequestFinalizeBlock.
 // Specifically, it will execute an application's BeginBlock (if defined), followed
 // by the transactions in the proposal, finally followed by the application's
@@ -866,7 +892,24 @@ func (app *BaseApp) internalFinalizeBlock(ctx context.Context, req *abci.Request
 // skipped. This is to support compatibility with proposers injecting vote
 // extensions into the proposal, which should not themselves be executed in cases
 // where they adhere to the sdk.Tx interface.
-func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (res *abci.ResponseFinalizeBlock, err error) {
+	defer func() {
+		if res == nil {
+			return
+		}
+
+		// call the streaming service hooks with the FinalizeBlock messages
+		for _, abciListener := range app.streamingManager.ABCIListeners {
+			if listenErr := abciListener.ListenFinalizeBlock(app.finalizeBlockState.ctx, *req, *res); listenErr != nil {
+				app.logger.Error("FinalizeBlock listening hook failed", "height", req.Height, "err", listenErr)
+				if app.streamingManager.StopNodeOnErr {
+					res, err = nil, listenErr
+					return
+				}
+			}
+		}
+	}()
+
 	if app.optimisticExec.Initialized() {
 		// check if the hash we got is the same as the one we are executing
 		aborted := app.optimisticExec.AbortIfNeeded(req.Hash)
@@ -887,7 +930,7 @@ func (app *BaseApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.Respons
 	}
 
 	// if no OE is running, just run the block (this is either a block replay or a OE that got aborted)
-	res, err := app.internalFinalizeBlock(context.Background(), req)
+	res, err = app.internalFinalizeBlock(context.Background(), req)
 	if res != nil {
 		res.AppHash = app.workingHash()
 	}
@@ -958,6 +1001,7 @@ func (app *BaseApp) Commit() (*abci.ResponseCommit, error) {
 	app.setState(execModeCheck, header)
 
 	app.finalizeBlockState = nil
+	app.oeTxCache.reset()
 
 	if app.prepareCheckStater != nil {
 		app.prepareCheckStater(app.checkState.ctx)
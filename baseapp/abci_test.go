@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/baseapp/oe"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -623,6 +625,40 @@ func TestABCI_CheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+func TestABCI_CheckTx_RemoveOnRecheck(t *testing.T) {
+	pool := mempool.NewSenderNonceMempool()
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			if ctx.IsReCheckTx() {
+				return ctx, errorsmod.Wrap(sdkerrors.ErrWrongSequence, "recheck failure")
+			}
+
+			return ctx, nil
+		})
+	}
+	suite := NewBaseAppSuite(t, anteOpt, baseapp.SetMempool(pool))
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), NoopCounterServerImpl{})
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	tx := newTxCounter(t, suite.txConfig, 0, 0)
+	txBytes, err := suite.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	r, err := suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes})
+	require.NoError(t, err)
+	require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	require.Equal(t, 1, pool.CountTx())
+
+	r, err = suite.baseApp.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_Recheck})
+	require.NoError(t, err)
+	require.False(t, r.IsOK())
+	require.Equal(t, 0, pool.CountTx())
+}
+
 func TestABCI_FinalizeBlock_DeliverTx(t *testing.T) {
 	anteKey := []byte("ante-key")
 	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
@@ -813,6 +849,82 @@ func TestABCI_Query_SimulateTx(t *testing.T) {
 	}
 }
 
+func TestABCI_SimulateBundle(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *baseapp.BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+	suite := NewBaseAppSuite(t, anteOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &cmtproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	deliverKey := []byte("deliver-key")
+	baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), CounterServerImpl{t, capKey1, deliverKey})
+
+	// every tx expects the counters incremented by the txs preceding it, while
+	// the message of the third tx fails, such that only its ante handler
+	// increments the ante counter
+	txs := []signing.Tx{
+		newTxCounter(t, suite.txConfig, 0, 0),
+		newTxCounter(t, suite.txConfig, 1, 1),
+		setFailOnHandler(t, suite.txConfig, newTxCounter(t, suite.txConfig, 2, 2), true),
+		newTxCounter(t, suite.txConfig, 3, 2),
+	}
+
+	var txsBytes [][]byte
+	for _, tx := range txs {
+		txBytes, err := suite.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txsBytes = append(txsBytes, txBytes)
+	}
+
+	// simulating the bundle twice has the same outcome, as the check state is
+	// not written to
+	var gasUsed []uint64
+	for i := 0; i < 2; i++ {
+		results, err := suite.baseApp.SimulateBundle(txsBytes)
+		require.NoError(t, err)
+		require.Len(t, results, len(txs))
+
+		for j, res := range results {
+			if j == 2 {
+				require.Error(t, res.Err)
+				require.Nil(t, res.Result)
+				continue
+			}
+
+			require.NoError(t, res.Err)
+			require.NotNil(t, res.Result)
+			require.Equal(t, counterEvent("ante_handler", int64(j)).ToABCIEvents()[0], res.Result.Events[0])
+		}
+
+		store := getCheckStateCtx(suite.baseApp).KVStore(capKey1)
+		require.Equal(t, int64(0), getIntFromStore(t, store, anteKey))
+		require.Equal(t, int64(0), getIntFromStore(t, store, deliverKey))
+
+		gasUsed = nil
+		for _, res := range results {
+			require.NotZero(t, res.GasInfo.GasUsed)
+			gasUsed = append(gasUsed, res.GasInfo.GasUsed)
+		}
+	}
+
+	// bundles exceeding the maximum number of txs are rejected, while the txs
+	// run out of gas once the bundle consumed its gas limit
+	baseapp.SetSimulateBundleLimits(3, gasUsed[0]+gasUsed[1]+1)(suite.baseApp)
+
+	_, err = suite.baseApp.SimulateBundle(txsBytes)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	results, err := suite.baseApp.SimulateBundle(txsBytes[:3])
+	require.ErrorIs(t, err, sdkerrors.ErrOutOfGas)
+	require.Len(t, results, 3)
+	require.NoError(t, results[0].Err)
+	require.NoError(t, results[1].Err)
+	require.ErrorIs(t, results[2].Err, sdkerrors.ErrOutOfGas)
+}
+
 func TestABCI_InvalidTransaction(t *testing.T) {
 	anteOpt := func(bapp *baseapp.BaseApp) {
 		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
@@ -2373,3 +2485,173 @@ func TestOptimisticExecution(t *testing.T) {
 
 	require.Equal(t, int64(50), suite.baseApp.LastBlockHeight())
 }
+
+func TestOptimisticExecution_PartialReuse(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000)), nil
+		})
+	}
+
+	newSuite := func(opts ...func(*baseapp.BaseApp)) (*BaseAppSuite, *atomic.Int64) {
+		executed := &atomic.Int64{}
+		suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), countingServerImpl{accumulatorServerImpl{capKey1}, executed})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		// OE is not run for the initial height
+		_, err = suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1})
+		require.NoError(t, err)
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+
+		return suite, executed
+	}
+
+	serial, _ := newSuite()
+	optimistic, executed := newSuite(baseapp.SetOptimisticExecution(oe.WithPartialReuse()))
+
+	newTx := func(counter int64) []byte {
+		txBytes, err := serial.txConfig.TxEncoder()(newTxCounter(t, serial.txConfig, counter, counter))
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	txs := [][]byte{newTx(1), newTx(2), newTx(3), newTx(4), newTx(5)}
+	blockTime := time.Now()
+
+	respProcProp, err := optimistic.baseApp.ProcessProposal(&abci.RequestProcessProposal{
+		Txs:    txs,
+		Height: 2,
+		Time:   blockTime,
+		Hash:   []byte("proposal"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, respProcProp.Status)
+
+	// the final block shares the first 3 txs with the proposal, all txs updating
+	// the same total such that the subsequent txs must be executed again
+	req := &abci.RequestFinalizeBlock{
+		Txs:    [][]byte{txs[0], txs[1], txs[2], newTx(6), txs[3], txs[4]},
+		Height: 2,
+		Time:   blockTime,
+		Hash:   []byte("final"),
+	}
+
+	serialRes, err := serial.baseApp.FinalizeBlock(req)
+	require.NoError(t, err)
+
+	// wait for the OE to execute every tx of the proposal, such that only the
+	// last 3 txs of the final block are executed again
+	require.Eventually(t, func() bool { return executed.Load() == 5 }, time.Second, time.Millisecond)
+
+	optimisticRes, err := optimistic.baseApp.FinalizeBlock(req)
+	require.NoError(t, err)
+	require.Equal(t, int64(8), executed.Load())
+
+	require.Equal(t, serialRes.TxResults, optimisticRes.TxResults)
+	require.Equal(t, serialRes.AppHash, optimisticRes.AppHash)
+}
+
+// countingServerImpl counts the msgs handled by the wrapped server.
+type countingServerImpl struct {
+	baseapptestutil.CounterServer
+	count *atomic.Int64
+}
+
+func (m countingServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	m.count.Add(1)
+	return m.CounterServer.IncrementCounter(ctx, msg)
+}
+
+// accumulatorServerImpl adds the counter of every msg to a total shared by all
+// txs, such that txs conflict with each other, failing msgs whose counter is a
+// multiple of 7.
+type accumulatorServerImpl struct {
+	capKey storetypes.StoreKey
+}
+
+func (m accumulatorServerImpl) IncrementCounter(ctx context.Context, msg *baseapptestutil.MsgCounter) (*baseapptestutil.MsgCreateCounterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := sdkCtx.KVStore(m.capKey)
+
+	if msg.Counter%7 == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "counter %d", msg.Counter)
+	}
+
+	var total int64
+	if bz := store.Get([]byte("total")); bz != nil {
+		total, _ = binary.Varint(bz)
+	}
+
+	total += msg.Counter
+	setIntOnStore(store, []byte("total"), total)
+	setIntOnStore(store, []byte(fmt.Sprintf("counter-%d", msg.Counter)), total)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent("total", sdk.NewAttribute("value", strconv.FormatInt(total, 10))))
+	return &baseapptestutil.MsgCreateCounterResponse{}, nil
+}
+
+func TestABCI_FinalizeBlock_BlockSTM(t *testing.T) {
+	anteOpt := func(bapp *baseapp.BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			return ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000)), nil
+		})
+	}
+
+	newSuite := func(opts ...func(*baseapp.BaseApp)) *BaseAppSuite {
+		suite := NewBaseAppSuite(t, append(opts, anteOpt)...)
+		baseapptestutil.RegisterCounterServer(suite.baseApp.MsgServiceRouter(), accumulatorServerImpl{capKey1})
+
+		_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+			ConsensusParams: &cmtproto.ConsensusParams{},
+		})
+		require.NoError(t, err)
+
+		return suite
+	}
+
+	serial := newSuite()
+	parallel := newSuite(baseapp.SetBlockSTMWorkers(4))
+
+	nBlocks := 3
+	txPerHeight := 20
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		txs := [][]byte{[]byte("invalid tx")}
+		for i := 0; i < txPerHeight; i++ {
+			counter := int64(blockN*txPerHeight + i)
+			tx := newTxCounter(t, serial.txConfig, counter, counter)
+
+			txBytes, err := serial.txConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			txs = append(txs, txBytes)
+		}
+
+		req := &abci.RequestFinalizeBlock{
+			Height: int64(blockN) + 1,
+			Txs:    txs,
+		}
+
+		serialRes, err := serial.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		parallelRes, err := parallel.baseApp.FinalizeBlock(req)
+		require.NoError(t, err)
+
+		require.Len(t, parallelRes.TxResults, len(txs))
+		require.Equal(t, serialRes.TxResults, parallelRes.TxResults)
+		require.Equal(t, serialRes.AppHash, parallelRes.AppHash)
+
+		_, err = serial.baseApp.Commit()
+		require.NoError(t, err)
+
+		_, err = parallel.baseApp.Commit()
+		require.NoError(t, err)
+	}
+}
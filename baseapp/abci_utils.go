@@ -12,9 +12,11 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	protoio "github.com/cosmos/gogoproto/io"
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/hashicorp/go-metrics"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
@@ -146,15 +148,48 @@ type (
 		TxEncode(tx sdk.Tx) ([]byte, error)
 	}
 
+	// ProposalTxContextVerifier defines a ProposalTxVerifier which additionally
+	// returns the context of a tx verified during ProcessProposal once it passed
+	// the AnteHandler. It is implemented by BaseApp.
+	ProposalTxContextVerifier interface {
+		ProposalTxVerifier
+		ProcessProposalVerifyTxContext(txBz []byte) (sdk.Context, sdk.Tx, error)
+	}
+
 	// DefaultProposalHandler defines the default ABCI PrepareProposal and
 	// ProcessProposal handlers.
 	DefaultProposalHandler struct {
-		mempool    mempool.Mempool
-		txVerifier ProposalTxVerifier
-		txSelector TxSelector
+		mempool         mempool.Mempool
+		txVerifier      ProposalTxVerifier
+		txSelector      TxSelector
+		txOrderVerifier TxOrderVerifier
 	}
 )
 
+// Reasons for rejecting a proposal, used to label the rejected proposals metric.
+const (
+	proposalRejectedInvalidTx  = "invalid_tx"
+	proposalRejectedBlockSpace = "block_space"
+	proposalRejectedNoLane     = "no_lane"
+	proposalRejectedLaneOrder  = "lane_order"
+	proposalRejectedTxOrder    = "tx_order"
+)
+
+// proposalRejection defines the error rejecting a proposal along with the
+// reason of the rejection.
+type proposalRejection struct {
+	reason string
+	err    error
+}
+
+func (r proposalRejection) Error() string {
+	return fmt.Sprintf("%s: %s", r.reason, r.err)
+}
+
+func (r proposalRejection) Unwrap() error {
+	return r.err
+}
+
 func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) *DefaultProposalHandler {
 	return &DefaultProposalHandler{
 		mempool:    mp,
@@ -168,6 +203,26 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 	h.txSelector = ts
 }
 
+// SetTxOrderVerifier sets the TxOrderVerifier on the DefaultProposalHandler,
+// such that ProcessProposal rejects proposals whose txs are not ordered as
+// the mempool selects them, e.g. a mempool.PriorityNonceOrderVerifier configured
+// with the TxPriority of a PriorityNonceMempool. By default, the order of txs
+// is not verified.
+//
+// Note, the priority of every tx is derived from the context of the tx once it
+// passed the AnteHandler during ProcessProposal, rather than from the context
+// the tx was inserted into the mempool of the proposer with. The priority must
+// thus not depend on state changing in between in a way that alters the order
+// of txs. In particular, if the fee market of x/auth is enabled, the default
+// TxFeeChecker derives the priority from the tip paid on top of the base fee,
+// which changes every block, e.g. the priority of txs whose tip no longer
+// covers the base fee drops to 0, such that honest proposals may be rejected.
+// In that case, use a TxPriority independent of the base fee, e.g. derived from
+// the gas price of the fee of the tx rather than from the context.
+func (h *DefaultProposalHandler) SetTxOrderVerifier(v TxOrderVerifier) {
+	h.txOrderVerifier = v
+}
+
 // PrepareProposalHandler returns the default implementation for processing an
 // ABCI proposal. The application's mempool is enumerated and all valid
 // transactions are added to the proposal. Transactions are valid if they:
@@ -188,6 +243,10 @@ func (h *DefaultProposalHandler) SetTxSelector(ts TxSelector) {
 // - If no mempool is set or if the mempool is a no-op mempool, the transactions
 // requested from CometBFT will simply be returned, which, by default, are in
 // FIFO order.
+//
+// - If the mempool is a LanedMempool, the transactions of every lane are
+// selected in the order of the lanes, each lane within its share of the block
+// space, in which case the TxSelector is not used.
 func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 		var maxBlockGas uint64
@@ -218,6 +277,10 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 			return &abci.ResponsePrepareProposal{Txs: h.txSelector.SelectedTxs(ctx)}, nil
 		}
 
+		if lanedMempool, ok := asLanedMempool(h.mempool); ok {
+			return h.prepareLanedProposal(ctx, req, lanedMempool)
+		}
+
 		iterator := h.mempool.Select(ctx, req.Txs)
 		for iterator != nil {
 			memTx := iterator.Tx()
@@ -257,6 +320,17 @@ func (h *DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHan
 // DefaultPrepareProposal. It is very important that the same validation logic
 // is used in both steps, and applications must ensure that this is the case in
 // non-default handlers.
+//
+// If the mempool is a LanedMempool, the proposal must additionally be composed
+// of the transactions of every lane in the order of the lanes, each lane within
+// its share of the block space.
+//
+// If a TxOrderVerifier is set, the transactions must additionally be ordered as
+// the mempool selects them, or as every lane selects them in the case of a
+// LanedMempool.
+//
+// Rejected proposals are counted by the process_proposal_rejected metric,
+// labeled by the reason of the rejection.
 func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
 	// If the mempool is nil or NoOp we simply return ACCEPT,
 	// because PrepareProposal may have included txs that could fail verification.
@@ -265,7 +339,21 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		return NoOpProcessProposal()
 	}
 
+	if lanedMempool, ok := asLanedMempool(h.mempool); ok {
+		return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+			if err := h.verifyLanedProposal(ctx, req.Txs, lanedMempool); err != nil {
+				return rejectProposal(err), nil
+			}
+
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+		}
+	}
+
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if h.txOrderVerifier != nil {
+			defer h.txOrderVerifier.Clear()
+		}
+
 		var totalTxGas uint64
 
 		var maxBlockGas int64
@@ -274,9 +362,9 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 		}
 
 		for _, txBytes := range req.Txs {
-			tx, err := h.txVerifier.ProcessProposalVerifyTx(txBytes)
+			tx, err := h.verifyProposalTx(ctx, txBytes)
 			if err != nil {
-				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+				return rejectProposal(err), nil
 			}
 
 			if maxBlockGas > 0 {
@@ -286,7 +374,8 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 				}
 
 				if totalTxGas > uint64(maxBlockGas) {
-					return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+					err := fmt.Errorf("proposal exceeds max block gas %d", maxBlockGas)
+					return rejectProposal(proposalRejection{proposalRejectedBlockSpace, err}), nil
 				}
 			}
 		}
@@ -295,6 +384,225 @@ func (h *DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHan
 	}
 }
 
+// verifyProposalTx verifies a transaction of a proposal and, if a TxOrderVerifier
+// is set, that it may follow the transactions of the proposal verified so far.
+func (h *DefaultProposalHandler) verifyProposalTx(ctx sdk.Context, txBz []byte) (sdk.Tx, error) {
+	txCtx, tx, err := h.verifyTxContext(ctx, txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.txOrderVerifier != nil {
+		if err := h.txOrderVerifier.VerifyTx(txCtx, tx); err != nil {
+			return nil, proposalRejection{proposalRejectedTxOrder, err}
+		}
+	}
+
+	return tx, nil
+}
+
+// verifyTxContext verifies a transaction of a proposal, returning the
+// context to derive its priority from if a TxOrderVerifier is set. The priority
+// of a tx is derived from its context once it passed the AnteHandler, as when it
+// is inserted into the mempool, if the ProposalTxVerifier provides it.
+func (h *DefaultProposalHandler) verifyTxContext(ctx sdk.Context, txBz []byte) (sdk.Context, sdk.Tx, error) {
+	var (
+		tx  sdk.Tx
+		err error
+	)
+	if verifier, ok := h.txVerifier.(ProposalTxContextVerifier); ok && h.txOrderVerifier != nil {
+		ctx, tx, err = verifier.ProcessProposalVerifyTxContext(txBz)
+	} else {
+		tx, err = h.txVerifier.ProcessProposalVerifyTx(txBz)
+	}
+	if err != nil {
+		return ctx, nil, proposalRejection{proposalRejectedInvalidTx, err}
+	}
+
+	return ctx, tx, nil
+}
+
+// rejectProposal returns the response rejecting the proposal, recording the
+// reason of the rejection.
+func rejectProposal(err error) *abci.ResponseProcessProposal {
+	reason := "unknown"
+	var rejection proposalRejection
+	if errors.As(err, &rejection) {
+		reason = rejection.reason
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"process_proposal", "rejected"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}
+}
+
+// asLanedMempool returns the mempool as a LanedMempool, unwrapping mempools
+// wrapping another one, e.g. a JournaledMempool.
+func asLanedMempool(mp mempool.Mempool) (*mempool.LanedMempool, bool) {
+	for {
+		switch m := mp.(type) {
+		case *mempool.LanedMempool:
+			return m, true
+
+		case interface{ Unwrap() mempool.Mempool }:
+			mp = m.Unwrap()
+
+		default:
+			return nil, false
+		}
+	}
+}
+
+// prepareLanedProposal selects the transactions of every lane of the mempool, in
+// the order of the lanes. The transactions of a lane are selected up to the
+// block space of the lane, as defined by the consensus params, and the remaining
+// space of the proposal.
+func (h *DefaultProposalHandler) prepareLanedProposal(
+	ctx sdk.Context,
+	req *abci.RequestPrepareProposal,
+	mp *mempool.LanedMempool,
+) (*abci.ResponsePrepareProposal, error) {
+	maxBytes, maxGas := blockLimits(ctx)
+
+	var (
+		selectedTxs          [][]byte
+		totalBytes, totalGas uint64
+	)
+
+	for _, lane := range mp.Lanes() {
+		maxLaneBytes, maxLaneGas := lane.BlockSpace(maxBytes, maxGas)
+		maxLaneBytes = min(maxLaneBytes, uint64(req.MaxTxBytes)-totalBytes)
+		if maxGas > 0 {
+			maxLaneGas = min(maxLaneGas, uint64(maxGas)-totalGas)
+		}
+
+		var laneBytes, laneGas uint64
+		for iterator := lane.Mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+
+			txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+			if err != nil {
+				err := mp.Remove(memTx)
+				if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					return nil, err
+				}
+
+				continue
+			}
+
+			txSize, txGas := uint64(len(txBz)), txGasLimit(memTx)
+			if laneBytes+txSize <= maxLaneBytes && (maxGas <= 0 || laneGas+txGas <= maxLaneGas) {
+				laneBytes += txSize
+				laneGas += txGas
+				selectedTxs = append(selectedTxs, txBz)
+			}
+
+			if laneBytes >= maxLaneBytes || (maxGas > 0 && laneGas >= maxLaneGas) {
+				break
+			}
+		}
+
+		totalBytes += laneBytes
+		totalGas += laneGas
+	}
+
+	return &abci.ResponsePrepareProposal{Txs: selectedTxs}, nil
+}
+
+// verifyLanedProposal verifies that every transaction of the proposal is valid,
+// that the proposal is composed of the transactions of every lane of the mempool
+// in the order of the lanes and that every lane remains within its block space,
+// as defined by the consensus params. If a TxOrderVerifier is set, the order of
+// the transactions of every lane is verified independently.
+func (h *DefaultProposalHandler) verifyLanedProposal(ctx sdk.Context, txs [][]byte, mp *mempool.LanedMempool) error {
+	maxBytes, maxGas := blockLimits(ctx)
+	lanes := mp.Lanes()
+
+	if h.txOrderVerifier != nil {
+		defer h.txOrderVerifier.Clear()
+	}
+
+	var (
+		laneIndex                    int
+		laneBytes, laneGas, blockGas uint64
+	)
+
+	maxLaneBytes, maxLaneGas := lanes[laneIndex].BlockSpace(maxBytes, maxGas)
+	for _, txBz := range txs {
+		txCtx, tx, err := h.verifyTxContext(ctx, txBz)
+		if err != nil {
+			return err
+		}
+
+		i := mp.LaneIndex(tx)
+		if i < 0 {
+			return proposalRejection{proposalRejectedNoLane, mempool.ErrNoMatchingLane}
+		}
+
+		if i < laneIndex {
+			err := fmt.Errorf("tx of lane %d included after txs of lane %d", i, laneIndex)
+			return proposalRejection{proposalRejectedLaneOrder, err}
+		}
+
+		if i > laneIndex {
+			laneIndex = i
+			laneBytes, laneGas = 0, 0
+			maxLaneBytes, maxLaneGas = lanes[laneIndex].BlockSpace(maxBytes, maxGas)
+
+			// every lane selects its txs independently
+			if h.txOrderVerifier != nil {
+				h.txOrderVerifier.Clear()
+			}
+		}
+
+		if h.txOrderVerifier != nil {
+			if err := h.txOrderVerifier.VerifyTx(txCtx, tx); err != nil {
+				return proposalRejection{proposalRejectedTxOrder, err}
+			}
+		}
+
+		txGas := txGasLimit(tx)
+		laneBytes += uint64(len(txBz))
+		laneGas += txGas
+		blockGas += txGas
+
+		if laneBytes > maxLaneBytes {
+			err := fmt.Errorf("lane %s exceeds its max bytes %d", lanes[laneIndex].Name, maxLaneBytes)
+			return proposalRejection{proposalRejectedBlockSpace, err}
+		}
+
+		if maxGas > 0 && (laneGas > maxLaneGas || blockGas > uint64(maxGas)) {
+			err := fmt.Errorf("lane %s exceeds its max gas %d", lanes[laneIndex].Name, maxLaneGas)
+			return proposalRejection{proposalRejectedBlockSpace, err}
+		}
+	}
+
+	return nil
+}
+
+// blockLimits returns the max bytes and max gas of a block as defined by the
+// consensus params.
+func blockLimits(ctx sdk.Context) (maxBytes, maxGas int64) {
+	if b := ctx.ConsensusParams().Block; b != nil {
+		return b.MaxBytes, b.MaxGas
+	}
+
+	return 0, 0
+}
+
+// txGasLimit returns the gas limit of the tx, or 0 if it is not a GasTx.
+func txGasLimit(tx sdk.Tx) uint64 {
+	if gasTx, ok := tx.(GasTx); ok {
+		return gasTx.GetGas()
+	}
+
+	return 0
+}
+
 // NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
 // return the transactions sent by the client's request.
 func NoOpPrepareProposal() sdk.PrepareProposalHandler {
@@ -396,3 +704,17 @@ func (ts *defaultTxSelector) SelectTxForProposal(_ context.Context, maxTxBytes,
 	// check if we've reached capacity; if so, we cannot select any more transactions
 	return ts.totalTxBytes >= maxTxBytes || (maxBlockGas > 0 && (ts.totalTxGas >= maxBlockGas))
 }
+
+// TxOrderVerifier defines a helper type that assists in verifying the order of
+// the transactions of a proposal in ProcessProposal, e.g. that they are ordered
+// as the mempool selects them. See mempool.PriorityNonceOrderVerifier.
+type TxOrderVerifier interface {
+	// VerifyTx should return an error if the transaction may not follow the
+	// transactions verified so far. The context is the one the transaction is
+	// inserted into the mempool with, i.e. once it passed the AnteHandler.
+	VerifyTx(ctx context.Context, tx sdk.Tx) error
+
+	// Clear should clear the TxOrderVerifier, such that the next transaction is
+	// verified as the first one of a proposal.
+	Clear()
+}
@@ -15,14 +15,18 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	baseapptestutil "github.com/cosmos/cosmos-sdk/baseapp/testutil"
 	"github.com/cosmos/cosmos-sdk/baseapp/testutil/mock"
+	"github.com/cosmos/cosmos-sdk/client"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
@@ -377,3 +381,215 @@ func marshalDelimitedFn(msg proto.Message) ([]byte, error) {
 
 	return buf.Bytes(), nil
 }
+
+// lanesTxVerifier defines a ProposalTxVerifier which only encodes and decodes
+// txs, for testing the composition of proposals.
+type lanesTxVerifier struct {
+	txConfig client.TxConfig
+}
+
+func (v lanesTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	return v.txConfig.TxEncoder()(tx)
+}
+
+func (v lanesTxVerifier) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	return v.txConfig.TxDecoder()(txBz)
+}
+
+func (v lanesTxVerifier) TxDecode(txBz []byte) (sdk.Tx, error) {
+	return v.txConfig.TxDecoder()(txBz)
+}
+
+func (v lanesTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.txConfig.TxEncoder()(tx)
+}
+
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_Lanes() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	// counter2 txs are allotted a quarter of the block space, while the default
+	// lane may use the whole block
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane{
+			Name:          "counter2",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2),
+			Match: func(tx sdk.Tx) bool {
+				_, ok := tx.GetMsgs()[0].(*baseapptestutil.MsgCounter2)
+				return ok
+			},
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	s.Require().NoError(err)
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(msg sdk.Msg, nonce uint64) (sdk.Tx, []byte) {
+		builder := txConfig.NewTxBuilder()
+		s.Require().NoError(builder.SetMsgs(msg))
+		builder.SetGasLimit(100)
+		setTxSignature(s.T(), builder, nonce)
+
+		txBz, err := txConfig.TxEncoder()(builder.GetTx())
+		s.Require().NoError(err)
+
+		return builder.GetTx(), txBz
+	}
+
+	var counterTxs, counter2Txs [][]byte
+	for i := uint64(0); i < 5; i++ {
+		tx, txBz := newTx(&baseapptestutil.MsgCounter{Counter: int64(i), Signer: addr.String()}, i)
+		s.Require().NoError(mp.Insert(s.ctx, tx))
+		counterTxs = append(counterTxs, txBz)
+
+		tx, txBz = newTx(&baseapptestutil.MsgCounter2{Counter: int64(i), Signer: addr.String()}, i)
+		s.Require().NoError(mp.Insert(s.ctx, tx))
+		counter2Txs = append(counter2Txs, txBz)
+	}
+	s.Require().Equal(10, mp.CountTx())
+
+	ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{
+			MaxGas: 400,
+		},
+	})
+
+	ph := baseapp.NewDefaultProposalHandler(mp, lanesTxVerifier{txConfig})
+
+	resp, err := ph.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{counter2Txs[0], counterTxs[0], counterTxs[1], counterTxs[2]}, resp.Txs)
+
+	testCases := map[string]struct {
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"prepared proposal": {
+			txs:    resp.Txs,
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lanes out of order": {
+			txs:    [][]byte{counterTxs[0], counter2Txs[0]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"lane exceeds its block space": {
+			txs:    [][]byte{counter2Txs[0], counter2Txs[1]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"block exceeds max gas": {
+			txs:    [][]byte{counter2Txs[0], counterTxs[0], counterTxs[1], counterTxs[2], counterTxs[3]},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"empty lane": {
+			txs:    [][]byte{counterTxs[0], counterTxs[1], counterTxs[2], counterTxs[3]},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			resp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: tc.txs})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.status, resp.Status)
+		})
+	}
+
+	// txs matching no lane are rejected
+	counter2Mp, err := mempool.NewLanedMempool(mp.Lanes()[0])
+	s.Require().NoError(err)
+
+	ph = baseapp.NewDefaultProposalHandler(counter2Mp, lanesTxVerifier{txConfig})
+	processResp, err := ph.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{counter2Txs[0], counterTxs[0]}})
+	s.Require().NoError(err)
+	s.Require().Equal(abci.ResponseProcessProposal_REJECT, processResp.Status)
+}
+
+// priorityTxVerifier defines a ProposalTxContextVerifier which derives the
+// priority of txs from their gas limit, as an AnteHandler would.
+type priorityTxVerifier struct {
+	lanesTxVerifier
+	ctx sdk.Context
+}
+
+func (v priorityTxVerifier) ProcessProposalVerifyTxContext(txBz []byte) (sdk.Context, sdk.Tx, error) {
+	tx, err := v.ProcessProposalVerifyTx(txBz)
+	if err != nil {
+		return sdk.Context{}, nil, err
+	}
+
+	return v.ctx.WithPriority(int64(tx.(baseapp.GasTx).GetGas())), tx, nil
+}
+
+func (s *ABCIUtilsTestSuite) TestDefaultProposalHandler_TxOrderVerifier() {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+	mp := mempool.DefaultPriorityMempool()
+
+	_, pubKeyA, addrA := testdata.KeyTestPubAddr()
+	_, pubKeyB, addrB := testdata.KeyTestPubAddr()
+	newTx := func(pubKey cryptotypes.PubKey, signer sdk.AccAddress, nonce, gas uint64) []byte {
+		builder := txConfig.NewTxBuilder()
+		s.Require().NoError(builder.SetMsgs(&baseapptestutil.MsgCounter{Counter: int64(nonce), Signer: signer.String()}))
+		builder.SetGasLimit(gas)
+		s.Require().NoError(builder.SetSignatures(signingtypes.SignatureV2{
+			PubKey:   pubKey,
+			Sequence: nonce,
+			Data:     &signingtypes.SingleSignatureData{},
+		}))
+		s.Require().NoError(mp.Insert(s.ctx.WithPriority(int64(gas)), builder.GetTx()))
+
+		txBz, err := txConfig.TxEncoder()(builder.GetTx())
+		s.Require().NoError(err)
+		return txBz
+	}
+
+	a0, a1 := newTx(pubKeyA, addrA, 0, 20), newTx(pubKeyA, addrA, 1, 30)
+	b0, b1 := newTx(pubKeyB, addrB, 0, 10), newTx(pubKeyB, addrB, 1, 15)
+
+	ph := baseapp.NewDefaultProposalHandler(mp, priorityTxVerifier{lanesTxVerifier{txConfig}, s.ctx})
+	ph.SetTxOrderVerifier(mempool.NewPriorityNonceOrderVerifier(mempool.NewDefaultTxPriority()))
+
+	resp, err := ph.PrepareProposalHandler()(s.ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1_000_000})
+	s.Require().NoError(err)
+	s.Require().Equal([][]byte{a0, a1, b0, b1}, resp.Txs)
+
+	testCases := map[string]struct {
+		txs    [][]byte
+		status abci.ResponseProcessProposal_ProposalStatus
+	}{
+		"prepared proposal": {
+			txs:    resp.Txs,
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"subset of prepared proposal": {
+			txs:    [][]byte{a0, b0, b1},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		"lower priority first": {
+			txs:    [][]byte{b0, a0, a1, b1},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		"out of nonce order": {
+			txs:    [][]byte{a1, a0},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			// iterate multiple times to ensure the tx order verifier is cleared each time
+			for i := 0; i < 2; i++ {
+				resp, err := ph.ProcessProposalHandler()(s.ctx, &abci.RequestProcessProposal{Txs: tc.txs})
+				s.Require().NoError(err)
+				s.Require().Equal(tc.status, resp.Status)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"context"
 	"fmt"
+	"io"
 	"math"
 	"sort"
 	"strconv"
@@ -20,6 +21,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
 	"cosmossdk.io/store/snapshots"
 	storetypes "cosmossdk.io/store/types"
 
@@ -54,7 +56,20 @@ const (
 	execModeFinalize                        // Finalize a block proposal
 )
 
-var _ servertypes.ABCI = (*BaseApp)(nil)
+const (
+	// DefaultSimulateBundleMaxTxs is the default maximum number of txs of a
+	// bundle simulated by SimulateBundle.
+	DefaultSimulateBundleMaxTxs = 16
+
+	// DefaultSimulateBundleMaxGas is the default maximum gas the txs of a bundle
+	// simulated by SimulateBundle may consume in total.
+	DefaultSimulateBundleMaxGas uint64 = 100_000_000
+)
+
+var (
+	_ servertypes.ABCI          = (*BaseApp)(nil)
+	_ ProposalTxContextVerifier = (*BaseApp)(nil)
+)
 
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct {
@@ -128,6 +143,11 @@ type BaseApp struct {
 	// queryGasLimit defines the maximum gas for queries; unbounded if 0.
 	queryGasLimit uint64
 
+	// simulateBundleMaxTxs and simulateBundleMaxGas bound the number of txs of
+	// a bundle simulated by SimulateBundle and the gas they may consume.
+	simulateBundleMaxTxs int
+	simulateBundleMaxGas uint64
+
 	// The minimum gas prices a validator is willing to accept for processing a
 	// transaction. This is mainly used for DoS and spam prevention.
 	minGasPrices sdk.DecCoins
@@ -180,6 +200,14 @@ type BaseApp struct {
 	// including the goroutine handling.This is experimental and must be enabled
 	// by developers.
 	optimisticExec *oe.OptimisticExecution
+
+	// oeTxCache caches the execution of the txs executed optimistically, such
+	// that it can be reused once the OE is aborted, see oe.WithPartialReuse.
+	oeTxCache oeTxCache
+
+	// blockSTMWorkers defines the number of workers executing the txs of a block
+	// in parallel using Block-STM. If 0, txs are executed serially.
+	blockSTMWorkers int
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
@@ -199,6 +227,9 @@ func NewBaseApp(
 		txDecoder:        txDecoder,
 		fauxMerkleMode:   false,
 		queryGasLimit:    math.MaxUint64,
+
+		simulateBundleMaxTxs: DefaultSimulateBundleMaxTxs,
+		simulateBundleMaxGas: DefaultSimulateBundleMaxGas,
 	}
 
 	for _, option := range options {
@@ -430,7 +461,74 @@ func (app *BaseApp) Init() error {
 		return errors.New("commit multi-store must not be nil")
 	}
 
-	return app.cms.GetPruning().Validate()
+	if err := app.cms.GetPruning().Validate(); err != nil {
+		return err
+	}
+
+	// The signing context caches the signers getter of every msg type the first
+	// time it is resolved, which is not safe for concurrent use, hence resolve
+	// them all before executing txs in parallel.
+	if app.blockSTMWorkers > 0 && app.interfaceRegistry != nil {
+		if err := app.interfaceRegistry.SigningContext().Validate(); err != nil {
+			return fmt.Errorf("failed to resolve msg signers: %w", err)
+		}
+	}
+
+	return app.replayMempoolJournal()
+}
+
+// replayMempoolJournal replays the txs journaled by the mempool, if it is a
+// mempool.Journal, through CheckTx on top of the latest committed state, such
+// that every tx is validated by the AnteHandler again before being re-inserted.
+func (app *BaseApp) replayMempoolJournal() error {
+	journal, ok := app.mempool.(mempool.Journal)
+	if !ok {
+		return nil
+	}
+
+	// CheckTx runs on top of the latest committed block, as it does once a block
+	// is committed, where the block time is restored from the commit info
+	if height := app.LastBlockHeight(); height > 0 {
+		header := cmtproto.Header{
+			ChainID: app.chainID,
+			Height:  height,
+		}
+
+		if rms, ok := app.cms.(*rootmulti.Store); ok {
+			cInfo, err := rms.GetCommitInfo(height)
+			if err != nil {
+				return fmt.Errorf("failed to get commit info at height %d: %w", height, err)
+			}
+
+			header.Time = cInfo.Timestamp
+		}
+
+		app.setState(execModeCheck, header)
+	}
+
+	var replayed, dropped int
+	err := journal.Replay(func(txBytes []byte) error {
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: txBytes, Type: abci.CheckTxType_New})
+		if err == nil && !res.IsOK() {
+			err = errors.New(res.Log)
+		}
+		if err != nil {
+			dropped++
+			return err
+		}
+
+		replayed++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replay mempool journal: %w", err)
+	}
+
+	if replayed+dropped > 0 {
+		app.logger.Info("replayed mempool journal", "replayed", replayed, "dropped", dropped)
+	}
+
+	return nil
 }
 
 func (app *BaseApp) setMinGasPrices(gasPrices sdk.DecCoins) {
@@ -643,7 +741,14 @@ func (app *BaseApp) getContextForTx(mode execMode, txBytes []byte) sdk.Context {
 	if modeState == nil {
 		panic(fmt.Sprintf("state is nil for mode %v", mode))
 	}
-	ctx := modeState.ctx.
+
+	return app.prepareTxContext(modeState.ctx, mode, txBytes)
+}
+
+// prepareTxContext returns the context for the tx w/ txBytes based off of the
+// provided context of the given mode.
+func (app *BaseApp) prepareTxContext(ctx sdk.Context, mode execMode, txBytes []byte) sdk.Context {
+	ctx = ctx.
 		WithTxBytes(txBytes)
 	// WithVoteInfos(app.voteInfos) // TODO: identify if this is needed
 
@@ -726,40 +831,46 @@ func (app *BaseApp) beginBlock(req *abci.RequestFinalizeBlock) (sdk.BeginBlock,
 }
 
 func (app *BaseApp) deliverTx(tx []byte) *abci.ExecTxResult {
-	gInfo := sdk.GasInfo{}
-	resultStr := "successful"
-
-	var resp *abci.ExecTxResult
+	resp := app.deliverTxWithContext(app.getContextForTx(execModeFinalize, tx), tx)
+	recordTxTelemetry(resp)
 
-	defer func() {
-		telemetry.IncrCounter(1, "tx", "count")
-		telemetry.IncrCounter(1, "tx", resultStr)
-		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
-		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
-	}()
+	return resp
+}
 
-	gInfo, result, anteEvents, err := app.runTx(execModeFinalize, tx)
+// deliverTxWithContext executes the tx against the given context, which must be
+// prepared for execModeFinalize, and returns its execution result.
+func (app *BaseApp) deliverTxWithContext(ctx sdk.Context, tx []byte) *abci.ExecTxResult {
+	gInfo, result, anteEvents, err := app.runTxWithContext(ctx, execModeFinalize, tx)
 	if err != nil {
-		resultStr = "failed"
-		resp = sdkerrors.ResponseExecTxResultWithEvents(
+		return sdkerrors.ResponseExecTxResultWithEvents(
 			err,
 			gInfo.GasWanted,
 			gInfo.GasUsed,
 			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
 			app.trace,
 		)
-		return resp
 	}
 
-	resp = &abci.ExecTxResult{
+	return &abci.ExecTxResult{
 		GasWanted: int64(gInfo.GasWanted),
 		GasUsed:   int64(gInfo.GasUsed),
 		Log:       result.Log,
 		Data:      result.Data,
 		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
 	}
+}
 
-	return resp
+// recordTxTelemetry records the telemetry of a delivered tx.
+func recordTxTelemetry(resp *abci.ExecTxResult) {
+	resultStr := "successful"
+	if !resp.IsOK() {
+		resultStr = "failed"
+	}
+
+	telemetry.IncrCounter(1, "tx", "count")
+	telemetry.IncrCounter(1, "tx", resultStr)
+	telemetry.SetGauge(float32(resp.GasUsed), "tx", "gas", "used")
+	telemetry.SetGauge(float32(resp.GasWanted), "tx", "gas", "wanted")
 }
 
 // endBlock is an application-defined function that is called after transactions
@@ -796,12 +907,17 @@ func (app *BaseApp) endBlock(ctx context.Context) (sdk.EndBlock, error) {
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext is runTx against the given context, which must be prepared
+// for the given mode, e.g. via getContextForTx.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode execMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter, so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -893,6 +1009,15 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			// A tx failing the AnteHandler on recheck, e.g. after its sequence was
+			// consumed by another tx, is no longer valid and is removed from the
+			// mempool so that it does not linger until selected for a proposal.
+			if mode == execModeReCheck {
+				if mempoolErr := app.mempool.Remove(tx); mempoolErr != nil && !errors.Is(mempoolErr, mempool.ErrTxNotFound) {
+					return gInfo, nil, nil, errors.Join(err, mempoolErr)
+				}
+			}
+
 			return gInfo, nil, nil, err
 		}
 
@@ -900,16 +1025,27 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 		anteEvents = events.ToABCIEvents()
 	}
 
+	// txs are simulated with an infinite gas meter, which is bounded by the gas
+	// left to a simulated bundle, if any
+	if gasLimit, ok := ctx.Value(simulateGasLimitKey{}).(uint64); ok && mode == execModeSimulate {
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		ctx = ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		ctx.GasMeter().ConsumeGas(gasConsumed, "ante handler")
+	}
+
+	if recorded, ok := ctx.Value(anteContextKey{}).(*sdk.Context); ok {
+		*recorded = ctx
+	}
+
 	if mode == execModeCheck {
 		err = app.mempool.Insert(ctx, tx)
 		if err != nil {
 			return gInfo, nil, anteEvents, err
 		}
 	} else if mode == execModeFinalize {
-		err = app.mempool.Remove(tx)
-		if err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
-			return gInfo, nil, anteEvents,
-				fmt.Errorf("failed to remove tx from mempool: %w", err)
+		err = app.removeFromMempool(ctx, tx)
+		if err != nil {
+			return gInfo, nil, anteEvents, err
 		}
 	}
 
@@ -947,6 +1083,11 @@ func (app *BaseApp) runTx(mode execMode, txBytes []byte) (gInfo sdk.GasInfo, res
 			// When block gas exceeds, it'll panic and won't commit the cached store.
 			consumeBlockGas()
 
+			msCache.Write()
+		} else if mode == execModeSimulate {
+			// The context of a simulated tx is a branch of its own, such that
+			// writing it only lets the subsequent txs of a simulated bundle
+			// observe the state changes of the tx.
 			msCache.Write()
 		}
 
@@ -1097,6 +1238,30 @@ func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
 	return tx, nil
 }
 
+// ProcessProposalVerifyTxContext performs the same verification as
+// ProcessProposalVerifyTx, additionally returning the context of the tx once it
+// passed the AnteHandler, e.g. to derive the mempool priority of the tx.
+func (app *BaseApp) ProcessProposalVerifyTxContext(txBz []byte) (sdk.Context, sdk.Tx, error) {
+	tx, err := app.txDecoder(txBz)
+	if err != nil {
+		return sdk.Context{}, nil, err
+	}
+
+	var anteCtx sdk.Context
+	ctx := app.getContextForTx(execModeProcessProposal, txBz).WithValue(anteContextKey{}, &anteCtx)
+
+	_, _, _, err = app.runTxWithContext(ctx, execModeProcessProposal, txBz)
+	if err != nil {
+		return sdk.Context{}, nil, err
+	}
+
+	return anteCtx, tx, nil
+}
+
+// anteContextKey defines the context key under which runTx records the context
+// of a tx once it passed the AnteHandler.
+type anteContextKey struct{}
+
 func (app *BaseApp) TxDecode(txBytes []byte) (sdk.Tx, error) {
 	return app.txDecoder(txBytes)
 }
@@ -1129,5 +1294,13 @@ func (app *BaseApp) Close() error {
 		}
 	}
 
+	// Close the mempool, e.g. the database of a mempool journal
+	if closer, ok := app.mempool.(io.Closer); ok {
+		app.logger.Info("Closing mempool")
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -3,6 +3,7 @@ package baseapp_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
@@ -30,6 +31,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 )
 
@@ -773,3 +777,79 @@ func TestLoadVersionPruning(t *testing.T) {
 	require.Nil(t, err)
 	testLoadVersionHelper(t, app, int64(7), lastCommitID)
 }
+
+func TestLoadVersionMempoolJournal(t *testing.T) {
+	cdc := codectestutil.CodecOptions{}.NewCodec()
+	baseapptestutil.RegisterInterfaces(cdc.InterfaceRegistry())
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	db, journalDB, paramDB := dbm.NewMemDB(), dbm.NewMemDB(), dbm.NewMemDB()
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+
+	// newApp (re)starts the app on top of the databases, where the AnteHandler
+	// rejects the txs of the given sequence and asserts the block time
+	newApp := func(rejectSequence uint64) (*baseapp.BaseApp, mempool.Mempool) {
+		pool := mempool.NewSenderNonceMempool()
+		app := baseapp.NewBaseApp(
+			t.Name(), log.NewTestLogger(t), db, txConfig.TxDecoder(),
+			baseapp.SetMempool(mempool.NewJournaledMempool(pool, journalDB)),
+		)
+		app.SetInterfaceRegistry(cdc.InterfaceRegistry())
+		app.MsgServiceRouter().SetInterfaceRegistry(cdc.InterfaceRegistry())
+		app.MountStores(capKey1)
+		app.SetParamStore(paramStore{db: paramDB})
+		app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, _ bool) (sdk.Context, error) {
+			sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			if err != nil {
+				return ctx, err
+			}
+
+			if sigs[0].Sequence == rejectSequence {
+				return ctx, sdkerrors.ErrWrongSequence
+			}
+
+			require.Equal(t, blockTime, ctx.BlockHeader().Time)
+
+			return ctx, nil
+		})
+		baseapptestutil.RegisterCounterServer(app.MsgServiceRouter(), NoopCounterServerImpl{})
+
+		require.NoError(t, app.LoadLatestVersion())
+
+		return app, pool
+	}
+
+	app, pool := newApp(math.MaxUint64)
+
+	_, err := app.InitChain(&abci.RequestInitChain{ConsensusParams: &cmtproto.ConsensusParams{}})
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, Time: blockTime})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := txConfig.TxEncoder()(newTxCounter(t, txConfig, i, i))
+		require.NoError(t, err)
+
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: txBytes})
+		require.NoError(t, err)
+		require.True(t, res.IsOK(), res.Log)
+	}
+	require.Equal(t, 3, pool.CountTx())
+
+	// the journaled txs are validated again on restart, such that the tx which
+	// fails the AnteHandler is dropped from the mempool and the journal
+	_, pool = newApp(1)
+	require.Equal(t, 2, pool.CountTx())
+
+	itr, err := journalDB.Iterator(nil, nil)
+	require.NoError(t, err)
+
+	var journaled int
+	for ; itr.Valid(); itr.Next() {
+		journaled++
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, 2, journaled)
+}
@@ -0,0 +1,136 @@
+package baseapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/blockstm"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+// executeTxsParallel executes the raw transactions in parallel using Block-STM,
+// producing the same results and state as executing them serially.
+//
+// Every transaction consumes block gas from its own meter during execution. Once
+// executed, the block gas consumed by each transaction is reconciled against the
+// block gas meter in block order. If serial execution would have run out of
+// block gas at a given transaction, which is rare as proposals are expected to
+// respect the block gas limit, the parallel results from that transaction
+// onwards are discarded and the remaining transactions are executed serially.
+//
+// NOTE: Transactions must only access state through the context's MultiStore,
+// as any other state shared between transactions, e.g. in-memory caches of
+// keepers, is not tracked for conflicts. Likewise, transactions must not rely on
+// the block gas consumed by preceding transactions, nor on the gas meter of the
+// block context, which is expected to be replaced by the AnteHandler.
+func (app *BaseApp) executeTxsParallel(ctx context.Context, txs [][]byte) ([]*abci.ExecTxResult, error) {
+	var (
+		blockCtx     = app.finalizeBlockState.ctx
+		ms           = app.finalizeBlockState.ms
+		txResults    = make([]*abci.ExecTxResult, len(txs))
+		txBlockGas   = make([]uint64, len(txs))
+		mempoolTxs   = make([]sdk.Tx, len(txs))
+		decodeFailed = make([]bool, len(txs))
+	)
+
+	for i, rawTx := range txs {
+		if _, err := app.txDecoder(rawTx); err != nil {
+			txResults[i] = txDecodeErrorResult()
+			decodeFailed[i] = true
+		}
+	}
+
+	writeSets, err := blockstm.Execute(ctx, len(txs), app.blockSTMWorkers, ms, func(txIndex int, txMultiStore storetypes.MultiStore) {
+		if decodeFailed[txIndex] {
+			return
+		}
+
+		rawTx := txs[txIndex]
+
+		var mempoolTx sdk.Tx
+		blockGasMeter := storetypes.NewInfiniteGasMeter()
+		txCtx := app.prepareTxContext(
+			blockCtx.
+				WithMultiStore(txMultiStore).
+				WithGasMeter(storetypes.NewInfiniteGasMeter()).
+				WithBlockGasMeter(blockGasMeter),
+			execModeFinalize,
+			rawTx,
+		).WithValue(mempoolRemovalKey{}, &mempoolTx)
+
+		txResults[txIndex] = app.deliverTxWithContext(txCtx, rawTx)
+		txBlockGas[txIndex] = blockGasMeter.GasConsumed()
+		mempoolTxs[txIndex] = mempoolTx
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blockGasMeter := blockCtx.BlockGasMeter()
+	for i := range txs {
+		if decodeFailed[i] {
+			continue
+		}
+
+		if !hasBlockGas(blockGasMeter, txBlockGas[i]) {
+			app.logger.Debug(
+				"block gas exceeded during parallel execution; executing remaining txs serially",
+				"height", blockCtx.BlockHeight(),
+				"tx_index", i,
+			)
+
+			remaining, err := app.executeTxsSerially(ctx, txs[i:])
+			if err != nil {
+				return nil, err
+			}
+
+			return append(txResults[:i], remaining...), nil
+		}
+
+		if mempoolTxs[i] != nil {
+			if err := app.removeFromMempool(blockCtx, mempoolTxs[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		writeSets[i].Write(ms)
+		blockGasMeter.ConsumeGas(txBlockGas[i], "block gas meter")
+		recordTxTelemetry(txResults[i])
+	}
+
+	return txResults, nil
+}
+
+// mempoolRemovalKey defines the context key under which a tx executed in
+// parallel records the tx to be removed from the mempool, as the mempool is not
+// safe for concurrent use. The removal is then performed once the results of
+// the tx are committed to the block state.
+type mempoolRemovalKey struct{}
+
+// removeFromMempool removes the tx from the mempool, unless the context defers
+// the removal, in which case the tx is recorded instead.
+func (app *BaseApp) removeFromMempool(ctx sdk.Context, tx sdk.Tx) error {
+	if deferred, ok := ctx.Value(mempoolRemovalKey{}).(*sdk.Tx); ok {
+		*deferred = tx
+		return nil
+	}
+
+	if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+		return fmt.Errorf("failed to remove tx from mempool: %w", err)
+	}
+
+	return nil
+}
+
+// hasBlockGas returns true if the given amount of gas can be consumed from the
+// block gas meter without the meter running out of gas, in which case the tx
+// consuming it would succeed block gas checks when executed serially.
+func hasBlockGas(blockGasMeter storetypes.GasMeter, gas uint64) bool {
+	return !blockGasMeter.IsOutOfGas() && gas <= blockGasMeter.Limit()-blockGasMeter.GasConsumed()
+}
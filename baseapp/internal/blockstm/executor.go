@@ -0,0 +1,135 @@
+// Package blockstm implements Block-STM, an optimistic parallel execution engine
+// which executes the txs of a block speculatively in parallel against a
+// multi-version memory, validates the reads of every execution and re-executes
+// txs as needed, such that the resulting state is identical to executing the txs
+// serially in block order.
+//
+// Ref: https://arxiv.org/abs/2203.06871
+package blockstm
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// ExecuteFn executes the tx at the given index against the given MultiStore.
+// It may be called several times for the same tx, but never concurrently, where
+// only the results of the last call must be retained. Every state access must
+// go through the provided MultiStore.
+type ExecuteFn func(txIndex int, ms storetypes.MultiStore)
+
+// Execute executes a block of the given size using the given number of workers
+// on top of the given base store, which must not be written to during the
+// execution. It returns the write set of every tx, which must be written to the
+// base store in block order to reflect the state after executing the block. An
+// error is returned if the context is canceled.
+func Execute(
+	ctx context.Context,
+	blockSize, workers int,
+	base storetypes.MultiStore,
+	execute ExecuteFn,
+) ([]WriteSet, error) {
+	if blockSize == 0 {
+		return nil, nil
+	}
+
+	e := &executor{
+		ctx:       ctx,
+		scheduler: NewScheduler(blockSize),
+		mv:        NewMVMemory(base, blockSize),
+		execute:   execute,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < min(workers, blockSize); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			e.run()
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	writeSets := make([]WriteSet, blockSize)
+	for i := range writeSets {
+		writeSets[i] = e.mv.WriteSet(i)
+	}
+
+	return writeSets, nil
+}
+
+type executor struct {
+	ctx       context.Context
+	scheduler *Scheduler
+	mv        *MVMemory
+	execute   ExecuteFn
+}
+
+// run performs tasks until the block is done, or the context is canceled.
+func (e *executor) run() {
+	t := noTask
+	for !e.scheduler.Done() {
+		if e.ctx.Err() != nil {
+			e.scheduler.Halt()
+			return
+		}
+
+		switch t.kind {
+		case taskExecution:
+			t = e.tryExecute(t.version)
+
+		case taskValidation:
+			t = e.validate(t.version)
+		}
+
+		if t.kind == taskNone {
+			t = e.scheduler.NextTask()
+			if t.kind == taskNone {
+				runtime.Gosched()
+			}
+		}
+	}
+}
+
+// tryExecute executes the incarnation and records its read and write sets, unless
+// it depends on a tx that is yet to be re-executed, in which case it is
+// suspended until then.
+func (e *executor) tryExecute(v version) task {
+	for {
+		view := newMultiStoreView(e.mv, v.txIndex)
+		e.execute(v.txIndex, view)
+
+		if !view.aborted() {
+			wroteNewLocation := e.mv.Record(v, view.readSet, view.writeSet())
+			return e.scheduler.FinishExecution(v, wroteNewLocation)
+		}
+
+		if e.scheduler.AddDependency(v.txIndex, view.blocking) {
+			return noTask
+		}
+
+		// the dependency has been re-executed in the meantime, so the incarnation
+		// is executed again
+	}
+}
+
+// validate validates the read set of the incarnation, aborting it if any read is
+// no longer valid, in which case its writes are marked as estimates.
+func (e *executor) validate(v version) task {
+	valid := e.mv.ValidateReadSet(v.txIndex)
+
+	aborted := !valid && e.scheduler.TryValidationAbort(v)
+	if aborted {
+		e.mv.ConvertWritesToEstimates(v.txIndex)
+	}
+
+	return e.scheduler.FinishValidation(v.txIndex, aborted)
+}
@@ -0,0 +1,223 @@
+package blockstm
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	storeKey1 = storetypes.NewKVStoreKey("store1")
+	storeKey2 = storetypes.NewKVStoreKey("store2")
+)
+
+func newBaseStore(t *testing.T) storetypes.CacheMultiStore {
+	t.Helper()
+
+	rs := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	rs.MountStoreWithDB(storeKey1, storetypes.StoreTypeIAVL, nil)
+	rs.MountStoreWithDB(storeKey2, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, rs.LoadLatestVersion())
+
+	ms := rs.CacheMultiStore()
+	for i := 0; i < 10; i++ {
+		ms.GetKVStore(storeKey1).Set(counterKey(i), encodeUint64(uint64(i)))
+	}
+
+	return ms
+}
+
+func counterKey(i int) []byte {
+	return []byte(fmt.Sprintf("counter%02d", i))
+}
+
+func encodeUint64(v uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, v)
+}
+
+func decodeUint64(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// newBlock returns a block of txs which access overlapping keys, i.e. which
+// conflict with each other, where every tx reports its results via the given
+// slice.
+func newBlock(blockSize, numKeys int, seed int64, results []string) ExecuteFn {
+	r := rand.New(rand.NewSource(seed))
+
+	type tx struct {
+		read, write, del int
+		iterate          bool
+	}
+
+	txs := make([]tx, blockSize)
+	for i := range txs {
+		txs[i] = tx{
+			read:    r.Intn(numKeys),
+			write:   r.Intn(numKeys),
+			del:     r.Intn(numKeys * 4),
+			iterate: r.Intn(4) == 0,
+		}
+	}
+
+	return func(txIndex int, ms storetypes.MultiStore) {
+		tx := txs[txIndex]
+
+		// branch the store, as BaseApp does
+		msCache := ms.CacheMultiStore()
+		store1 := msCache.GetKVStore(storeKey1)
+		store2 := msCache.GetKVStore(storeKey2)
+
+		v := decodeUint64(store1.Get(counterKey(tx.read)))
+		store1.Set(counterKey(tx.write), encodeUint64(v+uint64(txIndex)))
+
+		if tx.del < numKeys {
+			store1.Delete(counterKey(tx.del))
+		}
+
+		var sum uint64
+		if tx.iterate {
+			itr := store1.Iterator(counterKey(0), counterKey(numKeys))
+			for ; itr.Valid(); itr.Next() {
+				sum += decodeUint64(itr.Value())
+			}
+			itr.Close()
+
+			itr = store2.ReverseIterator(nil, nil)
+			if itr.Valid() {
+				sum += decodeUint64(itr.Value())
+			}
+			itr.Close()
+		}
+
+		store2.Set([]byte(fmt.Sprintf("tx%04d", txIndex)), encodeUint64(sum))
+		msCache.Write()
+
+		results[txIndex] = fmt.Sprintf("%d/%d", v, sum)
+	}
+}
+
+func requireEqualStores(t *testing.T, expected, actual storetypes.MultiStore) {
+	t.Helper()
+
+	for _, storeKey := range []storetypes.StoreKey{storeKey1, storeKey2} {
+		expItr := expected.GetKVStore(storeKey).Iterator(nil, nil)
+		actItr := actual.GetKVStore(storeKey).Iterator(nil, nil)
+
+		for ; expItr.Valid(); expItr.Next() {
+			require.True(t, actItr.Valid())
+			require.Equal(t, expItr.Key(), actItr.Key())
+			require.Equal(t, expItr.Value(), actItr.Value())
+			actItr.Next()
+		}
+		require.False(t, actItr.Valid())
+
+		require.NoError(t, expItr.Close())
+		require.NoError(t, actItr.Close())
+	}
+}
+
+func TestExecute(t *testing.T) {
+	testCases := []struct {
+		name      string
+		blockSize int
+		numKeys   int
+		workers   int
+	}{
+		{"empty block", 0, 10, 4},
+		{"single worker", 100, 10, 1},
+		{"high contention", 200, 2, 8},
+		{"low contention", 200, 10, 8},
+		{"more workers than txs", 3, 10, 16},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for seed := int64(0); seed < 5; seed++ {
+				// execute the block serially
+				serial := newBaseStore(t)
+				serialResults := make([]string, tc.blockSize)
+				execute := newBlock(tc.blockSize, tc.numKeys, seed, serialResults)
+				for i := 0; i < tc.blockSize; i++ {
+					execute(i, serial)
+				}
+
+				// execute the block in parallel
+				parallel := newBaseStore(t)
+				parallelResults := make([]string, tc.blockSize)
+				execute = newBlock(tc.blockSize, tc.numKeys, seed, parallelResults)
+
+				writeSets, err := Execute(context.Background(), tc.blockSize, tc.workers, parallel, execute)
+				require.NoError(t, err)
+				require.Len(t, writeSets, tc.blockSize)
+
+				for _, ws := range writeSets {
+					ws.Write(parallel)
+				}
+
+				require.Equal(t, serialResults, parallelResults)
+				requireEqualStores(t, serial, parallel)
+			}
+		})
+	}
+}
+
+func TestExecute_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := Execute(ctx, 10, 2, newBaseStore(t), func(txIndex int, ms storetypes.MultiStore) {
+		cancel()
+	})
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestMergeIterator(t *testing.T) {
+	ms := newBaseStore(t)
+
+	view := newMultiStoreView(NewMVMemory(ms, 2), 1)
+	kvStore := view.GetKVStore(storeKey1)
+
+	// overlay a write and a deletion over the base store
+	kvStore.Set(counterKey(10), encodeUint64(10))
+	kvStore.Delete(counterKey(0))
+
+	var keys []string
+	itr := kvStore.Iterator(nil, nil)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	require.Len(t, keys, 10)
+	require.Equal(t, string(counterKey(1)), keys[0])
+	require.Equal(t, string(counterKey(10)), keys[9])
+
+	keys = nil
+	itr = kvStore.ReverseIterator(counterKey(5), nil)
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+	}
+	require.NoError(t, itr.Close())
+	require.Equal(t, []string{
+		string(counterKey(10)), string(counterKey(9)), string(counterKey(8)),
+		string(counterKey(7)), string(counterKey(6)), string(counterKey(5)),
+	}, keys)
+
+	// the range read from the base store is recorded and remains valid
+	require.Len(t, view.readSet.iterations, 2)
+	require.True(t, view.readSet.iterations[0].exhausted)
+	require.Len(t, view.readSet.iterations[0].observed, 10)
+}
@@ -0,0 +1,167 @@
+package blockstm
+
+import (
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+// overlayItem defines a value overlaid on top of a parent iterator, where a nil
+// value denotes a deletion.
+type overlayItem struct {
+	key     string
+	value   []byte
+	version version
+}
+
+// mergeIterator merges a parent iterator with overlay items, which are sorted in
+// the same order as the parent iterator. An overlay item shadows the parent's
+// value of the same key. Values surfaced from the parent are of the base version.
+//
+// If an iteration is provided, every key surfaced, along with its value and the
+// version of its value, is recorded to it, such that the iteration can be validated later on.
+type mergeIterator struct {
+	parent     storetypes.Iterator
+	overlay    []overlayItem
+	start, end []byte
+	reverse    bool
+	it         *iteration
+
+	pos        int
+	valid      bool
+	fromParent bool
+	key        []byte
+	value      []byte
+	version    version
+}
+
+func newMergeIterator(
+	parent storetypes.Iterator,
+	overlay []overlayItem,
+	start, end []byte,
+	reverse bool,
+	it *iteration,
+) *mergeIterator {
+	itr := &mergeIterator{
+		parent:  parent,
+		overlay: overlay,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+		it:      it,
+	}
+	itr.skip()
+
+	return itr
+}
+
+// skip positions the iterator at the next valid item, skipping deletions.
+func (itr *mergeIterator) skip() {
+	for {
+		parentValid := itr.parent.Valid()
+		overlayValid := itr.pos < len(itr.overlay)
+
+		if !parentValid && !overlayValid {
+			itr.valid = false
+			if itr.it != nil {
+				itr.it.exhausted = true
+			}
+
+			return
+		}
+
+		if parentValid {
+			cmp := -1
+			if overlayValid {
+				cmp = strings.Compare(string(itr.parent.Key()), itr.overlay[itr.pos].key)
+				if itr.reverse {
+					cmp = -cmp
+				}
+			}
+
+			if cmp < 0 {
+				itr.land(true, itr.parent.Key(), itr.parent.Value(), baseVersion)
+				return
+			}
+
+			// the parent's value is shadowed by the overlay
+			if cmp == 0 {
+				itr.parent.Next()
+			}
+		}
+
+		item := itr.overlay[itr.pos]
+		if item.value == nil {
+			itr.pos++
+			continue
+		}
+
+		itr.land(false, []byte(item.key), item.value, item.version)
+		return
+	}
+}
+
+func (itr *mergeIterator) land(fromParent bool, key, value []byte, v version) {
+	itr.valid = true
+	itr.fromParent = fromParent
+	itr.key = key
+	itr.value = value
+	itr.version = v
+
+	if itr.it != nil {
+		itr.it.observed = append(itr.it.observed, observation{key: string(key), value: value, version: v})
+	}
+}
+
+// current returns the key and version of the current item.
+func (itr *mergeIterator) current() (string, version) {
+	return string(itr.key), itr.version
+}
+
+func (itr *mergeIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *mergeIterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *mergeIterator) Next() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	if itr.fromParent {
+		itr.parent.Next()
+	} else {
+		itr.pos++
+	}
+
+	itr.skip()
+}
+
+func (itr *mergeIterator) Key() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return itr.key
+}
+
+func (itr *mergeIterator) Value() []byte {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+
+	return itr.value
+}
+
+func (itr *mergeIterator) Error() error {
+	return itr.parent.Error()
+}
+
+func (itr *mergeIterator) Close() error {
+	return itr.parent.Close()
+}
@@ -0,0 +1,430 @@
+package blockstm
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// baseTxIndex defines the tx index of values read from the base store, i.e. the
+// state prior to executing any tx of the block.
+const baseTxIndex = -1
+
+// version defines the version of a value, i.e. the tx index and incarnation of
+// the execution that wrote it.
+type version struct {
+	txIndex     int
+	incarnation int
+}
+
+// baseVersion is the version of every value read from the base store.
+var baseVersion = version{txIndex: baseTxIndex}
+
+// mvEntry defines a value written to a key by an incarnation of a tx, where a
+// nil value denotes a deletion. An estimate marks the writes of an incarnation
+// that has been aborted and is expected to be re-executed.
+type mvEntry struct {
+	version  version
+	value    []byte
+	estimate bool
+}
+
+// mvStore defines the multi-version data of a single store key, i.e. the values
+// written to every key by each tx, ordered by tx index.
+type mvStore struct {
+	mtx sync.RWMutex
+
+	// keys reflects every key ever written, sorted, used for range iteration
+	keys []string
+	data map[string][]mvEntry
+}
+
+func newMVStore() *mvStore {
+	return &mvStore{data: make(map[string][]mvEntry)}
+}
+
+// write sets the value written to the key by the given version.
+func (s *mvStore) write(key string, v version, value []byte) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries, ok := s.data[key]
+	if !ok {
+		i, _ := slices.BinarySearch(s.keys, key)
+		s.keys = slices.Insert(s.keys, i, key)
+	}
+
+	entry := mvEntry{version: v, value: value}
+
+	i, found := slices.BinarySearchFunc(entries, v.txIndex, compareTxIndex)
+	if found {
+		entries[i] = entry
+	} else {
+		entries = slices.Insert(entries, i, entry)
+	}
+
+	s.data[key] = entries
+}
+
+// remove removes the value written to the key by the given tx.
+func (s *mvStore) remove(key string, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries := s.data[key]
+	if i, found := slices.BinarySearchFunc(entries, txIndex, compareTxIndex); found {
+		s.data[key] = slices.Delete(entries, i, i+1)
+	}
+}
+
+// markEstimate marks the value written to the key by the given tx as an
+// estimate.
+func (s *mvStore) markEstimate(key string, txIndex int) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	entries := s.data[key]
+	if i, found := slices.BinarySearchFunc(entries, txIndex, compareTxIndex); found {
+		entries[i].estimate = true
+	}
+}
+
+// read returns the latest entry written to the key by a tx prior to the given
+// tx, if any.
+func (s *mvStore) read(key string, txIndex int) (mvEntry, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return latestEntry(s.data[key], txIndex)
+}
+
+// readRange returns, in ascending key order, the latest entry written by a tx
+// prior to the given tx for every key within the given range.
+func (s *mvStore) readRange(start, end []byte, txIndex int) ([]string, []mvEntry) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	i := 0
+	if start != nil {
+		i = sort.SearchStrings(s.keys, string(start))
+	}
+
+	var (
+		keys    []string
+		entries []mvEntry
+	)
+	for ; i < len(s.keys); i++ {
+		key := s.keys[i]
+		if end != nil && key >= string(end) {
+			break
+		}
+
+		if entry, ok := latestEntry(s.data[key], txIndex); ok {
+			keys = append(keys, key)
+			entries = append(entries, entry)
+		}
+	}
+
+	return keys, entries
+}
+
+func latestEntry(entries []mvEntry, txIndex int) (mvEntry, bool) {
+	i, _ := slices.BinarySearchFunc(entries, txIndex, compareTxIndex)
+	if i == 0 {
+		return mvEntry{}, false
+	}
+
+	return entries[i-1], true
+}
+
+func compareTxIndex(e mvEntry, txIndex int) int {
+	return e.version.txIndex - txIndex
+}
+
+// write defines a single write of a tx, where a nil value denotes a deletion.
+type write struct {
+	storeKey storetypes.StoreKey
+	key      string
+	value    []byte
+}
+
+// WriteSet defines the writes of a tx, ordered by store key name and key.
+type WriteSet []write
+
+// Write applies the writes to the given MultiStore.
+func (ws WriteSet) Write(ms storetypes.MultiStore) {
+	for _, w := range ws {
+		kvStore := ms.GetKVStore(w.storeKey)
+		if w.value == nil {
+			kvStore.Delete([]byte(w.key))
+		} else {
+			kvStore.Set([]byte(w.key), w.value)
+		}
+	}
+}
+
+// read defines a read of a single key, along with the value read and its
+// version.
+type read struct {
+	storeKey storetypes.StoreKey
+	key      string
+	value    []byte
+	version  version
+}
+
+// observation defines a key, along with its value and the version of its value,
+// surfaced by an iterator.
+type observation struct {
+	key     string
+	value   []byte
+	version version
+}
+
+// iteration defines a range read, i.e. the keys surfaced by an iterator over
+// the state prior to the tx, and whether it was exhausted.
+type iteration struct {
+	storeKey   storetypes.StoreKey
+	start, end []byte
+	reverse    bool
+
+	observed  []observation
+	exhausted bool
+}
+
+// readSet defines every read of an incarnation of a tx.
+type readSet struct {
+	reads      []read
+	iterations []*iteration
+}
+
+// MVMemory defines the multi-version memory of a block, i.e. the values written
+// by the latest incarnation of every tx, which are read by the subsequent txs in
+// lieu of the base store.
+type MVMemory struct {
+	base storetypes.MultiStore
+
+	// mtx guards stores
+	mtx    sync.RWMutex
+	stores map[storetypes.StoreKey]*mvStore
+
+	// lastWrites and lastReads reflect the write and read sets recorded by the
+	// latest incarnation of every tx
+	lastWrites []atomic.Pointer[WriteSet]
+	lastReads  []atomic.Pointer[readSet]
+}
+
+// NewMVMemory returns a new MVMemory for a block of the given size on top of
+// the given base store.
+func NewMVMemory(base storetypes.MultiStore, blockSize int) *MVMemory {
+	return &MVMemory{
+		base:       base,
+		stores:     make(map[storetypes.StoreKey]*mvStore),
+		lastWrites: make([]atomic.Pointer[WriteSet], blockSize),
+		lastReads:  make([]atomic.Pointer[readSet], blockSize),
+	}
+}
+
+// getStore returns the multi-version data of the given store key, or nil if
+// nothing has been written to it.
+func (m *MVMemory) getStore(storeKey storetypes.StoreKey) *mvStore {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	return m.stores[storeKey]
+}
+
+func (m *MVMemory) getOrCreateStore(storeKey storetypes.StoreKey) *mvStore {
+	if s := m.getStore(storeKey); s != nil {
+		return s
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	s, ok := m.stores[storeKey]
+	if !ok {
+		s = newMVStore()
+		m.stores[storeKey] = s
+	}
+
+	return s
+}
+
+// Record records the read and write sets of the given incarnation, replacing
+// those of the previous incarnation of the tx. It returns true if the write set
+// contains a location not written by the previous incarnation.
+func (m *MVMemory) Record(v version, rs *readSet, ws WriteSet) bool {
+	for _, w := range ws {
+		m.getOrCreateStore(w.storeKey).write(w.key, v, w.value)
+	}
+
+	var prev WriteSet
+	if p := m.lastWrites[v.txIndex].Load(); p != nil {
+		prev = *p
+	}
+
+	// remove the writes of the previous incarnation to locations that are no
+	// longer written
+	wroteNewLocation := false
+	for _, w := range prev {
+		if !ws.contains(w.storeKey, w.key) {
+			m.getStore(w.storeKey).remove(w.key, v.txIndex)
+		}
+	}
+	for _, w := range ws {
+		if !prev.contains(w.storeKey, w.key) {
+			wroteNewLocation = true
+			break
+		}
+	}
+
+	m.lastWrites[v.txIndex].Store(&ws)
+	m.lastReads[v.txIndex].Store(rs)
+
+	return wroteNewLocation
+}
+
+// ConvertWritesToEstimates marks every value written by the latest incarnation
+// of the given tx as an estimate, such that subsequent txs reading them wait for
+// the tx to be re-executed.
+func (m *MVMemory) ConvertWritesToEstimates(txIndex int) {
+	p := m.lastWrites[txIndex].Load()
+	if p == nil {
+		return
+	}
+
+	for _, w := range *p {
+		m.getStore(w.storeKey).markEstimate(w.key, txIndex)
+	}
+}
+
+// ValidateReadSet returns true if every read of the latest incarnation of the
+// given tx still observes the same versions.
+func (m *MVMemory) ValidateReadSet(txIndex int) bool {
+	rs := m.lastReads[txIndex].Load()
+	if rs == nil {
+		return false
+	}
+
+	for _, r := range rs.reads {
+		v, ok := m.readVersion(r.storeKey, r.key, txIndex)
+		if !ok || v != r.version {
+			return false
+		}
+	}
+
+	for _, it := range rs.iterations {
+		if !m.validateIteration(it, txIndex) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// readVersion returns the version of the value of the key observed by the given
+// tx. It returns false if the value is an estimate.
+func (m *MVMemory) readVersion(storeKey storetypes.StoreKey, key string, txIndex int) (version, bool) {
+	s := m.getStore(storeKey)
+	if s == nil {
+		return baseVersion, true
+	}
+
+	entry, ok := s.read(key, txIndex)
+	if !ok {
+		return baseVersion, true
+	}
+
+	return entry.version, !entry.estimate
+}
+
+func (m *MVMemory) validateIteration(it *iteration, txIndex int) bool {
+	itr, blocking := m.newIterator(it.storeKey, it.start, it.end, it.reverse, txIndex, nil)
+	if blocking != baseTxIndex {
+		return false
+	}
+	defer itr.Close()
+
+	for _, obs := range it.observed {
+		if !itr.Valid() {
+			return false
+		}
+
+		key, v := itr.current()
+		if key != obs.key || v != obs.version {
+			return false
+		}
+
+		itr.Next()
+	}
+
+	return !it.exhausted || !itr.Valid()
+}
+
+// newIterator returns an iterator over the state observed by the given tx, i.e.
+// the base store overlaid with the latest values written by the prior txs. If
+// any value within the range is an estimate, the index of the tx that wrote it
+// is returned instead.
+func (m *MVMemory) newIterator(
+	storeKey storetypes.StoreKey,
+	start, end []byte,
+	reverse bool,
+	txIndex int,
+	it *iteration,
+) (*mergeIterator, int) {
+	var overlay []overlayItem
+	if s := m.getStore(storeKey); s != nil {
+		keys, entries := s.readRange(start, end, txIndex)
+		for i, entry := range entries {
+			if entry.estimate {
+				return nil, entry.version.txIndex
+			}
+
+			overlay = append(overlay, overlayItem{key: keys[i], value: entry.value, version: entry.version})
+		}
+	}
+
+	if reverse {
+		slices.Reverse(overlay)
+	}
+
+	kvStore := m.base.GetKVStore(storeKey)
+
+	var parent storetypes.Iterator
+	if reverse {
+		parent = kvStore.ReverseIterator(start, end)
+	} else {
+		parent = kvStore.Iterator(start, end)
+	}
+
+	return newMergeIterator(parent, overlay, start, end, reverse, it), baseTxIndex
+}
+
+// WriteSet returns the writes of the latest incarnation of the given tx.
+func (m *MVMemory) WriteSet(txIndex int) WriteSet {
+	if p := m.lastWrites[txIndex].Load(); p != nil {
+		return *p
+	}
+
+	return nil
+}
+
+func (ws WriteSet) contains(storeKey storetypes.StoreKey, key string) bool {
+	i := sort.Search(len(ws), func(i int) bool {
+		return compareWrite(ws[i], storeKey, key) >= 0
+	})
+
+	return i < len(ws) && ws[i].storeKey == storeKey && ws[i].key == key
+}
+
+func compareWrite(w write, storeKey storetypes.StoreKey, key string) int {
+	if c := strings.Compare(w.storeKey.Name(), storeKey.Name()); c != 0 {
+		return c
+	}
+
+	return strings.Compare(w.key, key)
+}
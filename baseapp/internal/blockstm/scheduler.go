@@ -0,0 +1,306 @@
+package blockstm
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// status defines the execution status of a tx.
+type status int
+
+const (
+	statusReadyToExecute status = iota
+	statusExecuting
+	statusExecuted
+	statusAborting
+)
+
+// taskKind defines the kind of a task handed out by the scheduler.
+type taskKind int
+
+const (
+	taskNone taskKind = iota
+	taskExecution
+	taskValidation
+)
+
+// task defines an execution or validation task of an incarnation of a tx.
+type task struct {
+	kind    taskKind
+	version version
+}
+
+var noTask = task{}
+
+// txState defines the scheduling state of a single tx.
+type txState struct {
+	// mtx guards incarnation and status
+	mtx         sync.Mutex
+	incarnation int
+	status      status
+
+	// dependenciesMtx guards dependencies, i.e. the txs waiting on this tx to be
+	// re-executed
+	dependenciesMtx sync.Mutex
+	dependencies    []int
+}
+
+// Scheduler defines the collaborative scheduler of Block-STM, which hands out
+// execution and validation tasks to the workers, prioritizing tasks of lower tx
+// indices. Execution and validation indices are decreased whenever a tx must be
+// re-executed or re-validated, and the block is done once both indices have
+// passed the end of the block with no active tasks remaining.
+//
+// Ref: https://arxiv.org/abs/2203.06871
+type Scheduler struct {
+	blockSize int
+
+	executionIdx   atomic.Int64
+	validationIdx  atomic.Int64
+	decreaseCnt    atomic.Int64
+	numActiveTasks atomic.Int64
+	done           atomic.Bool
+
+	txs []txState
+}
+
+// NewScheduler returns a new Scheduler for a block of the given size.
+func NewScheduler(blockSize int) *Scheduler {
+	return &Scheduler{
+		blockSize: blockSize,
+		txs:       make([]txState, blockSize),
+	}
+}
+
+// Done returns true once every tx has been executed and validated, or if the
+// scheduler has been halted.
+func (s *Scheduler) Done() bool {
+	return s.done.Load()
+}
+
+// Halt stops the scheduler, such that no more tasks are handed out.
+func (s *Scheduler) Halt() {
+	s.done.Store(true)
+}
+
+func (s *Scheduler) decreaseExecutionIdx(target int) {
+	storeMin(&s.executionIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+func (s *Scheduler) decreaseValidationIdx(target int) {
+	storeMin(&s.validationIdx, int64(target))
+	s.decreaseCnt.Add(1)
+}
+
+func storeMin(v *atomic.Int64, target int64) {
+	for {
+		current := v.Load()
+		if current <= target || v.CompareAndSwap(current, target) {
+			return
+		}
+	}
+}
+
+func (s *Scheduler) checkDone() {
+	observedCnt := s.decreaseCnt.Load()
+	if min(s.executionIdx.Load(), s.validationIdx.Load()) >= int64(s.blockSize) &&
+		s.numActiveTasks.Load() == 0 &&
+		observedCnt == s.decreaseCnt.Load() {
+		s.done.Store(true)
+	}
+}
+
+// tryIncarnate transitions the tx to executing, if it is ready to be executed,
+// returning the version of its new incarnation.
+func (s *Scheduler) tryIncarnate(txIndex int) (version, bool) {
+	if txIndex < s.blockSize {
+		tx := &s.txs[txIndex]
+
+		tx.mtx.Lock()
+		if tx.status == statusReadyToExecute {
+			tx.status = statusExecuting
+			v := version{txIndex: txIndex, incarnation: tx.incarnation}
+			tx.mtx.Unlock()
+
+			return v, true
+		}
+		tx.mtx.Unlock()
+	}
+
+	s.numActiveTasks.Add(-1)
+	return version{}, false
+}
+
+func (s *Scheduler) nextVersionToExecute() (version, bool) {
+	if s.executionIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return version{}, false
+	}
+
+	s.numActiveTasks.Add(1)
+	txIndex := int(s.executionIdx.Add(1) - 1)
+
+	return s.tryIncarnate(txIndex)
+}
+
+func (s *Scheduler) nextVersionToValidate() (version, bool) {
+	if s.validationIdx.Load() >= int64(s.blockSize) {
+		s.checkDone()
+		return version{}, false
+	}
+
+	s.numActiveTasks.Add(1)
+	txIndex := int(s.validationIdx.Add(1) - 1)
+
+	if txIndex < s.blockSize {
+		tx := &s.txs[txIndex]
+
+		tx.mtx.Lock()
+		if tx.status == statusExecuted {
+			v := version{txIndex: txIndex, incarnation: tx.incarnation}
+			tx.mtx.Unlock()
+
+			return v, true
+		}
+		tx.mtx.Unlock()
+	}
+
+	s.numActiveTasks.Add(-1)
+	return version{}, false
+}
+
+// NextTask returns the next task to be performed, favoring the task of the
+// lowest tx index, or noTask if no task is available at the moment.
+func (s *Scheduler) NextTask() task {
+	if s.validationIdx.Load() < s.executionIdx.Load() {
+		if v, ok := s.nextVersionToValidate(); ok {
+			return task{kind: taskValidation, version: v}
+		}
+	} else {
+		if v, ok := s.nextVersionToExecute(); ok {
+			return task{kind: taskExecution, version: v}
+		}
+	}
+
+	return noTask
+}
+
+// AddDependency suspends the given tx until the blocking tx is re-executed. It
+// returns false if the blocking tx has already been re-executed, in which case
+// the given tx should be re-executed immediately.
+func (s *Scheduler) AddDependency(txIndex, blockingTxIndex int) bool {
+	blocking := &s.txs[blockingTxIndex]
+
+	blocking.dependenciesMtx.Lock()
+	defer blocking.dependenciesMtx.Unlock()
+
+	blocking.mtx.Lock()
+	executed := blocking.status == statusExecuted
+	blocking.mtx.Unlock()
+
+	if executed {
+		return false
+	}
+
+	tx := &s.txs[txIndex]
+	tx.mtx.Lock()
+	tx.status = statusAborting
+	tx.mtx.Unlock()
+
+	blocking.dependencies = append(blocking.dependencies, txIndex)
+	s.numActiveTasks.Add(-1)
+
+	return true
+}
+
+func (s *Scheduler) setReadyStatus(txIndex int) {
+	tx := &s.txs[txIndex]
+
+	tx.mtx.Lock()
+	tx.incarnation++
+	tx.status = statusReadyToExecute
+	tx.mtx.Unlock()
+}
+
+func (s *Scheduler) resumeDependencies(dependencies []int) {
+	if len(dependencies) == 0 {
+		return
+	}
+
+	minDependency := dependencies[0]
+	for _, txIndex := range dependencies {
+		s.setReadyStatus(txIndex)
+		minDependency = min(minDependency, txIndex)
+	}
+
+	s.decreaseExecutionIdx(minDependency)
+}
+
+// FinishExecution marks the incarnation as executed, resuming every tx waiting
+// on it. A validation task of the incarnation may be returned for the caller to
+// perform right away.
+func (s *Scheduler) FinishExecution(v version, wroteNewLocation bool) task {
+	tx := &s.txs[v.txIndex]
+
+	tx.mtx.Lock()
+	tx.status = statusExecuted
+	tx.mtx.Unlock()
+
+	tx.dependenciesMtx.Lock()
+	dependencies := tx.dependencies
+	tx.dependencies = nil
+	tx.dependenciesMtx.Unlock()
+
+	s.resumeDependencies(dependencies)
+
+	if s.validationIdx.Load() > int64(v.txIndex) {
+		if !wroteNewLocation {
+			return task{kind: taskValidation, version: v}
+		}
+
+		// every subsequent tx must be re-validated, as they may have missed the
+		// new location
+		s.decreaseValidationIdx(v.txIndex)
+	}
+
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
+
+// TryValidationAbort transitions the incarnation to aborting, returning false if
+// it has already been aborted.
+func (s *Scheduler) TryValidationAbort(v version) bool {
+	tx := &s.txs[v.txIndex]
+
+	tx.mtx.Lock()
+	defer tx.mtx.Unlock()
+
+	if tx.incarnation == v.incarnation && tx.status == statusExecuted {
+		tx.status = statusAborting
+		return true
+	}
+
+	return false
+}
+
+// FinishValidation completes the validation of the incarnation. If it has been
+// aborted, every subsequent tx must be re-validated and an execution task of the
+// tx's next incarnation may be returned for the caller to perform right away.
+func (s *Scheduler) FinishValidation(txIndex int, aborted bool) task {
+	if aborted {
+		s.setReadyStatus(txIndex)
+		s.decreaseValidationIdx(txIndex + 1)
+
+		if s.executionIdx.Load() > int64(txIndex) {
+			if v, ok := s.tryIncarnate(txIndex); ok {
+				return task{kind: taskExecution, version: v}
+			}
+
+			return noTask
+		}
+	}
+
+	s.numActiveTasks.Add(-1)
+	return noTask
+}
@@ -0,0 +1,345 @@
+package blockstm
+
+import (
+	"io"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	_ storetypes.MultiStore      = (*multiStoreView)(nil)
+	_ storetypes.KVStore         = (*kvStoreView)(nil)
+	_ storetypes.CacheMultiStore = (*cacheMultiStore)(nil)
+)
+
+// multiStoreView defines the MultiStore an incarnation of a tx is executed
+// against. Reads are served from the writes of the incarnation itself, then from
+// the values written by prior txs in the MVMemory and lastly from the base
+// store, where every read not served by the incarnation's own writes is
+// recorded in its read set.
+//
+// If a read observes an estimate, the incarnation is aborted as it depends on a
+// tx that is yet to be re-executed. Once aborted, reads return empty values so
+// that the execution completes as soon as possible, after which its results are
+// discarded.
+type multiStoreView struct {
+	mv      *MVMemory
+	txIndex int
+
+	stores  map[storetypes.StoreKey]*kvStoreView
+	readSet *readSet
+
+	// blocking reflects the index of the tx that the incarnation depends on, or
+	// baseTxIndex if it has not been aborted
+	blocking int
+}
+
+func newMultiStoreView(mv *MVMemory, txIndex int) *multiStoreView {
+	return &multiStoreView{
+		mv:       mv,
+		txIndex:  txIndex,
+		stores:   make(map[storetypes.StoreKey]*kvStoreView),
+		readSet:  &readSet{},
+		blocking: baseTxIndex,
+	}
+}
+
+func (s *multiStoreView) aborted() bool {
+	return s.blocking != baseTxIndex
+}
+
+func (s *multiStoreView) abort(blocking int) {
+	if !s.aborted() {
+		s.blocking = blocking
+	}
+}
+
+// writeSet returns every write of the incarnation, ordered by store key name and
+// key.
+func (s *multiStoreView) writeSet() WriteSet {
+	storeKeys := maps.Keys(s.stores)
+	slices.SortFunc(storeKeys, func(a, b storetypes.StoreKey) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	var ws WriteSet
+	for _, storeKey := range storeKeys {
+		kvStore := s.stores[storeKey]
+
+		keys := maps.Keys(kvStore.writes)
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			ws = append(ws, write{storeKey: storeKey, key: key, value: kvStore.writes[key]})
+		}
+	}
+
+	return ws
+}
+
+func (s *multiStoreView) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (s *multiStoreView) CacheWrap() storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
+
+func (s *multiStoreView) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+func (s *multiStoreView) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(s)
+}
+
+func (s *multiStoreView) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	return s.mv.base.CacheMultiStoreWithVersion(version)
+}
+
+func (s *multiStoreView) GetStore(storeKey storetypes.StoreKey) storetypes.Store {
+	return s.GetKVStore(storeKey)
+}
+
+func (s *multiStoreView) GetKVStore(storeKey storetypes.StoreKey) storetypes.KVStore {
+	kvStore, ok := s.stores[storeKey]
+	if !ok {
+		kvStore = &kvStoreView{
+			view:     s,
+			storeKey: storeKey,
+			writes:   make(map[string][]byte),
+		}
+		s.stores[storeKey] = kvStore
+	}
+
+	return kvStore
+}
+
+func (s *multiStoreView) TracingEnabled() bool {
+	return false
+}
+
+func (s *multiStoreView) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return s
+}
+
+func (s *multiStoreView) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return s
+}
+
+func (s *multiStoreView) LatestVersion() int64 {
+	return s.mv.base.LatestVersion()
+}
+
+// kvStoreView defines the KVStore of a single store key of a multiStoreView,
+// which buffers the writes of the incarnation, where a nil value denotes a
+// deletion.
+type kvStoreView struct {
+	view     *multiStoreView
+	storeKey storetypes.StoreKey
+	writes   map[string][]byte
+}
+
+func (s *kvStoreView) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeIAVL
+}
+
+func (s *kvStoreView) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *kvStoreView) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *kvStoreView) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	if value, ok := s.writes[string(key)]; ok {
+		return value
+	}
+
+	if s.view.aborted() {
+		return nil
+	}
+
+	if mvs := s.view.mv.getStore(s.storeKey); mvs != nil {
+		if entry, ok := mvs.read(string(key), s.view.txIndex); ok {
+			if entry.estimate {
+				s.view.abort(entry.version.txIndex)
+				return nil
+			}
+
+			s.recordRead(key, entry.value, entry.version)
+			return entry.value
+		}
+	}
+
+	value := s.view.mv.base.GetKVStore(s.storeKey).Get(key)
+	s.recordRead(key, value, baseVersion)
+
+	return value
+}
+
+func (s *kvStoreView) recordRead(key, value []byte, v version) {
+	s.view.readSet.reads = append(s.view.readSet.reads, read{storeKey: s.storeKey, key: string(key), value: value, version: v})
+}
+
+func (s *kvStoreView) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *kvStoreView) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	s.writes[string(key)] = slices.Clone(value)
+}
+
+func (s *kvStoreView) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	s.writes[string(key)] = nil
+}
+
+func (s *kvStoreView) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *kvStoreView) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// iterator returns an iterator over the state observed by the incarnation,
+// overlaid with its own writes. The range read from the MVMemory and the base
+// store is recorded in the incarnation's read set.
+func (s *kvStoreView) iterator(start, end []byte, reverse bool) storetypes.Iterator {
+	var parent storetypes.Iterator = emptyIterator{start: start, end: end}
+	if !s.view.aborted() {
+		it := &iteration{storeKey: s.storeKey, start: start, end: end, reverse: reverse}
+
+		itr, blocking := s.view.mv.newIterator(s.storeKey, start, end, reverse, s.view.txIndex, it)
+		if blocking != baseTxIndex {
+			s.view.abort(blocking)
+		} else {
+			s.view.readSet.iterations = append(s.view.readSet.iterations, it)
+			parent = itr
+		}
+	}
+
+	var overlay []overlayItem
+	for key, value := range s.writes {
+		if (start != nil && key < string(start)) || (end != nil && key >= string(end)) {
+			continue
+		}
+
+		overlay = append(overlay, overlayItem{key: key, value: value})
+	}
+
+	slices.SortFunc(overlay, func(a, b overlayItem) int {
+		if reverse {
+			return strings.Compare(b.key, a.key)
+		}
+
+		return strings.Compare(a.key, b.key)
+	})
+
+	return newMergeIterator(parent, overlay, start, end, reverse, nil)
+}
+
+// emptyIterator defines an iterator over an empty range, used once an
+// incarnation has been aborted.
+type emptyIterator struct {
+	start, end []byte
+}
+
+func (itr emptyIterator) Domain() ([]byte, []byte) { return itr.start, itr.end }
+func (itr emptyIterator) Valid() bool              { return false }
+func (itr emptyIterator) Next()                    { panic("iterator is invalid") }
+func (itr emptyIterator) Key() []byte              { panic("iterator is invalid") }
+func (itr emptyIterator) Value() []byte            { panic("iterator is invalid") }
+func (itr emptyIterator) Error() error             { return nil }
+func (itr emptyIterator) Close() error             { return nil }
+
+// cacheMultiStore defines a CacheMultiStore that lazily branches every KVStore
+// of its parent upon first access. Unlike the cachemulti package, the set of
+// store keys need not be known upfront.
+type cacheMultiStore struct {
+	parent storetypes.MultiStore
+	stores map[storetypes.StoreKey]storetypes.CacheKVStore
+}
+
+func newCacheMultiStore(parent storetypes.MultiStore) *cacheMultiStore {
+	return &cacheMultiStore{
+		parent: parent,
+		stores: make(map[storetypes.StoreKey]storetypes.CacheKVStore),
+	}
+}
+
+func (s *cacheMultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (s *cacheMultiStore) CacheWrap() storetypes.CacheWrap {
+	return s.CacheMultiStore()
+}
+
+func (s *cacheMultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return s.CacheWrap()
+}
+
+func (s *cacheMultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	return newCacheMultiStore(s)
+}
+
+func (s *cacheMultiStore) CacheMultiStoreWithVersion(version int64) (storetypes.CacheMultiStore, error) {
+	return s.parent.CacheMultiStoreWithVersion(version)
+}
+
+func (s *cacheMultiStore) GetStore(storeKey storetypes.StoreKey) storetypes.Store {
+	return s.GetKVStore(storeKey)
+}
+
+func (s *cacheMultiStore) GetKVStore(storeKey storetypes.StoreKey) storetypes.KVStore {
+	kvStore, ok := s.stores[storeKey]
+	if !ok {
+		kvStore = cachekv.NewStore(s.parent.GetKVStore(storeKey))
+		s.stores[storeKey] = kvStore
+	}
+
+	return kvStore
+}
+
+func (s *cacheMultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (s *cacheMultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return s
+}
+
+func (s *cacheMultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return s
+}
+
+func (s *cacheMultiStore) LatestVersion() int64 {
+	return s.parent.LatestVersion()
+}
+
+// Write writes the branched KVStores to the parent in store key name order.
+func (s *cacheMultiStore) Write() {
+	storeKeys := maps.Keys(s.stores)
+	slices.SortFunc(storeKeys, func(a, b storetypes.StoreKey) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	for _, storeKey := range storeKeys {
+		s.stores[storeKey].Write()
+	}
+}
@@ -0,0 +1,83 @@
+package blockstm
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Trace defines the reads and writes of a single tx executed on top of a base
+// store. As the outcome of a deterministic tx only depends on the values it
+// reads, its execution may be reused on top of any other base store in which
+// every read observes the same values, by applying its writes in lieu of
+// executing it again.
+type Trace struct {
+	readSet  *readSet
+	writeSet WriteSet
+}
+
+// Record executes a single tx against the given base store, which is not
+// written to, and returns the trace of its execution. Every state access of the
+// tx must go through the provided MultiStore.
+func Record(base storetypes.MultiStore, execute func(ms storetypes.MultiStore)) *Trace {
+	view := newMultiStoreView(NewMVMemory(base, 1), 0)
+	execute(view)
+
+	return &Trace{
+		readSet:  view.readSet,
+		writeSet: view.writeSet(),
+	}
+}
+
+// WriteSet returns the writes of the tx, which must be written to the base store
+// to reflect the state after executing the tx.
+func (t *Trace) WriteSet() WriteSet {
+	return t.writeSet
+}
+
+// Validate returns true if every read of the tx observes the same value on top
+// of the given base store, i.e. if executing the tx on top of it would result
+// in the same writes.
+func (t *Trace) Validate(base storetypes.MultiStore) bool {
+	for _, r := range t.readSet.reads {
+		if !sameValue(base.GetKVStore(r.storeKey).Get([]byte(r.key)), r.value) {
+			return false
+		}
+	}
+
+	for _, it := range t.readSet.iterations {
+		if !validateTracedIteration(base, it) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validateTracedIteration(base storetypes.MultiStore, it *iteration) bool {
+	kvStore := base.GetKVStore(it.storeKey)
+
+	var itr storetypes.Iterator
+	if it.reverse {
+		itr = kvStore.ReverseIterator(it.start, it.end)
+	} else {
+		itr = kvStore.Iterator(it.start, it.end)
+	}
+	defer itr.Close()
+
+	for _, obs := range it.observed {
+		if !itr.Valid() || string(itr.Key()) != obs.key || !sameValue(itr.Value(), obs.value) {
+			return false
+		}
+
+		itr.Next()
+	}
+
+	return !it.exhausted || !itr.Valid()
+}
+
+// sameValue returns true if both values are equal, distinguishing a missing
+// value from an empty one.
+func sameValue(a, b []byte) bool {
+	return (a == nil) == (b == nil) && bytes.Equal(a, b)
+}
@@ -0,0 +1,95 @@
+package blockstm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+func TestTrace(t *testing.T) {
+	// the tx reads a counter, iterates over the second store and writes the sum
+	// of both to another counter
+	execute := func(ms storetypes.MultiStore) {
+		msCache := ms.CacheMultiStore()
+		store1 := msCache.GetKVStore(storeKey1)
+		store2 := msCache.GetKVStore(storeKey2)
+
+		sum := decodeUint64(store1.Get(counterKey(1)))
+
+		itr := store2.Iterator(nil, nil)
+		for ; itr.Valid(); itr.Next() {
+			sum += decodeUint64(itr.Value())
+		}
+		itr.Close()
+
+		store1.Set(counterKey(0), encodeUint64(sum))
+		store1.Delete(counterKey(2))
+		msCache.Write()
+	}
+
+	newBase := func() storetypes.CacheMultiStore {
+		ms := newBaseStore(t)
+		ms.GetKVStore(storeKey2).Set([]byte("a"), encodeUint64(100))
+		return ms
+	}
+
+	base := newBase()
+	trace := Record(base, execute)
+
+	// the base store is not written to
+	require.Equal(t, encodeUint64(0), base.GetKVStore(storeKey1).Get(counterKey(0)))
+	require.True(t, trace.Validate(base))
+
+	// applying the writes is equivalent to executing the tx
+	expected := newBase()
+	execute(expected)
+	trace.WriteSet().Write(base)
+	requireEqualStores(t, expected, base)
+
+	testCases := []struct {
+		name   string
+		modify func(ms storetypes.MultiStore)
+		valid  bool
+	}{
+		{
+			"unrelated write",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey1).Set(counterKey(5), encodeUint64(50)) },
+			true,
+		},
+		{
+			"overwritten key",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey1).Set(counterKey(0), encodeUint64(50)) },
+			true,
+		},
+		{
+			"read key changed",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey1).Set(counterKey(1), encodeUint64(50)) },
+			false,
+		},
+		{
+			"read key deleted",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey1).Delete(counterKey(1)) },
+			false,
+		},
+		{
+			"iterated value changed",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey2).Set([]byte("a"), encodeUint64(50)) },
+			false,
+		},
+		{
+			"key inserted in iterated range",
+			func(ms storetypes.MultiStore) { ms.GetKVStore(storeKey2).Set([]byte("b"), encodeUint64(50)) },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ms := newBase()
+			tc.modify(ms)
+			require.Equal(t, tc.valid, trace.Validate(ms))
+		})
+	}
+}
@@ -31,6 +31,8 @@ type OptimisticExecution struct {
 	cancelFunc  func() // cancel function for the context
 	initialized bool   // A boolean value indicating whether the struct has been initialized
 
+	partialReuse bool // whether the execution of txs is reused once the OE is aborted
+
 	// debugging/testing options
 	abortRate int // number from 0 to 100 that determines the percentage of OE that should be aborted
 }
@@ -54,6 +56,29 @@ func WithAbortRate(rate int) func(*OptimisticExecution) {
 	}
 }
 
+// WithPartialReuse enables the reuse of the execution of the txs of an aborted
+// OE. The execution of every tx is recorded while the OE runs, along with the
+// state it reads, and reused once the block is executed again, i.e. when the
+// final block only shares some txs with the proposal executed optimistically,
+// provided its height and time are unchanged and every read of the tx observes
+// the same state.
+//
+// NOTE: Txs are assumed to depend on the block header only through its height
+// and time, thus this must not be enabled if txs depend on e.g. the proposer,
+// the hash or the last commit of the block. Txs executed in parallel using
+// Block-STM are not reused.
+func WithPartialReuse() func(*OptimisticExecution) {
+	return func(oe *OptimisticExecution) {
+		oe.partialReuse = true
+	}
+}
+
+// PartialReuse returns true if the execution of the txs of an aborted OE is
+// reused, see WithPartialReuse.
+func (oe *OptimisticExecution) PartialReuse() bool {
+	return oe != nil && oe.partialReuse
+}
+
 // Reset resets the OE context. Must be called whenever we want to invalidate
 // the current OE.
 func (oe *OptimisticExecution) Reset() {
@@ -0,0 +1,109 @@
+package baseapp
+
+import (
+	"crypto/sha256"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/internal/blockstm"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// oeTxCache caches the execution of the txs executed optimistically for the
+// block of a given height and time, keyed by tx hash.
+type oeTxCache struct {
+	height int64
+	time   time.Time
+	txs    map[[sha256.Size]byte]*oeTx
+}
+
+// oeTx defines the execution of a tx, i.e. the trace of its state accesses,
+// its result and the block gas it consumed.
+type oeTx struct {
+	trace    *blockstm.Trace
+	result   *abci.ExecTxResult
+	blockGas uint64
+}
+
+// get returns the execution of the tx recorded for the block of the given
+// height and time, if any.
+func (c *oeTxCache) get(height int64, blockTime time.Time, hash [sha256.Size]byte) (*oeTx, bool) {
+	if c.height != height || !c.time.Equal(blockTime) {
+		return nil, false
+	}
+
+	tx, ok := c.txs[hash]
+	return tx, ok
+}
+
+// set records the execution of the tx for the block of the given height and
+// time, dropping the executions recorded for any other block.
+func (c *oeTxCache) set(height int64, blockTime time.Time, hash [sha256.Size]byte, tx *oeTx) {
+	if c.txs == nil || c.height != height || !c.time.Equal(blockTime) {
+		*c = oeTxCache{height: height, time: blockTime, txs: make(map[[sha256.Size]byte]*oeTx)}
+	}
+
+	c.txs[hash] = tx
+}
+
+// reset drops every recorded execution.
+func (c *oeTxCache) reset() {
+	*c = oeTxCache{}
+}
+
+// deliverTxReusingOE delivers the tx, reusing its execution by a prior OE of the
+// block if every read of the tx observes the same state, see
+// oe.WithPartialReuse. While the OE runs, the execution of the tx is recorded
+// for reuse.
+//
+// NOTE: A reused tx is not removed from the mempool again, as it was already
+// removed when executed optimistically.
+func (app *BaseApp) deliverTxReusingOE(rawTx []byte) *abci.ExecTxResult {
+	ms := app.finalizeBlockState.ms
+	if !app.optimisticExec.PartialReuse() || ms.TracingEnabled() {
+		return app.deliverTx(rawTx)
+	}
+
+	ctx := app.getContextForTx(execModeFinalize, rawTx)
+	height, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+	hash := sha256.Sum256(rawTx)
+	blockGasMeter := ctx.BlockGasMeter()
+
+	if tx, ok := app.oeTxCache.get(height, blockTime, hash); ok && hasBlockGas(blockGasMeter, tx.blockGas) && tx.trace.Validate(ms) {
+		tx.trace.WriteSet().Write(ms)
+		blockGasMeter.ConsumeGas(tx.blockGas, "block gas meter")
+		recordTxTelemetry(tx.result)
+		telemetry.IncrCounter(1, "oe", "tx", "reused")
+
+		return tx.result
+	}
+
+	// only record the txs executed optimistically, i.e. not those executed by
+	// FinalizeBlock itself
+	if !app.optimisticExec.Initialized() {
+		return app.deliverTx(rawTx)
+	}
+
+	var result *abci.ExecTxResult
+	blockGasBefore := blockGasMeter.GasConsumed()
+	trace := blockstm.Record(ms, func(txMultiStore storetypes.MultiStore) {
+		result = app.deliverTxWithContext(ctx.WithMultiStore(txMultiStore), rawTx)
+	})
+	trace.WriteSet().Write(ms)
+	recordTxTelemetry(result)
+
+	// the result of a tx running out of block gas depends on the txs preceding
+	// it, thus it is not reused
+	if !blockGasMeter.IsOutOfGas() {
+		app.oeTxCache.set(height, blockTime, hash, &oeTx{
+			trace:    trace,
+			result:   result,
+			blockGas: blockGasMeter.GasConsumed() - blockGasBefore,
+		})
+	}
+
+	return result
+}
@@ -49,6 +49,20 @@ func SetQueryGasLimit(queryGasLimit uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.queryGasLimit = queryGasLimit }
 }
 
+// SetSimulateBundleLimits returns an option that sets the maximum number of txs
+// of a bundle simulated by SimulateBundle, and the maximum gas they may consume
+// in total. A zero value keeps the corresponding default.
+func SetSimulateBundleLimits(maxTxs int, maxGas uint64) func(*BaseApp) {
+	return func(bapp *BaseApp) {
+		if maxTxs > 0 {
+			bapp.simulateBundleMaxTxs = maxTxs
+		}
+		if maxGas > 0 {
+			bapp.simulateBundleMaxGas = maxGas
+		}
+	}
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.setHaltHeight(blockHeight) }
@@ -114,6 +128,17 @@ func SetOptimisticExecution(opts ...func(*oe.OptimisticExecution)) func(*BaseApp
 	}
 }
 
+// SetBlockSTMWorkers enables parallel execution of the transactions of a block
+// using Block-STM with the given number of workers. If 0, transactions are
+// executed serially.
+func SetBlockSTMWorkers(workers int) func(*BaseApp) {
+	if workers < 0 {
+		panic(fmt.Sprintf("invalid number of Block-STM workers: %d", workers))
+	}
+
+	return func(app *BaseApp) { app.blockSTMWorkers = workers }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
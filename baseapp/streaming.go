@@ -7,9 +7,10 @@ import (
 
 	"github.com/spf13/cast"
 
-	"cosmossdk.io/store/streaming"
+	storestreaming "cosmossdk.io/store/streaming"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 )
@@ -20,6 +21,11 @@ const (
 	StreamingABCIPluginTomlKey        = "plugin"
 	StreamingABCIKeysTomlKey          = "keys"
 	StreamingABCIStopNodeOnErrTomlKey = "stop-node-on-err"
+
+	StreamingGRPCTomlKey             = "grpc"
+	StreamingGRPCEnableTomlKey       = "enable"
+	StreamingGRPCKeysTomlKey         = "keys"
+	StreamingGRPCRetainBlocksTomlKey = "retain-blocks"
 )
 
 // RegisterStreamingServices registers streaming services with the BaseApp.
@@ -31,7 +37,7 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		pluginName := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey)))
 		if len(pluginName) > 0 {
 			logLevel := cast.ToString(appOpts.Get(flags.FlagLogLevel))
-			plugin, err := streaming.NewStreamingPlugin(pluginName, logLevel)
+			plugin, err := storestreaming.NewStreamingPlugin(pluginName, logLevel)
 			if err != nil {
 				return fmt.Errorf("failed to load streaming plugin: %w", err)
 			}
@@ -41,9 +47,30 @@ func (app *BaseApp) RegisterStreamingServices(appOpts servertypes.AppOptions, ke
 		}
 	}
 
+	enableKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingGRPCTomlKey, StreamingGRPCEnableTomlKey)
+	if cast.ToBool(appOpts.Get(enableKey)) {
+		app.registerStreamingServer(appOpts, keys)
+	}
+
 	return nil
 }
 
+// registerStreamingServer registers the built-in streaming.Server as an
+// ABCIListener, and its StreamService with the gRPC query router such that it
+// is served by the gRPC server of the node.
+func (app *BaseApp) registerStreamingServer(appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) {
+	keysKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingGRPCTomlKey, StreamingGRPCKeysTomlKey)
+	retainBlocksKey := fmt.Sprintf("%s.%s.%s", StreamingTomlKey, StreamingGRPCTomlKey, StreamingGRPCRetainBlocksTomlKey)
+
+	exposedKeys := exposeStoreKeysSorted(cast.ToStringSlice(appOpts.Get(keysKey)), keys)
+	app.cms.AddListeners(exposedKeys)
+
+	server := streaming.NewServer(cast.ToInt(appOpts.Get(retainBlocksKey)))
+	streaming.RegisterStreamServiceServer(app.GRPCQueryRouter(), server)
+
+	app.streamingManager.ABCIListeners = append(app.streamingManager.ABCIListeners, server)
+}
+
 // registerStreamingPlugin registers streaming plugins with the BaseApp.
 func (app *BaseApp) registerStreamingPlugin(
 	appOpts servertypes.AppOptions,
@@ -73,7 +100,7 @@ func (app *BaseApp) registerABCIListenerPlugin(
 	app.cms.AddListeners(exposedKeys)
 	app.SetStreamingManager(
 		storetypes.StreamingManager{
-			ABCIListeners: []storetypes.ABCIListener{abciListener},
+			ABCIListeners: append(app.streamingManager.ABCIListeners, abciListener),
 			StopNodeOnErr: stopNodeOnErr,
 		},
 	)
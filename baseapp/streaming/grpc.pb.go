@@ -0,0 +1,838 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/streaming/v1/grpc.proto
+
+package streaming
+
+import (
+	context "context"
+	types1 "cosmossdk.io/store/types"
+	fmt "fmt"
+	types "github.com/cometbft/cometbft/abci/types"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SubscribeBlocksRequest is the request type for the SubscribeBlocks RPC method.
+type SubscribeBlocksRequest struct {
+	// start_height defines the height of the first block to stream. If zero,
+	// the stream starts with the next committed block.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// store_keys defines the names of the stores whose changes are streamed. The
+	// changes of every store exposed by the node are streamed if empty.
+	StoreKeys []string `protobuf:"bytes,2,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+}
+
+func (m *SubscribeBlocksRequest) Reset()         { *m = SubscribeBlocksRequest{} }
+func (m *SubscribeBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksRequest) ProtoMessage()    {}
+func (*SubscribeBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fc151d30622bb2a, []int{0}
+}
+func (m *SubscribeBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksRequest.Merge(m, src)
+}
+func (m *SubscribeBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksRequest proto.InternalMessageInfo
+
+func (m *SubscribeBlocksRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *SubscribeBlocksRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+// SubscribeBlocksResponse is the response type for the SubscribeBlocks RPC
+// method, which holds a single committed block.
+type SubscribeBlocksResponse struct {
+	// block_height defines the height of the block.
+	BlockHeight int64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// finalize_block_request defines the FinalizeBlock request of the block.
+	FinalizeBlockRequest *types.RequestFinalizeBlock `protobuf:"bytes,2,opt,name=finalize_block_request,json=finalizeBlockRequest,proto3" json:"finalize_block_request,omitempty"`
+	// finalize_block_response defines the FinalizeBlock response of the block.
+	FinalizeBlockResponse *types.ResponseFinalizeBlock `protobuf:"bytes,3,opt,name=finalize_block_response,json=finalizeBlockResponse,proto3" json:"finalize_block_response,omitempty"`
+	// change_set defines the state changes made by the block, ordered by store
+	// and in the order they were written.
+	ChangeSet []*types1.StoreKVPair `protobuf:"bytes,4,rep,name=change_set,json=changeSet,proto3" json:"change_set,omitempty"`
+}
+
+func (m *SubscribeBlocksResponse) Reset()         { *m = SubscribeBlocksResponse{} }
+func (m *SubscribeBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeBlocksResponse) ProtoMessage()    {}
+func (*SubscribeBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fc151d30622bb2a, []int{1}
+}
+func (m *SubscribeBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeBlocksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeBlocksResponse.Merge(m, src)
+}
+func (m *SubscribeBlocksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeBlocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeBlocksResponse proto.InternalMessageInfo
+
+func (m *SubscribeBlocksResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SubscribeBlocksResponse) GetFinalizeBlockRequest() *types.RequestFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlockRequest
+	}
+	return nil
+}
+
+func (m *SubscribeBlocksResponse) GetFinalizeBlockResponse() *types.ResponseFinalizeBlock {
+	if m != nil {
+		return m.FinalizeBlockResponse
+	}
+	return nil
+}
+
+func (m *SubscribeBlocksResponse) GetChangeSet() []*types1.StoreKVPair {
+	if m != nil {
+		return m.ChangeSet
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SubscribeBlocksRequest)(nil), "cosmos.streaming.v1.SubscribeBlocksRequest")
+	proto.RegisterType((*SubscribeBlocksResponse)(nil), "cosmos.streaming.v1.SubscribeBlocksResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/streaming/v1/grpc.proto", fileDescriptor_3fc151d30622bb2a) }
+
+var fileDescriptor_3fc151d30622bb2a = []byte{
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0x3b, 0xad, 0x08, 0x4d, 0x15, 0x61, 0xd4, 0xdd, 0xa1, 0xe2, 0xd0, 0x5d, 0x54, 0x0a,
+	0x6a, 0x62, 0xeb, 0x17, 0x90, 0x3d, 0xc8, 0x82, 0x17, 0x99, 0x01, 0x0f, 0x2b, 0x58, 0x92, 0xec,
+	0xdb, 0x69, 0x68, 0x9b, 0x8c, 0x79, 0xe9, 0x40, 0xbd, 0xf8, 0x15, 0xfc, 0x3e, 0x7e, 0x01, 0x8f,
+	0x7b, 0xf4, 0x28, 0xed, 0x17, 0x91, 0x49, 0x86, 0x2e, 0xdb, 0xf6, 0xb0, 0xa7, 0x61, 0xfe, 0x79,
+	0xef, 0x97, 0xf7, 0xcf, 0xfb, 0x93, 0x54, 0x1a, 0x5c, 0x18, 0x64, 0xe8, 0x2c, 0xf0, 0x85, 0xd2,
+	0x05, 0xab, 0x46, 0xac, 0xb0, 0xa5, 0xa4, 0xa5, 0x35, 0xce, 0xc4, 0x8f, 0xc3, 0x39, 0xdd, 0x9e,
+	0xd3, 0x6a, 0xd4, 0x7f, 0xe6, 0x40, 0x5f, 0x82, 0x5d, 0x28, 0xed, 0x18, 0x17, 0x52, 0x31, 0xb7,
+	0x2a, 0x01, 0x43, 0x47, 0xff, 0xc5, 0x96, 0x68, 0x2c, 0xb0, 0x6a, 0x24, 0xc0, 0xf1, 0x11, 0x9b,
+	0x2b, 0x74, 0xa0, 0xeb, 0x7e, 0x5f, 0x75, 0x7a, 0x41, 0x8e, 0xf2, 0xa5, 0x40, 0x69, 0x95, 0x80,
+	0xb3, 0xb9, 0x91, 0x33, 0xcc, 0xe0, 0xfb, 0x12, 0xd0, 0xc5, 0x27, 0xe4, 0x01, 0x3a, 0x6e, 0xdd,
+	0x64, 0x0a, 0xaa, 0x98, 0xba, 0x24, 0x1a, 0x44, 0xc3, 0x4e, 0xd6, 0xf3, 0xda, 0xb9, 0x97, 0xe2,
+	0xe7, 0x84, 0x78, 0xfa, 0x64, 0x06, 0x2b, 0x4c, 0xda, 0x83, 0xce, 0xb0, 0x9b, 0x75, 0xbd, 0xf2,
+	0x09, 0x56, 0x78, 0xfa, 0xbb, 0x4d, 0x8e, 0xf7, 0xe0, 0x58, 0x1a, 0x8d, 0x50, 0xd3, 0x45, 0xad,
+	0xec, 0xd0, 0xbd, 0xd6, 0xd0, 0xbf, 0x92, 0xa3, 0x2b, 0xa5, 0xf9, 0x5c, 0xfd, 0x80, 0x49, 0xa8,
+	0xb5, 0x61, 0xb4, 0xa4, 0x3d, 0x88, 0x86, 0xbd, 0xf1, 0x4b, 0x7a, 0x63, 0x9f, 0xd6, 0xf6, 0x69,
+	0x33, 0xfa, 0xc7, 0xa6, 0xcb, 0x5f, 0x99, 0x3d, 0xb9, 0xba, 0xf5, 0xdb, 0xb8, 0xfb, 0x46, 0x8e,
+	0xf7, 0xe0, 0x61, 0xb4, 0xa4, 0xe3, 0xe9, 0xaf, 0x0e, 0xd0, 0x43, 0xc1, 0x6d, 0xfc, 0xd3, 0x1d,
+	0x7c, 0xe3, 0xef, 0x03, 0x21, 0x72, 0xca, 0x75, 0x01, 0x13, 0x04, 0x97, 0xdc, 0x1b, 0x74, 0x86,
+	0xbd, 0xf1, 0x09, 0xdd, 0x2e, 0xd1, 0x58, 0xa0, 0xcd, 0x4a, 0x68, 0xee, 0x1f, 0xec, 0xcb, 0x67,
+	0xae, 0x6c, 0xd6, 0x0d, 0x4d, 0x39, 0xb8, 0xf1, 0x4f, 0xf2, 0x30, 0xf7, 0xcb, 0xce, 0xc1, 0x56,
+	0x4a, 0x42, 0xac, 0xc9, 0xa3, 0x9d, 0xd7, 0x8c, 0x5f, 0xd3, 0x03, 0xb1, 0xa0, 0x87, 0x17, 0xda,
+	0x7f, 0x73, 0xb7, 0xe2, 0x60, 0xe0, 0x5d, 0x74, 0x76, 0xfe, 0x67, 0x9d, 0x46, 0xd7, 0xeb, 0x34,
+	0xfa, 0xb7, 0x4e, 0xa3, 0x5f, 0x9b, 0xb4, 0x75, 0xbd, 0x49, 0x5b, 0x7f, 0x37, 0x69, 0xeb, 0x82,
+	0x16, 0xca, 0x4d, 0x97, 0x82, 0x4a, 0xb3, 0x60, 0x4d, 0xca, 0xc2, 0xe7, 0x2d, 0x5e, 0xce, 0x98,
+	0xe0, 0x08, 0xbc, 0x2c, 0x6f, 0xa2, 0x2c, 0xee, 0xfb, 0xac, 0xbd, 0xff, 0x3f, 0x00, 0x62, 0xfc,
+	0x7a, 0x63, 0xe5, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StreamServiceClient is the client API for StreamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StreamServiceClient interface {
+	// SubscribeBlocks streams every committed block starting at the requested
+	// height. Consumers may resume an interrupted stream from the height
+	// following the last block they received, as long as the node retains it.
+	SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (StreamService_SubscribeBlocksClient, error)
+}
+
+type streamServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStreamServiceClient(cc grpc1.ClientConn) StreamServiceClient {
+	return &streamServiceClient{cc}
+}
+
+func (c *streamServiceClient) SubscribeBlocks(ctx context.Context, in *SubscribeBlocksRequest, opts ...grpc.CallOption) (StreamService_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StreamService_serviceDesc.Streams[0], "/cosmos.streaming.v1.StreamService/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &streamServiceSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StreamService_SubscribeBlocksClient interface {
+	Recv() (*SubscribeBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type streamServiceSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *streamServiceSubscribeBlocksClient) Recv() (*SubscribeBlocksResponse, error) {
+	m := new(SubscribeBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StreamServiceServer is the server API for StreamService service.
+type StreamServiceServer interface {
+	// SubscribeBlocks streams every committed block starting at the requested
+	// height. Consumers may resume an interrupted stream from the height
+	// following the last block they received, as long as the node retains it.
+	SubscribeBlocks(*SubscribeBlocksRequest, StreamService_SubscribeBlocksServer) error
+}
+
+// UnimplementedStreamServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedStreamServiceServer struct {
+}
+
+func (*UnimplementedStreamServiceServer) SubscribeBlocks(req *SubscribeBlocksRequest, srv StreamService_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+
+func RegisterStreamServiceServer(s grpc1.Server, srv StreamServiceServer) {
+	s.RegisterService(&_StreamService_serviceDesc, srv)
+}
+
+func _StreamService_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StreamServiceServer).SubscribeBlocks(m, &streamServiceSubscribeBlocksServer{stream})
+}
+
+type StreamService_SubscribeBlocksServer interface {
+	Send(*SubscribeBlocksResponse) error
+	grpc.ServerStream
+}
+
+type streamServiceSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *streamServiceSubscribeBlocksServer) Send(m *SubscribeBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StreamService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.streaming.v1.StreamService",
+	HandlerType: (*StreamServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _StreamService_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/streaming/v1/grpc.proto",
+}
+
+func (m *SubscribeBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintGrpc(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeBlocksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeBlocksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChangeSet) > 0 {
+		for iNdEx := len(m.ChangeSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChangeSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGrpc(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FinalizeBlockResponse != nil {
+		{
+			size, err := m.FinalizeBlockResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FinalizeBlockRequest != nil {
+		{
+			size, err := m.FinalizeBlockRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGrpc(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintGrpc(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGrpc(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGrpc(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SubscribeBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.StartHeight))
+	}
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovGrpc(uint64(m.BlockHeight))
+	}
+	if m.FinalizeBlockRequest != nil {
+		l = m.FinalizeBlockRequest.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if m.FinalizeBlockResponse != nil {
+		l = m.FinalizeBlockResponse.Size()
+		n += 1 + l + sovGrpc(uint64(l))
+	}
+	if len(m.ChangeSet) > 0 {
+		for _, e := range m.ChangeSet {
+			l = e.Size()
+			n += 1 + l + sovGrpc(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGrpc(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGrpc(x uint64) (n int) {
+	return sovGrpc(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SubscribeBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockRequest == nil {
+				m.FinalizeBlockRequest = &types.RequestFinalizeBlock{}
+			}
+			if err := m.FinalizeBlockRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizeBlockResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalizeBlockResponse == nil {
+				m.FinalizeBlockResponse = &types.ResponseFinalizeBlock{}
+			}
+			if err := m.FinalizeBlockResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangeSet = append(m.ChangeSet, &types1.StoreKVPair{})
+			if err := m.ChangeSet[len(m.ChangeSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGrpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGrpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGrpc(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGrpc
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGrpc
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGrpc
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGrpc
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGrpc
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGrpc        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGrpc          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGrpc = fmt.Errorf("proto: unexpected end of group")
+)
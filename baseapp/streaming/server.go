@@ -0,0 +1,227 @@
+package streaming
+
+import (
+	"context"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ storetypes.ABCIListener = (*Server)(nil)
+	_ StreamServiceServer     = (*Server)(nil)
+)
+
+// Server defines an in-process ABCIListener which retains the most recent
+// blocks committed by the node, along with the state changes they made, and
+// streams them to the subscribers of the StreamService.
+//
+// Each subscriber reads the retained blocks at its own pace through a height
+// cursor. Commit never waits for subscribers: a subscriber falling behind the
+// retained blocks is dropped with an OutOfRange error.
+//
+// The retained blocks are only kept in memory, thus they are lost when the node
+// restarts. A subscriber resuming from a height committed before the restart is
+// dropped with an OutOfRange error until the node committed that height again,
+// and must then recover the missed blocks by other means, e.g. by querying the
+// node.
+type Server struct {
+	retainBlocks int
+
+	mtx sync.Mutex
+	// cond is broadcast whenever a block is committed or a subscriber leaves.
+	cond *sync.Cond
+	// pending is the block whose FinalizeBlock was received but which was not
+	// committed yet.
+	pending *SubscribeBlocksResponse
+	// blocks are the retained blocks, ordered by height.
+	blocks []*SubscribeBlocksResponse
+	// cursors holds the height of the next block to send to each subscriber,
+	// where zero denotes the first block committed after it subscribed.
+	cursors map[uint64]int64
+	nextID  uint64
+}
+
+// NewServer returns a Server retaining the given number of blocks.
+func NewServer(retainBlocks int) *Server {
+	if retainBlocks < 1 {
+		retainBlocks = 1
+	}
+
+	s := &Server{
+		retainBlocks: retainBlocks,
+		cursors:      make(map[uint64]int64),
+	}
+	s.cond = sync.NewCond(&s.mtx)
+
+	return s
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (s *Server) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.pending = &SubscribeBlocksResponse{
+		BlockHeight:           req.Height,
+		FinalizeBlockRequest:  &req,
+		FinalizeBlockResponse: &res,
+	}
+
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (s *Server) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	block := s.pending
+	s.pending = nil
+	if block == nil {
+		block = &SubscribeBlocksResponse{BlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight()}
+	}
+	block.ChangeSet = changeSet
+
+	s.blocks = append(s.blocks, block)
+	if len(s.blocks) > s.retainBlocks {
+		s.blocks[0] = nil
+		s.blocks = s.blocks[1:]
+	}
+
+	s.cond.Broadcast()
+
+	return nil
+}
+
+// SubscribeBlocks implements the StreamService service.
+func (s *Server) SubscribeBlocks(req *SubscribeBlocksRequest, stream StreamService_SubscribeBlocksServer) error {
+	if req.StartHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid start height %d", req.StartHeight)
+	}
+
+	storeKeys := make(map[string]bool, len(req.StoreKeys))
+	for _, key := range req.StoreKeys {
+		storeKeys[key] = true
+	}
+
+	ctx := stream.Context()
+	id := s.subscribe(req.StartHeight)
+	defer s.unsubscribe(id)
+
+	// wake up the subscriber once it leaves, as it may be waiting for a block
+	stop := context.AfterFunc(ctx, func() {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+
+		s.cond.Broadcast()
+	})
+	defer stop()
+
+	for {
+		block, err := s.nextBlock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if block == nil {
+			return nil
+		}
+
+		if err := stream.Send(filterChangeSet(block, storeKeys)); err != nil {
+			return err
+		}
+
+		s.advance(id, block.BlockHeight+1)
+	}
+}
+
+// subscribe registers a subscriber starting at the given height, or at the
+// next committed block if zero.
+func (s *Server) subscribe(startHeight int64) uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if startHeight == 0 && len(s.blocks) > 0 {
+		startHeight = s.blocks[len(s.blocks)-1].BlockHeight + 1
+	}
+
+	id := s.nextID
+	s.nextID++
+	s.cursors[id] = startHeight
+
+	return id
+}
+
+// unsubscribe removes the subscriber.
+func (s *Server) unsubscribe(id uint64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	delete(s.cursors, id)
+}
+
+// advance sets the cursor of the subscriber to the given height.
+func (s *Server) advance(id uint64, height int64) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.cursors[id] = height
+}
+
+// nextBlock waits for the block at the cursor of the subscriber, returning nil
+// once the context of the subscriber is done.
+func (s *Server) nextBlock(ctx context.Context, id uint64) (*SubscribeBlocksResponse, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for {
+		if ctx.Err() != nil {
+			return nil, nil
+		}
+
+		if len(s.blocks) > 0 {
+			cursor := s.cursors[id]
+			oldest, latest := s.blocks[0].BlockHeight, s.blocks[len(s.blocks)-1].BlockHeight
+
+			switch {
+			case cursor == 0:
+				// the subscriber waits for the first block committed after it
+				// subscribed, which is the oldest one since none were retained
+				s.cursors[id] = oldest
+				return s.blocks[0], nil
+
+			case cursor < oldest:
+				return nil, status.Errorf(codes.OutOfRange, "block %d is no longer retained, the oldest retained block is %d", cursor, oldest)
+
+			case cursor <= latest:
+				return s.blocks[cursor-oldest], nil
+			}
+		}
+
+		s.cond.Wait()
+	}
+}
+
+// filterChangeSet returns the block with the changes of the given stores only,
+// or the block itself if no stores are given.
+func filterChangeSet(block *SubscribeBlocksResponse, storeKeys map[string]bool) *SubscribeBlocksResponse {
+	if len(storeKeys) == 0 {
+		return block
+	}
+
+	filtered := *block
+	filtered.ChangeSet = nil
+	for _, pair := range block.ChangeSet {
+		if storeKeys[pair.StoreKey] {
+			filtered.ChangeSet = append(filtered.ChangeSet, pair)
+		}
+	}
+
+	return &filtered
+}
@@ -0,0 +1,167 @@
+package streaming_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp/streaming"
+)
+
+type mockStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	blocks chan *streaming.SubscribeBlocksResponse
+}
+
+func newMockStream(ctx context.Context) *mockStream {
+	return &mockStream{ctx: ctx, blocks: make(chan *streaming.SubscribeBlocksResponse, 10)}
+}
+
+func (m *mockStream) Context() context.Context { return m.ctx }
+
+func (m *mockStream) Send(res *streaming.SubscribeBlocksResponse) error {
+	select {
+	case m.blocks <- res:
+		return nil
+	case <-m.ctx.Done():
+		return m.ctx.Err()
+	}
+}
+
+func (m *mockStream) next(t *testing.T) *streaming.SubscribeBlocksResponse {
+	t.Helper()
+
+	select {
+	case res := <-m.blocks:
+		return res
+	case <-time.After(time.Second):
+		t.Fatal("expected a block")
+		return nil
+	}
+}
+
+func commitBlock(t *testing.T, s *streaming.Server, height int64) {
+	t.Helper()
+
+	changeSet := []*storetypes.StoreKVPair{
+		{StoreKey: "acc", Key: []byte{byte(height)}, Value: []byte("acc")},
+		{StoreKey: "bank", Key: []byte{byte(height)}, Value: []byte("bank")},
+	}
+
+	require.NoError(t, s.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: height}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, s.ListenCommit(context.Background(), abci.ResponseCommit{}, changeSet))
+}
+
+func subscribe(s *streaming.Server, req *streaming.SubscribeBlocksRequest) (*mockStream, context.CancelFunc, <-chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMockStream(ctx)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.SubscribeBlocks(req, stream) }()
+
+	return stream, cancel, errCh
+}
+
+func TestServer_SubscribeBlocks(t *testing.T) {
+	s := streaming.NewServer(3)
+	for height := int64(1); height <= 4; height++ {
+		commitBlock(t, s, height)
+	}
+
+	// blocks are replayed from the start height, with the changes of the
+	// requested stores only
+	stream, cancel, errCh := subscribe(s, &streaming.SubscribeBlocksRequest{StartHeight: 3, StoreKeys: []string{"bank"}})
+	for height := int64(3); height <= 4; height++ {
+		res := stream.next(t)
+		require.Equal(t, height, res.BlockHeight)
+		require.Equal(t, height, res.FinalizeBlockRequest.Height)
+		require.Len(t, res.ChangeSet, 1)
+		require.Equal(t, "bank", res.ChangeSet[0].StoreKey)
+	}
+
+	// newly committed blocks are streamed as they are committed
+	commitBlock(t, s, 5)
+	res := stream.next(t)
+	require.Equal(t, int64(5), res.BlockHeight)
+
+	cancel()
+	require.NoError(t, <-errCh)
+
+	// a zero start height streams the blocks committed after subscribing
+	stream, cancel, errCh = subscribe(s, &streaming.SubscribeBlocksRequest{})
+	height := int64(6)
+	for res = nil; res == nil; height++ {
+		commitBlock(t, s, height)
+
+		select {
+		case res = <-stream.blocks:
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	require.Greater(t, res.BlockHeight, int64(5))
+	require.Len(t, res.ChangeSet, 2)
+
+	cancel()
+	require.NoError(t, <-errCh)
+
+	// blocks which are no longer retained cannot be streamed
+	_, cancel, errCh = subscribe(s, &streaming.SubscribeBlocksRequest{StartHeight: 1})
+	defer cancel()
+	require.Equal(t, codes.OutOfRange, status.Code(<-errCh))
+
+	_, cancel, errCh = subscribe(s, &streaming.SubscribeBlocksRequest{StartHeight: -1})
+	defer cancel()
+	require.Equal(t, codes.InvalidArgument, status.Code(<-errCh))
+}
+
+func TestServer_SlowSubscriber(t *testing.T) {
+	s := streaming.NewServer(1)
+	commitBlock(t, s, 1)
+
+	stream, cancel, errCh := subscribe(s, &streaming.SubscribeBlocksRequest{StartHeight: 1})
+	defer cancel()
+	require.Equal(t, int64(1), stream.next(t).BlockHeight)
+
+	// stall the subscriber by filling its buffer, such that it doesn't receive
+	// block 2 before blocks 3 and 4 are committed
+	for i := 0; i < cap(stream.blocks); i++ {
+		stream.blocks <- nil
+	}
+	commitBlock(t, s, 2)
+
+	committed := make(chan struct{})
+	go func() {
+		commitBlock(t, s, 3)
+		commitBlock(t, s, 4)
+		close(committed)
+	}()
+
+	select {
+	case <-committed:
+	case <-time.After(time.Second):
+		t.Fatal("commit should not wait for the subscriber")
+	}
+
+	// once the subscriber catches up, it is dropped as block 3 is no longer
+	// retained
+	for {
+		select {
+		case res := <-stream.blocks:
+			require.True(t, res == nil || res.BlockHeight == 2)
+		case err := <-errCh:
+			require.Equal(t, codes.OutOfRange, status.Code(err))
+			return
+		case <-time.After(time.Second):
+			t.Fatal("the subscriber should be dropped")
+		}
+	}
+}
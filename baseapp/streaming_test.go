@@ -146,3 +146,41 @@ func Test_Ctx_with_StreamingManager(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+// finalizeBlockListener records the FinalizeBlock requests it listens to.
+type finalizeBlockListener struct {
+	MockABCIListener
+	reqs []abci.RequestFinalizeBlock
+}
+
+func (l *finalizeBlockListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	l.reqs = append(l.reqs, req)
+	return nil
+}
+
+func TestABCI_ListenFinalizeBlock(t *testing.T) {
+	listener := &finalizeBlockListener{MockABCIListener: NewMockABCIListener("lis_1")}
+	streamingManager := storetypes.StreamingManager{ABCIListeners: []storetypes.ABCIListener{listener}}
+	streamingManagerOpt := func(bapp *baseapp.BaseApp) { bapp.SetStreamingManager(streamingManager) }
+	suite := NewBaseAppSuite(t, streamingManagerOpt)
+
+	_, err := suite.baseApp.InitChain(&abci.RequestInitChain{
+		ConsensusParams: &tmproto.ConsensusParams{},
+	})
+	require.NoError(t, err)
+
+	nBlocks := 3
+
+	for blockN := 0; blockN < nBlocks; blockN++ {
+		txs := [][]byte{[]byte(fmt.Sprintf("tx%d", blockN))}
+
+		_, err := suite.baseApp.FinalizeBlock(&abci.RequestFinalizeBlock{Height: int64(blockN) + 1, Txs: txs})
+		require.NoError(t, err)
+		require.Len(t, listener.reqs, blockN+1, "should be called on each FinalizeBlock")
+		require.Equal(t, int64(blockN)+1, listener.reqs[blockN].Height, "should contain the same height")
+		require.Equal(t, txs, listener.reqs[blockN].Txs, "should contain the same txs")
+
+		_, err = suite.baseApp.Commit()
+		require.NoError(t, err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -29,6 +30,45 @@ func (app *BaseApp) Simulate(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) {
 	return gasInfo, result, err
 }
 
+// simulateGasLimitKey is the context key of the gas a simulated tx may consume.
+type simulateGasLimitKey struct{}
+
+// SimulateBundle executes an ordered bundle of txs in simulate mode, where every
+// tx observes the state changes of the txs preceding it, returning the outcome
+// of every tx. As in a block, a failed tx does not abort the bundle, its state
+// changes being discarded. The bundle is executed on top of the check state,
+// which is not written to.
+//
+// Bundles with more txs than allowed by SetSimulateBundleLimits are rejected.
+// The txs run out of gas once they consumed the gas limit of the bundle in
+// total, in which case the results of the txs executed so far are returned
+// along with an ErrOutOfGas error.
+func (app *BaseApp) SimulateBundle(txs [][]byte) ([]sdk.SimulationResult, error) {
+	if len(txs) > app.simulateBundleMaxTxs {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "bundle of %d txs exceeds the limit of %d txs", len(txs), app.simulateBundleMaxTxs)
+	}
+
+	ms := app.checkState.ms.CacheMultiStore()
+	gasLeft := app.simulateBundleMaxGas
+
+	results := make([]sdk.SimulationResult, 0, len(txs))
+	for _, txBytes := range txs {
+		ctx := app.getContextForTx(execModeSimulate, txBytes).
+			WithMultiStore(ms).
+			WithGasMeter(storetypes.NewInfiniteGasMeter()).
+			WithValue(simulateGasLimitKey{}, gasLeft)
+		gasInfo, result, _, err := app.runTxWithContext(ctx, execModeSimulate, txBytes)
+		results = append(results, sdk.SimulationResult{GasInfo: gasInfo, Result: result, Err: err})
+
+		if gasInfo.GasUsed > gasLeft {
+			return results, errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "bundle exceeds the gas limit of %d", app.simulateBundleMaxGas)
+		}
+		gasLeft -= gasInfo.GasUsed
+	}
+
+	return results, nil
+}
+
 func (app *BaseApp) SimDeliver(txEncoder sdk.TxEncoder, tx sdk.Tx) (sdk.GasInfo, *sdk.Result, error) {
 	// See comment for Check().
 	bz, err := txEncoder(tx)
@@ -0,0 +1,44 @@
+package mempool
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// MempoolAutoCLIDescriptor is the AutoCLI descriptor of the mempool query
+// service of the node.
+var MempoolAutoCLIDescriptor = &autocliv1.ServiceCommandDescriptor{
+	Service: _Query_serviceDesc.ServiceName,
+	RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+		{
+			RpcMethod:      "PendingTxs",
+			Use:            "pending-txs [sender]",
+			Short:          "Query the txs held by the mempool of the node, optionally of a sender",
+			PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "sender", Optional: true}},
+		},
+		{
+			RpcMethod: "WatchEvents",
+			Skip:      true, // streaming is only available over gRPC
+		},
+	},
+}
+
+// NewMempoolCommands is a fake `appmodule.Module` to be considered as a module
+// and be added in AutoCLI, alongside the other node services.
+func NewMempoolCommands() *mempoolModule {
+	return &mempoolModule{}
+}
+
+type mempoolModule struct{}
+
+func (m mempoolModule) IsOnePerModuleType() {}
+func (m mempoolModule) IsAppModule()        {}
+
+func (m mempoolModule) Name() string {
+	return "mempool"
+}
+
+func (m mempoolModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: MempoolAutoCLIDescriptor,
+	}
+}
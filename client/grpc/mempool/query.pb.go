@@ -0,0 +1,1638 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/mempool/v1/query.proto
+
+package mempool
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventType defines the type of a mempool event.
+type EventType int32
+
+const (
+	// EVENT_TYPE_UNSPECIFIED defines an unknown event type.
+	EventType_EVENT_TYPE_UNSPECIFIED EventType = 0
+	// EVENT_TYPE_INSERT defines the insertion of a tx into the mempool.
+	EventType_EVENT_TYPE_INSERT EventType = 1
+	// EVENT_TYPE_REMOVE defines the removal of a tx from the mempool, e.g. once
+	// included in a block.
+	EventType_EVENT_TYPE_REMOVE EventType = 2
+	// EVENT_TYPE_EVICT defines the eviction of a tx by the mempool, e.g. once
+	// expired.
+	EventType_EVENT_TYPE_EVICT EventType = 3
+)
+
+var EventType_name = map[int32]string{
+	0: "EVENT_TYPE_UNSPECIFIED",
+	1: "EVENT_TYPE_INSERT",
+	2: "EVENT_TYPE_REMOVE",
+	3: "EVENT_TYPE_EVICT",
+}
+
+var EventType_value = map[string]int32{
+	"EVENT_TYPE_UNSPECIFIED": 0,
+	"EVENT_TYPE_INSERT":      1,
+	"EVENT_TYPE_REMOVE":      2,
+	"EVENT_TYPE_EVICT":       3,
+}
+
+func (x EventType) String() string {
+	return proto.EnumName(EventType_name, int32(x))
+}
+
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{0}
+}
+
+// PendingTxsRequest is the request type for the Query/PendingTxs RPC method.
+type PendingTxsRequest struct {
+	// sender defines the address of the sender to query the txs of. If empty,
+	// the txs of every sender are returned.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTxsRequest) Reset()         { *m = PendingTxsRequest{} }
+func (m *PendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsRequest) ProtoMessage()    {}
+func (*PendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{0}
+}
+func (m *PendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTxsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsRequest.Merge(m, src)
+}
+func (m *PendingTxsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxsRequest proto.InternalMessageInfo
+
+func (m *PendingTxsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingTxsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingTxsResponse is the response type for the Query/PendingTxs RPC method.
+type PendingTxsResponse struct {
+	// txs defines the txs held by the mempool.
+	Txs []*PendingTx `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *PendingTxsResponse) Reset()         { *m = PendingTxsResponse{} }
+func (m *PendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingTxsResponse) ProtoMessage()    {}
+func (*PendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{1}
+}
+func (m *PendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTxsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxsResponse.Merge(m, src)
+}
+func (m *PendingTxsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxsResponse proto.InternalMessageInfo
+
+func (m *PendingTxsResponse) GetTxs() []*PendingTx {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *PendingTxsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// WatchEventsRequest is the request type for the Query/WatchEvents RPC method.
+type WatchEventsRequest struct {
+	// sender defines the address of the sender to watch the txs of. If empty,
+	// the events of every sender are streamed.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *WatchEventsRequest) Reset()         { *m = WatchEventsRequest{} }
+func (m *WatchEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsRequest) ProtoMessage()    {}
+func (*WatchEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{2}
+}
+func (m *WatchEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsRequest.Merge(m, src)
+}
+func (m *WatchEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEventsRequest proto.InternalMessageInfo
+
+func (m *WatchEventsRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// WatchEventsResponse is the response type for the Query/WatchEvents RPC
+// method, streamed for every event of the mempool.
+type WatchEventsResponse struct {
+	// type defines the type of the event.
+	EventType EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=cosmos.mempool.v1.EventType" json:"event_type,omitempty"`
+	// tx defines the tx the event refers to.
+	Tx *PendingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (m *WatchEventsResponse) Reset()         { *m = WatchEventsResponse{} }
+func (m *WatchEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsResponse) ProtoMessage()    {}
+func (*WatchEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{3}
+}
+func (m *WatchEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WatchEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsResponse.Merge(m, src)
+}
+func (m *WatchEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEventsResponse proto.InternalMessageInfo
+
+func (m *WatchEventsResponse) GetEventType() EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (m *WatchEventsResponse) GetTx() *PendingTx {
+	if m != nil {
+		return m.Tx
+	}
+	return nil
+}
+
+// PendingTx defines a tx held by the mempool.
+type PendingTx struct {
+	// hash defines the hex encoded hash of the tx.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// sender defines the address of the sender of the tx, i.e. its first signer.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// nonce defines the sequence of the sender of the tx.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// priority defines the priority of the tx, if the mempool orders txs by
+	// priority.
+	Priority string `protobuf:"bytes,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	// tx_size defines the size of the encoded tx in bytes.
+	TxSize uint64 `protobuf:"varint,5,opt,name=tx_size,json=txSize,proto3" json:"tx_size,omitempty"`
+	// tx_bytes defines the encoded tx.
+	TxBytes []byte `protobuf:"bytes,6,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *PendingTx) Reset()         { *m = PendingTx{} }
+func (m *PendingTx) String() string { return proto.CompactTextString(m) }
+func (*PendingTx) ProtoMessage()    {}
+func (*PendingTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_726ed45e944a1404, []int{4}
+}
+func (m *PendingTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTx.Merge(m, src)
+}
+func (m *PendingTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTx proto.InternalMessageInfo
+
+func (m *PendingTx) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *PendingTx) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *PendingTx) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *PendingTx) GetPriority() string {
+	if m != nil {
+		return m.Priority
+	}
+	return ""
+}
+
+func (m *PendingTx) GetTxSize() uint64 {
+	if m != nil {
+		return m.TxSize
+	}
+	return 0
+}
+
+func (m *PendingTx) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.mempool.v1.EventType", EventType_name, EventType_value)
+	proto.RegisterType((*PendingTxsRequest)(nil), "cosmos.mempool.v1.PendingTxsRequest")
+	proto.RegisterType((*PendingTxsResponse)(nil), "cosmos.mempool.v1.PendingTxsResponse")
+	proto.RegisterType((*WatchEventsRequest)(nil), "cosmos.mempool.v1.WatchEventsRequest")
+	proto.RegisterType((*WatchEventsResponse)(nil), "cosmos.mempool.v1.WatchEventsResponse")
+	proto.RegisterType((*PendingTx)(nil), "cosmos.mempool.v1.PendingTx")
+}
+
+func init() { proto.RegisterFile("cosmos/mempool/v1/query.proto", fileDescriptor_726ed45e944a1404) }
+
+var fileDescriptor_726ed45e944a1404 = []byte{
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x51, 0x6f, 0xd2, 0x50,
+	0x14, 0xe6, 0xc2, 0x60, 0xe3, 0x60, 0x0c, 0x5c, 0xe7, 0xac, 0x44, 0x1b, 0x42, 0x74, 0x92, 0x65,
+	0xb6, 0x03, 0x1f, 0x7d, 0xdb, 0xec, 0x0c, 0x26, 0x22, 0x96, 0x8a, 0x51, 0x1f, 0xb0, 0x94, 0x13,
+	0x68, 0x36, 0x7a, 0xbb, 0xde, 0x0b, 0x29, 0x7b, 0xf2, 0x0f, 0x98, 0xf8, 0x07, 0xfc, 0x3b, 0xc6,
+	0xc7, 0x3d, 0xfa, 0x68, 0xe0, 0x8f, 0x18, 0xda, 0xc2, 0x8a, 0x23, 0xe3, 0xa9, 0xf7, 0x9c, 0xfb,
+	0x7d, 0xe7, 0x7c, 0xe7, 0x7e, 0xf7, 0x16, 0x1e, 0x5b, 0x8c, 0x0f, 0x19, 0x57, 0x87, 0x38, 0x74,
+	0x19, 0x3b, 0x57, 0xc7, 0x55, 0xf5, 0x62, 0x84, 0xde, 0x44, 0x71, 0x3d, 0x26, 0x18, 0x2d, 0x84,
+	0xdb, 0x4a, 0xb4, 0xad, 0x8c, 0xab, 0xc5, 0x83, 0x88, 0xd1, 0x35, 0x39, 0x86, 0x58, 0x75, 0x5c,
+	0xed, 0xa2, 0x30, 0xab, 0xaa, 0x6b, 0xf6, 0x6d, 0xc7, 0x14, 0x36, 0x73, 0x42, 0x7a, 0x99, 0x43,
+	0xa1, 0x89, 0x4e, 0xcf, 0x76, 0xfa, 0x86, 0xcf, 0x75, 0xbc, 0x18, 0x21, 0x17, 0x74, 0x0f, 0x32,
+	0x1c, 0x9d, 0x1e, 0x7a, 0x12, 0x29, 0x91, 0x4a, 0x56, 0x8f, 0x22, 0x7a, 0x0a, 0x70, 0x5d, 0x40,
+	0x4a, 0x96, 0x48, 0x25, 0x57, 0xdb, 0x57, 0x22, 0x01, 0xf3, 0x6e, 0x4a, 0xa8, 0x2c, 0xea, 0xa6,
+	0x34, 0xcd, 0x3e, 0x46, 0x35, 0xf5, 0x18, 0xb3, 0xfc, 0x9d, 0x00, 0x8d, 0x77, 0xe5, 0x2e, 0x73,
+	0x38, 0x52, 0x05, 0x52, 0xc2, 0xe7, 0x12, 0x29, 0xa5, 0x2a, 0xb9, 0xda, 0x23, 0xe5, 0xc6, 0x60,
+	0xca, 0x92, 0xa3, 0xcf, 0x81, 0xf4, 0xf5, 0x1a, 0x39, 0xcf, 0x36, 0xca, 0x09, 0x9b, 0xad, 0xe8,
+	0x39, 0x04, 0xfa, 0xd1, 0x14, 0xd6, 0x40, 0x1b, 0xa3, 0x23, 0x36, 0x9d, 0x42, 0xf9, 0x1b, 0x81,
+	0x7b, 0x2b, 0xf0, 0x48, 0xfe, 0x4b, 0x00, 0x9c, 0x67, 0x3a, 0x62, 0xe2, 0x62, 0xc0, 0xb9, 0xbb,
+	0x76, 0x8a, 0x80, 0x66, 0x4c, 0x5c, 0xd4, 0xb3, 0xb8, 0x58, 0xd2, 0x43, 0x48, 0x0a, 0x3f, 0x9a,
+	0xe1, 0xf6, 0xd1, 0x93, 0xc2, 0x2f, 0xff, 0x24, 0x90, 0x5d, 0x66, 0x28, 0x85, 0xad, 0x81, 0xc9,
+	0x07, 0x91, 0xcc, 0x60, 0x1d, 0x13, 0x9f, 0x5c, 0xb1, 0x70, 0x17, 0xd2, 0x0e, 0x73, 0x2c, 0x94,
+	0x52, 0x25, 0x52, 0xd9, 0xd2, 0xc3, 0x80, 0x16, 0x61, 0xc7, 0xf5, 0x6c, 0xe6, 0xd9, 0x62, 0x22,
+	0x6d, 0x05, 0xf8, 0x65, 0x4c, 0x1f, 0xc0, 0xb6, 0xf0, 0x3b, 0xdc, 0xbe, 0x44, 0x29, 0x1d, 0x70,
+	0x32, 0xc2, 0x6f, 0xd9, 0x97, 0x48, 0x1f, 0xc2, 0x8e, 0xf0, 0x3b, 0xdd, 0x89, 0x40, 0x2e, 0x65,
+	0x4a, 0xa4, 0x72, 0x47, 0xdf, 0x16, 0xfe, 0xf1, 0x3c, 0x3c, 0x38, 0x83, 0xec, 0x72, 0x4a, 0x5a,
+	0x84, 0x3d, 0xad, 0xad, 0x35, 0x8c, 0x8e, 0xf1, 0xa9, 0xa9, 0x75, 0x3e, 0x34, 0x5a, 0x4d, 0xed,
+	0xa4, 0x7e, 0x5a, 0xd7, 0x5e, 0xe5, 0x13, 0xf4, 0x3e, 0x14, 0x62, 0x7b, 0xf5, 0x46, 0x4b, 0xd3,
+	0x8d, 0x3c, 0xf9, 0x2f, 0xad, 0x6b, 0x6f, 0xdf, 0xb5, 0xb5, 0x7c, 0x92, 0xee, 0x42, 0x3e, 0x96,
+	0xd6, 0xda, 0xf5, 0x13, 0x23, 0x9f, 0xaa, 0xfd, 0x22, 0x90, 0x7e, 0x3f, 0x37, 0x9a, 0x7e, 0x01,
+	0xb8, 0xbe, 0x56, 0xf4, 0xc9, 0x6d, 0xc7, 0xb8, 0x70, 0xb9, 0xf8, 0x74, 0x03, 0x2a, 0x34, 0xb7,
+	0x9c, 0xa0, 0x5f, 0x21, 0x17, 0x73, 0x9d, 0xae, 0xe3, 0xdd, 0xbc, 0x44, 0xc5, 0xfd, 0x4d, 0xb0,
+	0x45, 0xfd, 0x23, 0x72, 0xfc, 0xe6, 0xf7, 0x54, 0x26, 0x57, 0x53, 0x99, 0xfc, 0x9d, 0xca, 0xe4,
+	0xc7, 0x4c, 0x4e, 0x5c, 0xcd, 0xe4, 0xc4, 0x9f, 0x99, 0x9c, 0xf8, 0x7c, 0xd4, 0xb7, 0xc5, 0x60,
+	0xd4, 0x55, 0x2c, 0x36, 0x54, 0xa3, 0xc7, 0x1d, 0x7e, 0x9e, 0xf3, 0xde, 0x99, 0x6a, 0x9d, 0xdb,
+	0xe8, 0x08, 0xb5, 0xef, 0xb9, 0xd6, 0xe2, 0x2f, 0xd1, 0xcd, 0x04, 0xcf, 0xfb, 0xc5, 0xbf, 0x01,
+	0x00, 0x0d, 0xf7, 0xb6, 0x2a, 0x3e, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingTxs queries the txs held by the mempool, optionally filtered by
+	// sender, ordered by sender and nonce.
+	PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error)
+	// WatchEvents streams the insertion, removal and eviction events of the
+	// mempool, optionally filtered by sender. It is only available over gRPC.
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Query_WatchEventsClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingTxs(ctx context.Context, in *PendingTxsRequest, opts ...grpc.CallOption) (*PendingTxsResponse, error) {
+	out := new(PendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mempool.v1.Query/PendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (Query_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/cosmos.mempool.v1.Query/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type queryWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingTxs queries the txs held by the mempool, optionally filtered by
+	// sender, ordered by sender and nonce.
+	PendingTxs(context.Context, *PendingTxsRequest) (*PendingTxsResponse, error)
+	// WatchEvents streams the insertion, removal and eviction events of the
+	// mempool, optionally filtered by sender. It is only available over gRPC.
+	WatchEvents(*WatchEventsRequest, Query_WatchEventsServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingTxs(ctx context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTxs not implemented")
+}
+func (*UnimplementedQueryServer) WatchEvents(req *WatchEventsRequest, srv Query_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mempool.v1.Query/PendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTxs(ctx, req.(*PendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).WatchEvents(m, &queryWatchEventsServer{stream})
+}
+
+type Query_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type queryWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.mempool.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingTxs",
+			Handler:    _Query_PendingTxs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _Query_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/mempool/v1/query.proto",
+}
+
+func (m *PendingTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Txs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TxSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Priority) > 0 {
+		i -= len(m.Priority)
+		copy(dAtA[i:], m.Priority)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Priority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingTxsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTxsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WatchEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventType != 0 {
+		n += 1 + sovQuery(uint64(m.EventType))
+	}
+	if m.Tx != nil {
+		l = m.Tx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.Priority)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxSize != 0 {
+		n += 1 + sovQuery(uint64(m.TxSize))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingTxsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTxsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTxsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTxsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTxsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTxsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &PendingTx{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= EventType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tx == nil {
+				m.Tx = &PendingTx{}
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Priority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxSize", wireType)
+			}
+			m.TxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,215 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// watchEventsBufferSize defines the number of events buffered for every
+// WatchEvents stream, beyond which events are dropped until the client catches
+// up.
+const watchEventsBufferSize = 1024
+
+// RegisterMempoolService registers the mempool gRPC service on the provided gRPC
+// router.
+func RegisterMempoolService(clientCtx client.Context, server gogogrpc.Server, mp sdkmempool.Mempool) {
+	RegisterQueryServer(server, NewQueryServer(clientCtx, mp))
+}
+
+var _ QueryServer = queryServer{}
+
+type queryServer struct {
+	clientCtx client.Context
+	mempool   sdkmempool.Mempool
+}
+
+// NewQueryServer returns a QueryServer inspecting the given mempool, which must
+// implement the mempool.Inspector interface for the queries to succeed.
+func NewQueryServer(clientCtx client.Context, mp sdkmempool.Mempool) QueryServer {
+	return queryServer{
+		clientCtx: clientCtx,
+		mempool:   mp,
+	}
+}
+
+// PendingTxs implements the Query/PendingTxs gRPC method.
+func (s queryServer) PendingTxs(_ context.Context, req *PendingTxsRequest) (*PendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	inspector, err := s.inspector(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := inspector.PendingTxs(req.Sender)
+
+	start, end, pageRes, err := paginate(req.Pagination, len(pending))
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Pagination != nil && req.Pagination.Reverse {
+		for i, j := 0, len(pending)-1; i < j; i, j = i+1, j-1 {
+			pending[i], pending[j] = pending[j], pending[i]
+		}
+	}
+
+	txs := make([]*PendingTx, 0, end-start)
+	for _, tx := range pending[start:end] {
+		pendingTx, err := s.pendingTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, pendingTx)
+	}
+
+	return &PendingTxsResponse{
+		Txs:        txs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// WatchEvents implements the Query/WatchEvents gRPC method.
+func (s queryServer) WatchEvents(req *WatchEventsRequest, stream Query_WatchEventsServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	inspector, err := s.inspector(req.Sender)
+	if err != nil {
+		return err
+	}
+
+	events, cancel := inspector.Subscribe(watchEventsBufferSize)
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+
+			if req.Sender != "" && e.Sender != req.Sender {
+				continue
+			}
+
+			tx, err := s.pendingTx(e.PendingTx)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&WatchEventsResponse{EventType: toEventType(e.Type), Tx: tx}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// inspector returns the mempool as an Inspector, validating the sender of the
+// request, if any.
+func (s queryServer) inspector(sender string) (sdkmempool.Inspector, error) {
+	if sender != "" {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sender address: %s", err)
+		}
+	}
+
+	inspector, ok := s.mempool.(sdkmempool.Inspector)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "mempool %T does not support inspection", s.mempool)
+	}
+
+	return inspector, nil
+}
+
+// pendingTx encodes the tx of the mempool into its response representation.
+func (s queryServer) pendingTx(tx sdkmempool.PendingTx) (*PendingTx, error) {
+	if s.clientCtx.TxConfig == nil {
+		return nil, status.Error(codes.Internal, "tx config not set")
+	}
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(tx.Tx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode tx: %s", err)
+	}
+
+	return &PendingTx{
+		Hash:     fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+		Sender:   tx.Sender,
+		Nonce:    tx.Nonce,
+		Priority: tx.Priority,
+		TxSize:   uint64(len(txBytes)),
+		TxBytes:  txBytes,
+	}, nil
+}
+
+// paginate returns the range of the page of a list of the given length. The
+// key of a page is the big endian encoded offset of its first item.
+func paginate(pageReq *query.PageRequest, length int) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return 0, 0, nil, status.Error(codes.InvalidArgument, "either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return 0, 0, nil, status.Error(codes.InvalidArgument, "invalid pagination key")
+		}
+
+		offset = binary.BigEndian.Uint64(pageReq.Key)
+	}
+
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	start = int(min(offset, uint64(length)))
+	end = start + int(min(limit, uint64(length-start)))
+
+	pageRes = &query.PageResponse{}
+	if end < length {
+		pageRes.NextKey = binary.BigEndian.AppendUint64(nil, uint64(end))
+	}
+
+	if pageReq.CountTotal {
+		pageRes.Total = uint64(length)
+	}
+
+	return start, end, pageRes, nil
+}
+
+func toEventType(t sdkmempool.EventType) EventType {
+	switch t {
+	case sdkmempool.EventInsert:
+		return EventType_EVENT_TYPE_INSERT
+	case sdkmempool.EventRemove:
+		return EventType_EVENT_TYPE_REMOVE
+	case sdkmempool.EventEvict:
+		return EventType_EVENT_TYPE_EVICT
+	default:
+		return EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,145 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+type watchEventsServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *WatchEventsResponse
+}
+
+func (s watchEventsServer) Context() context.Context { return s.ctx }
+
+func (s watchEventsServer) Send(res *WatchEventsResponse) error {
+	s.sent <- res
+	return nil
+}
+
+func newTx(t *testing.T, clientCtx client.Context, secret string, nonce uint64) sdk.Tx {
+	t.Helper()
+
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	builder.SetMemo(secret)
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   secp256k1.GenPrivKeyFromSecret([]byte(secret)).PubKey(),
+		Sequence: nonce,
+		Data:     &signingtypes.SingleSignatureData{},
+	}))
+
+	return builder.GetTx()
+}
+
+func TestQueryServer_PendingTxs(t *testing.T) {
+	clientCtx := client.Context{}.WithTxConfig(moduletestutil.MakeTestTxConfig())
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	mp := sdkmempool.DefaultPriorityMempool()
+	txs := []sdk.Tx{
+		newTx(t, clientCtx, "a", 0),
+		newTx(t, clientCtx, "a", 1),
+		newTx(t, clientCtx, "a", 2),
+		newTx(t, clientCtx, "b", 0),
+	}
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(int64(i)), tx))
+	}
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("a")).PubKey().Address()).String()
+	svr := NewQueryServer(clientCtx, mp)
+
+	res, err := svr.PendingTxs(ctx, &PendingTxsRequest{Sender: sender})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+
+	for i, tx := range res.Txs {
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(txs[i])
+		require.NoError(t, err)
+
+		require.Equal(t, sender, tx.Sender)
+		require.Equal(t, uint64(i), tx.Nonce)
+		require.Equal(t, fmt.Sprint(i), tx.Priority)
+		require.Equal(t, uint64(len(txBytes)), tx.TxSize)
+		require.Equal(t, txBytes, tx.TxBytes)
+		require.Len(t, tx.Hash, 64)
+	}
+
+	// paginate over every tx of the mempool
+	res, err = svr.PendingTxs(ctx, &PendingTxsRequest{Pagination: &query.PageRequest{Limit: 3, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 3)
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = svr.PendingTxs(ctx, &PendingTxsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Nil(t, res.Pagination.NextKey)
+
+	res, err = svr.PendingTxs(ctx, &PendingTxsRequest{Pagination: &query.PageRequest{Offset: 10}})
+	require.NoError(t, err)
+	require.Empty(t, res.Txs)
+
+	_, err = svr.PendingTxs(ctx, &PendingTxsRequest{Sender: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = NewQueryServer(clientCtx, sdkmempool.NoOpMempool{}).PendingTxs(ctx, &PendingTxsRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestQueryServer_WatchEvents(t *testing.T) {
+	clientCtx := client.Context{}.WithTxConfig(moduletestutil.MakeTestTxConfig())
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	mp := sdkmempool.NewSenderNonceMempool()
+	svr := NewQueryServer(clientCtx, mp)
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := watchEventsServer{ctx: streamCtx, sent: make(chan *WatchEventsResponse, 10)}
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("b")).PubKey().Address()).String()
+	done := make(chan error)
+	go func() {
+		done <- svr.WatchEvents(&WatchEventsRequest{Sender: sender}, stream)
+	}()
+
+	// wait for the stream to subscribe, as events are only sent to subscribers
+	txA, txB := newTx(t, clientCtx, "a", 0), newTx(t, clientCtx, "b", 0)
+	require.Eventually(t, func() bool {
+		require.NoError(t, mp.Insert(ctx, txA))
+		require.NoError(t, mp.Insert(ctx, txB))
+		require.NoError(t, mp.Remove(txB))
+		return len(stream.sent) > 0
+	}, time.Second, 10*time.Millisecond)
+
+	// only the events of the sender are streamed
+	res := <-stream.sent
+	for res.EventType != EventType_EVENT_TYPE_REMOVE {
+		require.Equal(t, EventType_EVENT_TYPE_INSERT, res.EventType)
+		require.Equal(t, sender, res.Tx.Sender)
+		res = <-stream.sent
+	}
+	require.Equal(t, sender, res.Tx.Sender)
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -32,6 +32,7 @@ type Factory struct {
 	sequence           uint64
 	gas                uint64
 	timeoutHeight      uint64
+	unordered          bool
 	gasAdjustment      float64
 	chainID            string
 	offline            bool
@@ -73,9 +74,12 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		signMode = signing.SignMode_SIGN_MODE_EIP_191
 	}
 
+	// unordered txs do not need the sequence of the account in offline mode
+	unordered := clientCtx.Viper.GetBool(flags.FlagUnordered)
+
 	var accNum, accSeq uint64
 	if clientCtx.Offline {
-		if flagSet.Changed(flags.FlagAccountNumber) && flagSet.Changed(flags.FlagSequence) {
+		if flagSet.Changed(flags.FlagAccountNumber) && (flagSet.Changed(flags.FlagSequence) || unordered) {
 			accNum = clientCtx.Viper.GetUint64(flags.FlagAccountNumber)
 			accSeq = clientCtx.Viper.GetUint64(flags.FlagSequence)
 		} else {
@@ -102,6 +106,7 @@ func NewFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (Factory, e
 		accountNumber:      accNum,
 		sequence:           accSeq,
 		timeoutHeight:      timeoutHeight,
+		unordered:          unordered,
 		gasAdjustment:      gasAdj,
 		memo:               memo,
 		signMode:           signMode,
@@ -131,6 +136,7 @@ func (f Factory) Fees() sdk.Coins                           { return f.fees }
 func (f Factory) GasPrices() sdk.DecCoins                   { return f.gasPrices }
 func (f Factory) AccountRetriever() client.AccountRetriever { return f.accountRetriever }
 func (f Factory) TimeoutHeight() uint64                     { return f.timeoutHeight }
+func (f Factory) Unordered() bool                           { return f.unordered }
 
 // SimulateAndExecute returns the option to simulate and then execute the transaction
 // using the gas from the simulation results
@@ -236,6 +242,12 @@ func (f Factory) WithTimeoutHeight(height uint64) Factory {
 	return f
 }
 
+// WithUnordered returns a copy of the Factory with an updated unordered field.
+func (f Factory) WithUnordered(v bool) Factory {
+	f.unordered = v
+	return f
+}
+
 // WithFeeGranter returns a copy of the Factory with an updated fee granter.
 func (f Factory) WithFeeGranter(fg sdk.AccAddress) Factory {
 	f.feeGranter = fg
@@ -323,6 +335,10 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 		}
 	}
 
+	if f.unordered && f.timeoutHeight == 0 {
+		return nil, errors.New("unordered transactions must have a timeout height set")
+	}
+
 	// Prevent simple inclusion of a valid mnemonic in the memo field
 	if f.memo != "" && bip39.IsMnemonicValid(strings.ToLower(f.memo)) {
 		return nil, errors.New("cannot provide a valid mnemonic seed in the memo field")
@@ -340,6 +356,7 @@ func (f Factory) BuildUnsignedTx(msgs ...sdk.Msg) (client.TxBuilder, error) {
 	tx.SetFeeGranter(f.feeGranter)
 	tx.SetFeePayer(f.feePayer)
 	tx.SetTimeoutHeight(f.TimeoutHeight())
+	tx.SetUnordered(f.Unordered())
 
 	if etx, ok := tx.(client.ExtendedTxBuilder); ok {
 		etx.SetExtensionOptions(f.extOptions...)
@@ -484,7 +501,8 @@ func (f Factory) Prepare(clientCtx client.Context) (Factory, error) {
 			fc = fc.WithAccountNumber(num)
 		}
 
-		if initSeq == 0 {
+		// the sequence of unordered txs is always zero
+		if initSeq == 0 && !fc.unordered {
 			fc = fc.WithSequence(seq)
 		}
 	}
@@ -166,6 +166,16 @@ func CalculateGas(
 	return simRes, uint64(txf.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
 }
 
+// SimulateBundle simulates the execution of an ordered bundle of encoded txs,
+// where every tx observes the state changes of the txs preceding it, returning
+// the simulation result of every tx.
+func SimulateBundle(clientCtx gogogrpc.ClientConn, txsBytes [][]byte) (*tx.SimulateBundleResponse, error) {
+	txSvcClient := tx.NewServiceClient(clientCtx)
+	return txSvcClient.SimulateBundle(context.Background(), &tx.SimulateBundleRequest{
+		TxsBytes: txsBytes,
+	})
+}
+
 // SignWithPrivKey signs a given tx with the given private key, and returns the
 // corresponding SignatureV2 if the signing is successful.
 func SignWithPrivKey(
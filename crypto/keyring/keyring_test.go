@@ -25,6 +25,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -1693,6 +1694,41 @@ func TestAltKeyring_DeleteByAddress(t *testing.T) {
 	}
 }
 
+func TestOfflineWebAuthnKey(t *testing.T) {
+	cdc := getCodec()
+	kb, err := New("keybasename", BackendTest, t.TempDir(), nil, cdc)
+	require.NoError(t, err)
+
+	priv, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	pub := secp256r1.NewWebAuthnPubKey(priv.PubKey().(*secp256r1.PubKey))
+
+	// passkeys are imported through their JSON encoded public key
+	bz, err := cdc.MarshalInterfaceJSON(pub)
+	require.NoError(t, err)
+	var imported types.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &imported))
+
+	_, err = kb.SaveOfflineKey("passkey", imported)
+	require.NoError(t, err)
+
+	k, err := kb.Key("passkey")
+	require.NoError(t, err)
+	require.NotNil(t, k.GetOffline())
+
+	key, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pub.Equals(key))
+
+	addr, err := k.GetAddress()
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(pub.Address()), addr)
+
+	// the private key never leaves the authenticator
+	_, _, err = kb.Sign("passkey", []byte("msg"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorIs(t, err, ErrOfflineSign)
+}
+
 // TODO: review
 func TestAltKeyring_SaveOfflineKey(t *testing.T) {
 	cdc := getCodec()
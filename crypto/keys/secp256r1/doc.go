@@ -1,5 +1,6 @@
 // Package secp256r1 implements Cosmos-SDK compatible ECDSA public and private key. The keys
-// can be protobuf serialized and packed in Any.
+// can be protobuf serialized and packed in Any. WebAuthnPubKey wraps the public key of
+// a WebAuthn (passkey) credential whose signatures are WebAuthn assertions.
 package secp256r1
 
 import (
@@ -29,7 +30,8 @@ func init() {
 	}
 }
 
-// RegisterInterfaces adds secp256r1 PubKey to pubkey registry
+// RegisterInterfaces adds secp256r1 PubKey and WebAuthnPubKey to pubkey registry
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &PubKey{})
+	registry.RegisterImplementations((*cryptotypes.PubKey)(nil), &WebAuthnPubKey{})
 }
@@ -107,9 +107,105 @@ var xxx_messageInfo_PrivKey proto.InternalMessageInfo
 func (*PrivKey) XXX_MessageName() string {
 	return "cosmos.crypto.secp256r1.PrivKey"
 }
+
+// WebAuthnPubKey defines the secp256r1 ECDSA public key of a WebAuthn (passkey)
+// credential. Signatures made with it are WebAuthn assertions encoded as
+// WebAuthnSignature.
+type WebAuthnPubKey struct {
+	// Point on secp256r1 curve in a compressed representation as specified in section
+	// 4.3.6 of ANSI X9.62: https://webstore.ansi.org/standards/ascx9/ansix9621998
+	Key *webAuthnPK `protobuf:"bytes,1,opt,name=key,proto3,customtype=webAuthnPK" json:"key,omitempty"`
+}
+
+func (m *WebAuthnPubKey) Reset()      { *m = WebAuthnPubKey{} }
+func (*WebAuthnPubKey) ProtoMessage() {}
+func (*WebAuthnPubKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b90c18415095c0c3, []int{2}
+}
+func (m *WebAuthnPubKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebAuthnPubKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebAuthnPubKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebAuthnPubKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebAuthnPubKey.Merge(m, src)
+}
+func (m *WebAuthnPubKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebAuthnPubKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebAuthnPubKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebAuthnPubKey proto.InternalMessageInfo
+
+func (*WebAuthnPubKey) XXX_MessageName() string {
+	return "cosmos.crypto.secp256r1.WebAuthnPubKey"
+}
+
+// WebAuthnSignature defines a WebAuthn assertion made by a WebAuthnPubKey
+// credential. The assertion challenge is the base64url encoded SHA-256 hash
+// of the sign bytes.
+type WebAuthnSignature struct {
+	// authenticator_data is the raw authenticator data returned by the authenticator.
+	AuthenticatorData []byte `protobuf:"bytes,1,opt,name=authenticator_data,json=authenticatorData,proto3" json:"authenticator_data,omitempty"`
+	// client_data_json is the raw client data JSON returned by the client.
+	ClientDataJSON []byte `protobuf:"bytes,2,opt,name=client_data_json,json=clientDataJson,proto3" json:"client_data_json,omitempty"`
+	// signature is the ECDSA signature over authenticator_data || SHA-256(client_data_json),
+	// encoded as fixed-width 64 bytes (R || S) with a low-s normalized S.
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *WebAuthnSignature) Reset()      { *m = WebAuthnSignature{} }
+func (*WebAuthnSignature) ProtoMessage() {}
+func (*WebAuthnSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b90c18415095c0c3, []int{3}
+}
+func (m *WebAuthnSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WebAuthnSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WebAuthnSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WebAuthnSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebAuthnSignature.Merge(m, src)
+}
+func (m *WebAuthnSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *WebAuthnSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebAuthnSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebAuthnSignature proto.InternalMessageInfo
+
+func (*WebAuthnSignature) XXX_MessageName() string {
+	return "cosmos.crypto.secp256r1.WebAuthnSignature"
+}
 func init() {
 	proto.RegisterType((*PubKey)(nil), "cosmos.crypto.secp256r1.PubKey")
 	proto.RegisterType((*PrivKey)(nil), "cosmos.crypto.secp256r1.PrivKey")
+	proto.RegisterType((*WebAuthnPubKey)(nil), "cosmos.crypto.secp256r1.WebAuthnPubKey")
+	proto.RegisterType((*WebAuthnSignature)(nil), "cosmos.crypto.secp256r1.WebAuthnSignature")
 }
 
 func init() {
@@ -117,21 +213,29 @@ func init() {
 }
 
 var fileDescriptor_b90c18415095c0c3 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2e, 0xaa, 0x2c, 0x28, 0xc9, 0xd7, 0x2f, 0x4e, 0x4d, 0x2e, 0x30, 0x32,
-	0x35, 0x2b, 0x32, 0xd4, 0xcf, 0x4e, 0xad, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x87, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xab, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xab,
-	0xd1, 0x07, 0xb1, 0x20, 0xca, 0x95, 0xd4, 0xb9, 0xd8, 0x02, 0x4a, 0x93, 0xbc, 0x53, 0x2b, 0x85,
-	0x64, 0xb9, 0x98, 0xb3, 0x53, 0x2b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x78, 0x9c, 0xb8, 0x6f, 0xdd,
-	0x93, 0x67, 0x4f, 0x4d, 0x4e, 0x29, 0x4e, 0x0c, 0xf0, 0x0e, 0x02, 0x89, 0x2b, 0xe9, 0x71, 0xb1,
-	0x07, 0x14, 0x65, 0x96, 0x81, 0x54, 0x2a, 0x73, 0xb1, 0x15, 0xa7, 0x26, 0x17, 0xa5, 0x96, 0x60,
-	0x28, 0x0e, 0xf6, 0x0e, 0x82, 0x4a, 0x39, 0x45, 0x9c, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c,
-	0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x89, 0xc7, 0x72, 0x8c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65,
-	0x94, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xf3, 0x1c, 0x98, 0xd2,
-	0x2d, 0x4e, 0xc9, 0x86, 0xf9, 0x13, 0xe4, 0x3b, 0x84, 0x67, 0x93, 0xd8, 0xc0, 0x2e, 0x37, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x65, 0x08, 0x5c, 0x0e, 0x01, 0x00, 0x00,
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xfa, 0x30,
+	0x1c, 0xc7, 0xd7, 0x3f, 0x09, 0xe4, 0x5f, 0xcd, 0x22, 0x8d, 0x89, 0xc4, 0x68, 0x21, 0xf3, 0xa0,
+	0x17, 0xb6, 0x88, 0xd1, 0x93, 0x17, 0xd1, 0x93, 0x24, 0x4a, 0xc6, 0x41, 0xe3, 0x85, 0x74, 0xa5,
+	0x19, 0x13, 0x59, 0x49, 0xdb, 0x69, 0xf6, 0x16, 0x3e, 0x81, 0xcf, 0xc3, 0x91, 0x23, 0xe1, 0x40,
+	0x64, 0x7b, 0x11, 0xb3, 0x8e, 0x89, 0xc6, 0x53, 0xdb, 0xdf, 0xf7, 0xd3, 0xdf, 0xe7, 0xf0, 0x85,
+	0x16, 0xe5, 0x72, 0xcc, 0xa5, 0x43, 0x45, 0x3c, 0x51, 0xdc, 0x91, 0x8c, 0x4e, 0x5a, 0xe7, 0x17,
+	0xe2, 0xd4, 0x19, 0xb1, 0x58, 0xda, 0x13, 0xc1, 0x15, 0x47, 0x7b, 0x39, 0x63, 0xe7, 0x8c, 0xfd,
+	0xcd, 0xec, 0xef, 0xfa, 0xdc, 0xe7, 0x9a, 0x71, 0xb2, 0x5b, 0x8e, 0x5b, 0xc7, 0xb0, 0xdc, 0x8d,
+	0xbc, 0x0e, 0x8b, 0xd1, 0x21, 0x2c, 0x8d, 0x58, 0x5c, 0x03, 0x0d, 0x70, 0xb2, 0xdd, 0xde, 0x5a,
+	0x2c, 0xeb, 0x15, 0x46, 0x07, 0x92, 0x74, 0x3b, 0x6e, 0x36, 0xb7, 0x6c, 0x58, 0xe9, 0x8a, 0xe0,
+	0x35, 0x23, 0x8f, 0x60, 0x59, 0x32, 0x2a, 0x98, 0xfa, 0x03, 0xf7, 0x3a, 0xee, 0x3a, 0xb2, 0x5a,
+	0xd0, 0x7c, 0x60, 0xde, 0x55, 0xa4, 0x86, 0xe1, 0x5a, 0xd0, 0xf8, 0x29, 0x30, 0x17, 0xcb, 0x3a,
+	0x7c, 0x2b, 0x80, 0xb5, 0xe3, 0x03, 0xc0, 0x6a, 0xf1, 0xa9, 0x17, 0xf8, 0x21, 0x51, 0x91, 0x60,
+	0xa8, 0x09, 0x11, 0x89, 0xd4, 0x90, 0x85, 0x2a, 0xa0, 0x44, 0x71, 0xd1, 0x1f, 0x10, 0x45, 0xf2,
+	0x35, 0x6e, 0xf5, 0x57, 0x72, 0x43, 0x14, 0x41, 0x97, 0x70, 0x87, 0xbe, 0x04, 0x2c, 0x54, 0x9a,
+	0xeb, 0x3f, 0x4b, 0x1e, 0xd6, 0xfe, 0x69, 0x27, 0x4a, 0x96, 0x75, 0xf3, 0x5a, 0x67, 0x19, 0x79,
+	0xdb, 0xbb, 0xbf, 0x73, 0x4d, 0xba, 0x79, 0x4b, 0x1e, 0xa2, 0x03, 0xf8, 0x5f, 0x16, 0xe6, 0x5a,
+	0x49, 0x3b, 0x36, 0x83, 0xf6, 0xe3, 0x74, 0x85, 0x8d, 0xf9, 0x0a, 0x1b, 0xd3, 0x04, 0x83, 0x59,
+	0x82, 0xc1, 0x67, 0x82, 0xc1, 0x7b, 0x8a, 0x8d, 0x69, 0x8a, 0xc1, 0x2c, 0xc5, 0xc6, 0x3c, 0xc5,
+	0xc6, 0x53, 0xcb, 0x0f, 0xd4, 0x30, 0xf2, 0x6c, 0xca, 0xc7, 0x4e, 0xd1, 0x98, 0x3e, 0x9a, 0x72,
+	0x30, 0x2a, 0xca, 0xcb, 0x2a, 0xdb, 0x34, 0xe8, 0x95, 0x75, 0x1d, 0x67, 0x5f, 0x03, 0x00, 0xbf,
+	0x60, 0x3c, 0xa4, 0xe3, 0x01, 0x00, 0x00,
 }
 
 func (m *PubKey) Marshal() (dAtA []byte, err error) {
@@ -204,6 +308,85 @@ func (m *PrivKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WebAuthnPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebAuthnPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebAuthnPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Key != nil {
+		{
+			size := m.Key.Size()
+			i -= size
+			if _, err := m.Key.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintKeys(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WebAuthnSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WebAuthnSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WebAuthnSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientDataJSON) > 0 {
+		i -= len(m.ClientDataJSON)
+		copy(dAtA[i:], m.ClientDataJSON)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.ClientDataJSON)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuthenticatorData) > 0 {
+		i -= len(m.AuthenticatorData)
+		copy(dAtA[i:], m.AuthenticatorData)
+		i = encodeVarintKeys(dAtA, i, uint64(len(m.AuthenticatorData)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintKeys(dAtA []byte, offset int, v uint64) int {
 	offset -= sovKeys(v)
 	base := offset
@@ -241,6 +424,40 @@ func (m *PrivKey) Size() (n int) {
 	return n
 }
 
+func (m *WebAuthnPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != nil {
+		l = m.Key.Size()
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
+func (m *WebAuthnSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AuthenticatorData)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.ClientDataJSON)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovKeys(uint64(l))
+	}
+	return n
+}
+
 func sovKeys(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -417,6 +634,243 @@ func (m *PrivKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WebAuthnPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebAuthnPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebAuthnPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v webAuthnPK
+			m.Key = &v
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebAuthnSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowKeys
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebAuthnSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebAuthnSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthenticatorData = append(m.AuthenticatorData[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuthenticatorData == nil {
+				m.AuthenticatorData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDataJSON", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDataJSON = append(m.ClientDataJSON[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientDataJSON == nil {
+				m.ClientDataJSON = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowKeys
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthKeys
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipKeys(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthKeys
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipKeys(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,157 @@
+package secp256r1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/internal/ecdsa"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+const (
+	webAuthnName = "webauthn-secp256r1"
+
+	// webAuthnTypeGet is the client data type of a WebAuthn assertion.
+	webAuthnTypeGet = "webauthn.get"
+	// authenticatorDataMinSize is the size of the rpIdHash, flags and signCount
+	// fields every authenticator data starts with.
+	authenticatorDataMinSize = sha256.Size + 1 + 4
+	// authenticatorDataFlagUP is the "user present" authenticator data flag.
+	authenticatorDataFlagUP = 0x01
+)
+
+var _ cryptotypes.PubKey = (*WebAuthnPubKey)(nil)
+
+// NewWebAuthnPubKey wraps a secp256r1 public key as the public key of a
+// WebAuthn credential.
+func NewWebAuthnPubKey(pk *PubKey) *WebAuthnPubKey {
+	// copy the key so that the address cached by pk isn't shared
+	return &WebAuthnPubKey{Key: &webAuthnPK{ecdsaPK{ecdsa.PubKey{PublicKey: pk.Key.PublicKey}}}}
+}
+
+// String implements proto.Message interface.
+func (m *WebAuthnPubKey) String() string {
+	return m.Key.String(webAuthnName)
+}
+
+// Bytes implements SDK PubKey interface.
+func (m *WebAuthnPubKey) Bytes() []byte {
+	if m == nil {
+		return nil
+	}
+	return m.Key.Bytes()
+}
+
+// Equals implements SDK PubKey interface.
+func (m *WebAuthnPubKey) Equals(other cryptotypes.PubKey) bool {
+	pk2, ok := other.(*WebAuthnPubKey)
+	if !ok {
+		return false
+	}
+	return m.Key.Equal(&pk2.Key.PublicKey)
+}
+
+// Address implements SDK PubKey interface.
+func (m *WebAuthnPubKey) Address() cmtcrypto.Address {
+	return m.Key.Address(proto.MessageName(m))
+}
+
+// Type returns key type name. Implements SDK PubKey interface.
+func (m *WebAuthnPubKey) Type() string {
+	return webAuthnName
+}
+
+// VerifySignature implements SDK PubKey interface. sig must be a proto encoded
+// WebAuthnSignature whose client data challenges the SHA-256 hash of msg.
+// Binding the assertion to a relying party and origin is left to the client.
+func (m *WebAuthnPubKey) VerifySignature(msg, sig []byte) bool {
+	var assertion WebAuthnSignature
+	if err := assertion.Unmarshal(sig); err != nil {
+		return false
+	}
+
+	if err := assertion.verifyChallenge(msg); err != nil {
+		return false
+	}
+
+	clientDataHash := sha256.Sum256(assertion.ClientDataJSON)
+	signed := make([]byte, 0, len(assertion.AuthenticatorData)+len(clientDataHash))
+	signed = append(signed, assertion.AuthenticatorData...)
+	signed = append(signed, clientDataHash[:]...)
+
+	return m.Key.VerifySignature(signed, assertion.Signature)
+}
+
+// webAuthnPK is the key of a WebAuthnPubKey. Unlike ecdsaPK it is JSON encoded
+// like a proto bytes field, so that WebAuthn keys can be stored offline.
+type webAuthnPK struct {
+	ecdsaPK
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (pk webAuthnPK) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pk.Bytes())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (pk *webAuthnPK) UnmarshalJSON(bz []byte) error {
+	var key []byte
+	if err := json.Unmarshal(bz, &key); err != nil {
+		return err
+	}
+	return pk.Unmarshal(key)
+}
+
+// clientData holds the client data JSON fields checked during verification.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// String implements proto.Message interface.
+func (m *WebAuthnSignature) String() string {
+	return fmt.Sprintf("WebAuthnSignature{%X, %s, %X}", m.AuthenticatorData, m.ClientDataJSON, m.Signature)
+}
+
+// verifyChallenge checks that the assertion was made by a present user for
+// the challenge derived from msg.
+func (m *WebAuthnSignature) verifyChallenge(msg []byte) error {
+	if len(m.AuthenticatorData) < authenticatorDataMinSize {
+		return fmt.Errorf("authenticator data too short: %d < %d", len(m.AuthenticatorData), authenticatorDataMinSize)
+	}
+	if m.AuthenticatorData[sha256.Size]&authenticatorDataFlagUP == 0 {
+		return fmt.Errorf("user presence flag not set")
+	}
+
+	var cd clientData
+	if err := json.Unmarshal(m.ClientDataJSON, &cd); err != nil {
+		return fmt.Errorf("invalid client data JSON: %w", err)
+	}
+	if cd.Type != webAuthnTypeGet {
+		return fmt.Errorf("invalid client data type: %q", cd.Type)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(cd.Challenge)
+	if err != nil {
+		return fmt.Errorf("invalid client data challenge: %w", err)
+	}
+	expected := WebAuthnChallenge(msg)
+	if !bytes.Equal(challenge, expected) {
+		return fmt.Errorf("client data challenge mismatch")
+	}
+
+	return nil
+}
+
+// WebAuthnChallenge returns the WebAuthn assertion challenge for msg, which is
+// its SHA-256 hash.
+func WebAuthnChallenge(msg []byte) []byte {
+	h := sha256.Sum256(msg)
+	return h[:]
+}
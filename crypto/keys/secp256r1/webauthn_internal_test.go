@@ -0,0 +1,134 @@
+package secp256r1
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func TestWebAuthnPKSuite(t *testing.T) {
+	suite.Run(t, new(WebAuthnPKSuite))
+}
+
+type WebAuthnPKSuite struct {
+	CommonSuite
+	wpk *WebAuthnPubKey
+}
+
+func (suite *WebAuthnPKSuite) SetupSuite() {
+	suite.CommonSuite.SetupSuite()
+	suite.wpk = NewWebAuthnPubKey(suite.pk)
+}
+
+// assert signs msg the way a WebAuthn authenticator would and returns the
+// encoded assertion.
+func (suite *WebAuthnPKSuite) assert(msg []byte, clientDataType string, flags byte) []byte {
+	authData := make([]byte, authenticatorDataMinSize)
+	rpIDHash := sha256.Sum256([]byte("wallet.example.com"))
+	copy(authData, rpIDHash[:])
+	authData[sha256.Size] = flags
+
+	clientDataJSON := []byte(fmt.Sprintf(
+		`{"type":%q,"challenge":%q,"origin":"https://wallet.example.com"}`,
+		clientDataType, base64.RawURLEncoding.EncodeToString(WebAuthnChallenge(msg)),
+	))
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	sig, err := suite.sk.Sign(append(append([]byte{}, authData...), clientDataHash[:]...))
+	suite.Require().NoError(err)
+
+	bz, err := (&WebAuthnSignature{
+		AuthenticatorData: authData,
+		ClientDataJSON:    clientDataJSON,
+		Signature:         sig,
+	}).Marshal()
+	suite.Require().NoError(err)
+	return bz
+}
+
+func (suite *WebAuthnPKSuite) TestType() {
+	suite.Require().Equal(webAuthnName, suite.wpk.Type())
+}
+
+func (suite *WebAuthnPKSuite) TestAddress() {
+	require := suite.Require()
+
+	require.Equal(suite.pk.Bytes(), suite.wpk.Bytes())
+	require.NotEqual(suite.pk.Address(), suite.wpk.Address())
+}
+
+func (suite *WebAuthnPKSuite) TestEquals() {
+	require := suite.Require()
+
+	require.False(suite.wpk.Equals(suite.pk))
+	require.True(suite.wpk.Equals(NewWebAuthnPubKey(suite.pk)))
+}
+
+func (suite *WebAuthnPKSuite) TestVerifySignature() {
+	msg := []byte("sign bytes")
+
+	testCases := []struct {
+		name   string
+		sig    []byte
+		verify bool
+	}{
+		{"valid assertion", suite.assert(msg, webAuthnTypeGet, authenticatorDataFlagUP), true},
+		{"wrong message", suite.assert([]byte("other sign bytes"), webAuthnTypeGet, authenticatorDataFlagUP), false},
+		{"wrong client data type", suite.assert(msg, "webauthn.create", authenticatorDataFlagUP), false},
+		{"user not present", suite.assert(msg, webAuthnTypeGet, 0), false},
+		{"plain secp256r1 signature", func() []byte {
+			sig, err := suite.sk.Sign(msg)
+			suite.Require().NoError(err)
+			return sig
+		}(), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.verify, suite.wpk.VerifySignature(msg, tc.sig))
+		})
+	}
+}
+
+func (suite *WebAuthnPKSuite) TestMarshalProto() {
+	require := suite.Require()
+
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterface(suite.wpk)
+	require.NoError(err)
+
+	var pk cryptotypes.PubKey
+	require.NoError(cdc.UnmarshalInterface(bz, &pk))
+	require.True(pk.Equals(suite.wpk))
+}
+
+func (suite *WebAuthnPKSuite) TestMarshalJSON() {
+	require := suite.Require()
+
+	registry := types.NewInterfaceRegistry()
+	RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	bz, err := cdc.MarshalInterfaceJSON(suite.wpk)
+	require.NoError(err)
+	require.Contains(string(bz), fmt.Sprintf(`"key":"%s"`, base64.StdEncoding.EncodeToString(suite.wpk.Bytes())))
+
+	var pk cryptotypes.PubKey
+	require.NoError(cdc.UnmarshalInterfaceJSON(bz, &pk))
+	require.True(pk.Equals(suite.wpk))
+
+	// the JSON encoding of secp256r1 PubKey is left unchanged
+	_, ok := any(suite.pk.Key).(json.Marshaler)
+	require.False(ok)
+}
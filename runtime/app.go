@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -213,7 +214,7 @@ func (a *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (a *App) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry)
+	authtx.RegisterTxService(a.GRPCQueryRouter(), clientCtx, a.Simulate, a.interfaceRegistry, authtx.WithSimulateBundle(a.SimulateBundle))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -230,6 +231,7 @@ func (a *App) RegisterTendermintService(clientCtx client.Context) {
 // RegisterNodeService registers the node gRPC service on the app gRPC router.
 func (a *App) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, a.GRPCQueryRouter(), cfg)
+	mempoolservice.RegisterMempoolService(clientCtx, a.GRPCQueryRouter(), a.Mempool())
 }
 
 // Configurator returns the app's configurator.
@@ -43,6 +43,15 @@ type BaseConfig struct {
 	// If set to 0, it is unbounded.
 	QueryGasLimit uint64 `mapstructure:"query-gas-limit"`
 
+	// SimulateBundleMaxTxs defines the maximum number of txs of a bundle
+	// simulated by the SimulateBundle gRPC method. If 0, the default is used.
+	SimulateBundleMaxTxs int `mapstructure:"simulate-bundle-max-txs"`
+
+	// SimulateBundleMaxGas defines the maximum gas the txs of a bundle simulated
+	// by the SimulateBundle gRPC method may consume in total. If 0, the default
+	// is used.
+	SimulateBundleMaxGas uint64 `mapstructure:"simulate-bundle-max-gas"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
@@ -92,6 +101,11 @@ type BaseConfig struct {
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the CometBFT config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
+
+	// BlockSTMWorkers defines the number of workers used to execute the
+	// transactions of a block in parallel using Block-STM. If 0, transactions
+	// are executed serially.
+	BlockSTMWorkers int `mapstructure:"block-stm-workers"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -167,13 +181,18 @@ type MempoolConfig struct {
 	// unbounded in how many txs it may contain, and a positive value indicates
 	// the maximum amount of txs it may contain.
 	MaxTxs int `mapstructure:"max-txs"`
+
+	// Journal defines whether the txs of the mempool are journaled to disk, such
+	// that they are replayed into the mempool when the node restarts.
+	Journal bool `mapstructure:"journal"`
 }
 
 // State Streaming configuration
 type (
 	// StreamingConfig defines application configuration for external streaming services
 	StreamingConfig struct {
-		ABCI ABCIListenerConfig `mapstructure:"abci"`
+		ABCI ABCIListenerConfig  `mapstructure:"abci"`
+		GRPC GRPCStreamingConfig `mapstructure:"grpc"`
 	}
 	// ABCIListenerConfig defines application configuration for ABCIListener streaming service
 	ABCIListenerConfig struct {
@@ -181,6 +200,13 @@ type (
 		Plugin        string   `mapstructure:"plugin"`
 		StopNodeOnErr bool     `mapstructure:"stop-node-on-err"`
 	}
+	// GRPCStreamingConfig defines application configuration for the built-in
+	// gRPC streaming service
+	GRPCStreamingConfig struct {
+		Enable       bool     `mapstructure:"enable"`
+		Keys         []string `mapstructure:"keys"`
+		RetainBlocks uint32   `mapstructure:"retain-blocks"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -228,17 +254,20 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			QueryGasLimit:       0,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250,
-			IAVLDisableFastNode: false,
-			AppDBBackend:        "",
+			MinGasPrices:         defaultMinGasPrices,
+			QueryGasLimit:        0,
+			SimulateBundleMaxTxs: 0,
+			SimulateBundleMaxGas: 0,
+			InterBlockCache:      true,
+			Pruning:              pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:    "0",
+			PruningInterval:      "0",
+			MinRetainBlocks:      0,
+			IndexEvents:          make([]string, 0),
+			IAVLCacheSize:        781250,
+			IAVLDisableFastNode:  false,
+			AppDBBackend:         "",
+			BlockSTMWorkers:      0,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -270,6 +299,10 @@ func DefaultConfig() *Config {
 				Keys:          []string{},
 				StopNodeOnErr: true,
 			},
+			GRPC: GRPCStreamingConfig{
+				Keys:         []string{},
+				RetainBlocks: 100,
+			},
 		},
 		Mempool: MempoolConfig{
 			MaxTxs: 5_000,
@@ -44,6 +44,11 @@ func TestStreamingConfig(t *testing.T) {
 				Plugin:        "plugin-A",
 				StopNodeOnErr: false,
 			},
+			GRPC: GRPCStreamingConfig{
+				Enable:       true,
+				Keys:         []string{"bank"},
+				RetainBlocks: 10,
+			},
 		},
 	}
 
@@ -61,6 +66,8 @@ func TestStreamingConfig(t *testing.T) {
 		`keys = ["one", "two", ]`,
 		`plugin = "plugin-A"`,
 		`stop-node-on-err = false`,
+		`keys = ["bank", ]`,
+		`retain-blocks = 10`,
 	}
 
 	for _, line := range expectedLines {
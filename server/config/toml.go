@@ -25,6 +25,12 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # If this is set to zero, the query can consume an unbounded amount of gas.
 query-gas-limit = "{{ .BaseConfig.QueryGasLimit }}"
 
+# The maximum number of txs of a bundle simulated over gRPC, and the maximum gas
+# they may consume in total. If set to zero, the defaults of 16 txs and 100000000
+# gas are used.
+simulate-bundle-max-txs = {{ .BaseConfig.SimulateBundleMaxTxs }}
+simulate-bundle-max-gas = {{ .BaseConfig.SimulateBundleMaxGas }}
+
 # default: the last 362880 states are kept, pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: 2 latest states will be kept; pruning at 10 block intervals.
@@ -86,6 +92,12 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # The fallback is the db_backend value set in CometBFT's config.toml.
 app-db-backend = "{{ .BaseConfig.AppDBBackend }}"
 
+# BlockSTMWorkers defines the number of workers used to execute the transactions
+# of a block optimistically in parallel using Block-STM. The results are
+# identical to executing the transactions serially.
+# Default is 0, which executes the transactions serially.
+block-stm-workers = {{ .BaseConfig.BlockSTMWorkers }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
@@ -223,6 +235,24 @@ plugin = "{{ .Streaming.ABCI.Plugin }}"
 # stop-node-on-err specifies whether to stop the node on message delivery error.
 stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 
+# streaming.grpc specifies the configuration for the built-in gRPC streaming
+# service, which streams the committed blocks along with their state changes to
+# the subscribers of cosmos.streaming.v1.StreamService over the gRPC server,
+# without requiring a plugin.
+[streaming.grpc]
+
+# Enable defines if the gRPC streaming service should be enabled.
+enable = {{ .Streaming.GRPC.Enable }}
+
+# List of kv store keys whose state changes are streamed out.
+# ["*"] to expose all keys.
+keys = [{{ range .Streaming.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# RetainBlocks defines the number of recent blocks kept in memory, from which
+# subscribers may resume their stream. Subscribers falling behind the retained
+# blocks are dropped. The retained blocks are lost when the node restarts.
+retain-blocks = {{ .Streaming.GRPC.RetainBlocks }}
+
 ###############################################################################
 ###                         Mempool                                         ###
 ###############################################################################
@@ -235,6 +265,11 @@ stop-node-on-err = {{ .Streaming.ABCI.StopNodeOnErr }}
 # Note, this configuration only applies to SDK built-in app-side mempool
 # implementations.
 max-txs = {{ .Mempool.MaxTxs }}
+
+# Journal defines whether the txs of the mempool are journaled to disk, in the
+# data directory, such that they are replayed into the mempool, and validated
+# again, when the node restarts.
+journal = {{ .Mempool.Journal }}
 `
 
 var configTemplate *template.Template
@@ -44,19 +44,21 @@ import (
 
 const (
 	// CometBFT full-node start flags
-	flagWithComet          = "with-comet"
-	flagAddress            = "address"
-	flagTransport          = "transport"
-	flagTraceStore         = "trace-store"
-	flagCPUProfile         = "cpu-profile"
-	FlagMinGasPrices       = "minimum-gas-prices"
-	FlagQueryGasLimit      = "query-gas-limit"
-	FlagHaltHeight         = "halt-height"
-	FlagHaltTime           = "halt-time"
-	FlagInterBlockCache    = "inter-block-cache"
-	FlagUnsafeSkipUpgrades = "unsafe-skip-upgrades"
-	FlagTrace              = "trace"
-	FlagInvCheckPeriod     = "inv-check-period"
+	flagWithComet            = "with-comet"
+	flagAddress              = "address"
+	flagTransport            = "transport"
+	flagTraceStore           = "trace-store"
+	flagCPUProfile           = "cpu-profile"
+	FlagMinGasPrices         = "minimum-gas-prices"
+	FlagQueryGasLimit        = "query-gas-limit"
+	FlagSimulateBundleMaxTxs = "simulate-bundle-max-txs"
+	FlagSimulateBundleMaxGas = "simulate-bundle-max-gas"
+	FlagHaltHeight           = "halt-height"
+	FlagHaltTime             = "halt-time"
+	FlagInterBlockCache      = "inter-block-cache"
+	FlagUnsafeSkipUpgrades   = "unsafe-skip-upgrades"
+	FlagTrace                = "trace"
+	FlagInvCheckPeriod       = "inv-check-period"
 
 	FlagPruning             = "pruning"
 	FlagPruningKeepRecent   = "pruning-keep-recent"
@@ -66,6 +68,7 @@ const (
 	FlagIAVLCacheSize       = "iavl-cache-size"
 	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
 	FlagShutdownGrace       = "shutdown-grace"
+	FlagBlockSTMWorkers     = "block-stm-workers"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -88,7 +91,8 @@ const (
 	flagGRPCWebEnable = "grpc-web.enable"
 
 	// mempool flags
-	FlagMempoolMaxTxs = "mempool.max-txs"
+	FlagMempoolMaxTxs  = "mempool.max-txs"
+	FlagMempoolJournal = "mempool.journal"
 )
 
 // StartCmdOptions defines options that can be customized in `StartCmdWithOptions`,
@@ -192,6 +196,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
 	cmd.Flags().Uint64(FlagQueryGasLimit, 0, "Maximum gas a Rest/Grpc query can consume. Blank and 0 imply unbounded.")
+	cmd.Flags().Int(FlagSimulateBundleMaxTxs, 0, "Maximum number of txs of a bundle simulated over gRPC (0 uses the default)")
+	cmd.Flags().Uint64(FlagSimulateBundleMaxGas, 0, "Maximum gas the txs of a bundle simulated over gRPC may consume in total (0 uses the default)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
@@ -218,7 +224,9 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagStateSyncSnapshotInterval, 0, "State sync snapshot interval")
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Int(FlagBlockSTMWorkers, 0, "Number of workers executing block transactions in parallel using Block-STM (0 executes them serially)")
 	cmd.Flags().Int(FlagMempoolMaxTxs, mempool.DefaultMaxTx, "Sets MaxTx value for the app-side mempool")
+	cmd.Flags().Bool(FlagMempoolJournal, false, "Journal the txs of the app-side mempool to disk, such that they are replayed on restart")
 	cmd.Flags().Duration(FlagShutdownGrace, 0*time.Second, "On Shutdown, duration to wait for resource clean up")
 
 	// support old flags name for backwards compatibility
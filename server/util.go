@@ -505,11 +505,20 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 
 	defaultMempool := baseapp.SetMempool(mempool.NoOpMempool{})
 	if maxTxs := cast.ToInt(appOpts.Get(FlagMempoolMaxTxs)); maxTxs >= 0 {
-		defaultMempool = baseapp.SetMempool(
-			mempool.NewSenderNonceMempool(
-				mempool.SenderNonceMaxTxOpt(maxTxs),
-			),
+		var mp mempool.Mempool = mempool.NewSenderNonceMempool(
+			mempool.SenderNonceMaxTxOpt(maxTxs),
 		)
+
+		if cast.ToBool(appOpts.Get(FlagMempoolJournal)) {
+			journalDB, err := dbm.NewDB("mempool", GetAppDBBackend(appOpts), filepath.Join(homeDir, "data"))
+			if err != nil {
+				panic(err)
+			}
+
+			mp = mempool.NewJournaledMempool(mp, journalDB)
+		}
+
+		defaultMempool = baseapp.SetMempool(mp)
 	}
 
 	return []func(*baseapp.BaseApp){
@@ -527,6 +536,8 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		defaultMempool,
 		baseapp.SetChainID(chainID),
 		baseapp.SetQueryGasLimit(cast.ToUint64(appOpts.Get(FlagQueryGasLimit))),
+		baseapp.SetSimulateBundleLimits(cast.ToInt(appOpts.Get(FlagSimulateBundleMaxTxs)), cast.ToUint64(appOpts.Get(FlagSimulateBundleMaxGas))),
+		baseapp.SetBlockSTMWorkers(cast.ToInt(appOpts.Get(FlagBlockSTMWorkers))),
 	}
 }
 
@@ -43,6 +43,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -172,6 +173,9 @@ type SimApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// storeMigration migrates the state of the app into store/v2, if enabled
+	storeMigration *storeMigration
 }
 
 func init() {
@@ -257,6 +261,12 @@ func NewSimApp(
 		authzkeeper.StoreKey, nftkeeper.StoreKey, group.StoreKey, pooltypes.StoreKey,
 	)
 
+	// register the store/v2 migration prior to the streaming services
+	storeMigration, err := registerStoreMigration(bApp, appOpts, keys)
+	if err != nil {
+		panic(err)
+	}
+
 	// register streaming services
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
 		panic(err)
@@ -269,6 +279,7 @@ func NewSimApp(
 		txConfig:          txConfig,
 		interfaceRegistry: interfaceRegistry,
 		keys:              keys,
+		storeMigration:    storeMigration,
 	}
 
 	// set the BaseApp's parameter store
@@ -437,6 +448,7 @@ func NewSimApp(
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		authtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -457,7 +469,7 @@ func NewSimApp(
 
 	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	err := app.ModuleManager.RegisterServices(app.configurator)
+	err = app.ModuleManager.RegisterServices(app.configurator)
 	if err != nil {
 		panic(err)
 	}
@@ -530,6 +542,10 @@ func NewSimApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			panic(fmt.Errorf("error loading last version: %w", err))
 		}
+
+		if err := app.startStoreMigration(); err != nil {
+			panic(err)
+		}
 	}
 
 	return app
@@ -544,6 +560,9 @@ func (app *SimApp) setAnteHandler(txConfig client.TxConfig) {
 				SignModeHandler: txConfig.SignModeHandler(),
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+				// the unordered tx keeper is only used if unordered txs are
+				// enabled through the x/auth params
+				UnorderedTxKeeper: app.AccountKeeper,
 			},
 			&app.CircuitKeeper,
 		},
@@ -699,7 +718,10 @@ func (app *SimApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICon
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *SimApp) RegisterTxService(clientCtx client.Context) {
-	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
+	authtx.RegisterTxService(
+		app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry,
+		authtx.WithSimulateBundle(app.BaseApp.SimulateBundle),
+	)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -715,6 +737,7 @@ func (app *SimApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *SimApp) RegisterNodeService(clientCtx client.Context, cfg config.Config) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter(), cfg)
+	mempoolservice.RegisterMempoolService(clientCtx, app.GRPCQueryRouter(), app.Mempool())
 }
 
 // GetMaccPerms returns a copy of the module account permissions
@@ -86,6 +86,9 @@ type SimApp struct {
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// storeMigration migrates the state of the app into store/v2, if enabled
+	storeMigration *storeMigration
 }
 
 func init() {
@@ -224,6 +227,13 @@ func NewSimApp(
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
 
+	// register the store/v2 migration prior to the streaming services
+	storeMigration, err := registerStoreMigration(app.BaseApp, appOpts, app.kvStoreKeys())
+	if err != nil {
+		panic(err)
+	}
+	app.storeMigration = storeMigration
+
 	// register streaming services
 	if err := app.RegisterStreamingServices(appOpts, app.kvStoreKeys()); err != nil {
 		panic(err)
@@ -265,6 +275,12 @@ func NewSimApp(
 		panic(err)
 	}
 
+	if loadLatest {
+		if err := app.startStoreMigration(); err != nil {
+			panic(err)
+		}
+	}
+
 	return app
 }
 
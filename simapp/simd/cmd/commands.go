@@ -65,6 +65,7 @@ func initRootCmd(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().Uint64(simapp.FlagStoreMigrationHeight, 0, "Migrate the state of the app into store/v2 once the given height is committed (0 disables)")
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
@@ -118,6 +119,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		authcmd.GetSimulateBundleCmd(),
 	)
 
 	return cmd
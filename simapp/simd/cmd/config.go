@@ -79,10 +79,20 @@ func initAppConfig() (string, interface{}) {
 		LruSize uint64 `mapstructure:"lru-size"`
 	}
 
+	// StoreMigrationConfig defines the configuration of the migration of the
+	// state of the app into store/v2.
+	type StoreMigrationConfig struct {
+		// Height defines the height once committed of which the state of the app
+		// is migrated into store/v2. 0 disables the migration.
+		Height uint64 `mapstructure:"height"`
+	}
+
 	type CustomAppConfig struct {
 		serverconfig.Config `mapstructure:",squash"`
 
 		WASM WASMConfig `mapstructure:"wasm"`
+
+		StoreMigration StoreMigrationConfig `mapstructure:"store-migration"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -121,7 +131,14 @@ func initAppConfig() (string, interface{}) {
 query-gas-limit = {{ .WASM.QueryGasLimit }}
 # This is the number of wasm vm instances we keep cached in memory for speed-up
 # Warning: this is currently unstable and may lead to crashes, best to keep for 0 unless testing locally
-lru-size = {{ .WASM.LruSize }}`
+lru-size = {{ .WASM.LruSize }}
+
+[store-migration]
+# Height defines the height once committed of which the state of the app is
+# migrated into store/v2, in the store-v2 directory of the data directory. The
+# changesets of the blocks committed after it are recorded until the migration
+# is finalized. Setting height to 0 disables the migration.
+height = {{ .StoreMigration.Height }}`
 
 	return customAppTemplate, customAppConfig
 }
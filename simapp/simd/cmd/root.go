@@ -13,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/config"
+	mempoolservice "github.com/cosmos/cosmos-sdk/client/grpc/mempool"
 	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
@@ -113,6 +114,10 @@ func NewRootCmd() *cobra.Command {
 	autoCliOpts.Keyring, _ = keyring.NewAutoCLIKeyring(initClientCtx.Keyring)
 	autoCliOpts.ClientCtx = initClientCtx
 
+	// add the commands of the mempool service, registered along with the node service
+	mempoolCmds := mempoolservice.NewMempoolCommands()
+	autoCliOpts.Modules[mempoolCmds.Name()] = mempoolCmds
+
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
 	}
@@ -0,0 +1,232 @@
+package simapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	storev2 "cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/migration"
+	storepruning "cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage/sqlite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlagStoreMigrationHeight defines the height once committed of which the
+// state of the app is migrated into store/v2. 0 disables the migration.
+const FlagStoreMigrationHeight = "store-migration.height"
+
+var _ storetypes.ABCIListener = (*storeMigration)(nil)
+
+// storeMigration migrates the state of the app into a store/v2 RootStore. It
+// listens to the changesets committed by the app, starting the migration once
+// the migration height is committed and recording every changeset committed
+// after it.
+type storeMigration struct {
+	height uint64
+
+	// storeKeys are the names of the migrated stores
+	storeKeys map[string]struct{}
+
+	target    storev2.RootStore
+	manager   *migration.Manager
+	finalized bool
+
+	// appHash is the app hash of the block being committed
+	appHash []byte
+}
+
+// registerStoreMigration creates the target RootStore of the store migration,
+// if a migration height is configured, and listens to the changesets committed
+// to the given stores. It must be called prior to registering the streaming
+// services, as it resets the streaming manager of the app.
+func registerStoreMigration(bApp *baseapp.BaseApp, appOpts servertypes.AppOptions, keys map[string]*storetypes.KVStoreKey) (*storeMigration, error) {
+	height := cast.ToUint64(appOpts.Get(FlagStoreMigrationHeight))
+	if height == 0 {
+		return nil, nil
+	}
+
+	rms, ok := bApp.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return nil, fmt.Errorf("store migration requires a %T commit multi-store, got %T", &rootmulti.Store{}, bApp.CommitMultiStore())
+	}
+
+	m := &storeMigration{
+		height:    height,
+		storeKeys: make(map[string]struct{}, len(keys)),
+	}
+
+	var (
+		listened  []storetypes.StoreKey
+		storeKeys []string
+	)
+	for name, key := range keys {
+		listened = append(listened, key)
+		storeKeys = append(storeKeys, name)
+		m.storeKeys[name] = struct{}{}
+	}
+	sort.Strings(storeKeys)
+
+	target, err := newStoreMigrationTarget(appOpts, storeKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create store migration target: %w", err)
+	}
+
+	m.manager, err = migration.NewManager(bApp.Logger(), rms, target)
+	if err != nil {
+		return nil, errors.Join(err, target.Close())
+	}
+	m.target = target
+
+	rms.AddListeners(listened)
+	bApp.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{m},
+	})
+
+	return m, nil
+}
+
+// newStoreMigrationTarget creates the store/v2 RootStore the state of the app is
+// migrated into, in the store-v2 directory of the data directory. SQLite is used
+// as SS backend and an IAVL tree per store as SC backend, both pruned according
+// to the pruning options of the app.
+func newStoreMigrationTarget(appOpts servertypes.AppOptions, storeKeys []string) (storev2.RootStore, error) {
+	pruningOpts, err := server.GetPruningOptionsFromFlags(appOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "store-v2")
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("failed to create store-v2 directory: %w", err)
+	}
+
+	ss, err := sqlite.New(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	scDB, err := dbm.NewDB("sc", server.GetAppDBBackend(appOpts), dir)
+	if err != nil {
+		return nil, errors.Join(err, ss.Close())
+	}
+
+	trees := make(map[string]storev2.Tree, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		prefixDB := dbm.NewPrefixDB(scDB, []byte(fmt.Sprintf("s/k:%s/", storeKey)))
+		trees[storeKey] = iavl.NewIavlTree(prefixDB, log.NewNopLogger(), iavl.DefaultConfig())
+	}
+
+	opts := storepruning.NewCustomOptions(pruningOpts.KeepRecent, pruningOpts.Interval)
+	rs, err := root.New(log.NewNopLogger(), 1, ss, commitment.NewDatabase(trees), opts, opts)
+	if err != nil {
+		return nil, errors.Join(err, ss.Close(), scDB.Close())
+	}
+
+	return rs, nil
+}
+
+// startStoreMigration starts the store migration at the latest committed height
+// if the migration height is already committed, e.g. when the node restarts
+// while migrating.
+func (app *SimApp) startStoreMigration() error {
+	m := app.storeMigration
+	if m == nil {
+		return nil
+	}
+
+	if height := uint64(app.LastBlockHeight()); height > 0 && height >= m.height {
+		m.height = height
+		return m.manager.Start(height)
+	}
+
+	return nil
+}
+
+// FinalizeStoreMigration blocks until the store/v2 RootStore the state of the
+// app is migrated into has caught up with the latest committed height, and
+// returns it. No changeset is recorded once the migration is finalized, so the
+// app must switch to the returned RootStore prior to committing the next block.
+func (app *SimApp) FinalizeStoreMigration() (storev2.RootStore, error) {
+	if app.storeMigration == nil {
+		return nil, errors.New("store migration is not enabled")
+	}
+
+	target, err := app.storeMigration.manager.Finalize(uint64(app.LastBlockHeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	app.storeMigration.finalized = true
+
+	return target, nil
+}
+
+// Close closes the BaseApp and the target of an unfinalized store migration,
+// which is otherwise owned by the caller of FinalizeStoreMigration.
+func (app *SimApp) Close() error {
+	err := app.BaseApp.Close()
+	if m := app.storeMigration; m != nil && !m.finalized {
+		app.Logger().Info("Closing store migration target")
+		err = errors.Join(err, m.target.Close())
+	}
+
+	return err
+}
+
+// ListenFinalizeBlock implements the ABCIListener interface.
+func (m *storeMigration) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	m.appHash = res.AppHash
+	return nil
+}
+
+// ListenCommit implements the ABCIListener interface.
+func (m *storeMigration) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	if m.finalized {
+		return nil
+	}
+
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	switch {
+	case height == m.height:
+		return m.manager.Start(height)
+
+	case height > m.height:
+		cs := storev2.NewChangeset()
+		for _, pair := range changeSet {
+			// skip the stores listened to by other listeners only
+			if _, ok := m.storeKeys[pair.StoreKey]; !ok {
+				continue
+			}
+
+			kvPair := storev2.KVPair{StoreKey: pair.StoreKey, Key: pair.Key}
+			if !pair.Delete {
+				kvPair.Value = pair.Value
+			}
+
+			cs.AddKVPair(kvPair)
+		}
+
+		return m.manager.Record(height, cs, m.appHash)
+	}
+
+	return nil
+}
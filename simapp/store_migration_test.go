@@ -0,0 +1,70 @@
+package simapp
+
+import (
+	"bytes"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	pruningtypes "cosmossdk.io/store/pruning/types"
+	"cosmossdk.io/store/v2/root"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	const (
+		migrationHeight = 3
+		blocks          = 6
+	)
+
+	app := NewSimappWithCustomOptions(t, false, SetupOptions{
+		Logger: log.NewNopLogger(),
+		DB:     dbm.NewMemDB(),
+		AppOpts: simtestutil.AppOptionsMap{
+			flags.FlagHome:           t.TempDir(),
+			server.FlagPruning:       pruningtypes.PruningOptionNothing,
+			FlagStoreMigrationHeight: migrationHeight,
+		},
+	})
+
+	_, err := app.FinalizeStoreMigration()
+	require.Error(t, err, "the migration must not be finalized prior to starting")
+
+	for height := int64(1); height <= blocks; height++ {
+		_, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: height})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	target, err := app.FinalizeStoreMigration()
+	require.NoError(t, err)
+	defer target.Close()
+
+	version, err := target.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(blocks), version)
+
+	// the app hash of the target matches the app hash of the app
+	lastCommitID, err := target.(*root.Store).LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, app.LastCommitID().Hash, lastCommitID.Hash)
+
+	bankStore := app.CommitMultiStore().GetKVStore(app.GetKey(banktypes.StoreKey))
+	iter := bankStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	// the state of the target matches the state of the app
+	for ; iter.Valid(); iter.Next() {
+		require.True(t, bytes.Equal(iter.Value(), target.GetKVStore(banktypes.StoreKey).Get(iter.Key())), "key %X", iter.Key())
+	}
+
+	require.NoError(t, app.Close())
+}
@@ -27,6 +27,67 @@ type iterator struct {
 	exhausted bool // exhausted reflects if the parent iterator is exhausted or not
 }
 
+// NewMergedIterator returns an iterator over the domain [start, end) that walks
+// over both the given changeset, i.e. dirty writes keyed by key, and the parent
+// iterator at the same time. Entries in the changeset take precedence over the
+// parent iterator, where a nil value denotes a deletion.
+//
+// Note, the changeset is not retained by the iterator, i.e. writes to the
+// changeset after the iterator is created do not affect the iterator.
+func NewMergedIterator(parentItr store.Iterator, changeset map[string]store.KVPair, start, end []byte, reverse bool) store.Iterator {
+	startStr := string(start)
+	endStr := string(end)
+
+	keys := make([]string, 0, len(changeset))
+	for key := range changeset {
+		switch {
+		case start != nil && end != nil:
+			if key >= startStr && key < endStr {
+				keys = append(keys, key)
+			}
+
+		case start != nil:
+			if key >= startStr {
+				keys = append(keys, key)
+			}
+
+		case end != nil:
+			if key < endStr {
+				keys = append(keys, key)
+			}
+
+		default:
+			keys = append(keys, key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	if reverse {
+		slices.Reverse(keys)
+	}
+
+	values := make([]store.KVPair, len(keys))
+	for i, key := range keys {
+		values[i] = changeset[key]
+	}
+
+	itr := &iterator{
+		parentItr: parentItr,
+		start:     start,
+		end:       end,
+		keys:      keys,
+		values:    values,
+		reverse:   reverse,
+		exhausted: !parentItr.Valid(),
+	}
+
+	// call Next() to move the iterator to the first key/value entry
+	_ = itr.Next()
+
+	return itr
+}
+
 // Domain returns the domain of the iterator. The caller must not modify the
 // return values.
 func (itr *iterator) Domain() ([]byte, []byte) {
@@ -257,56 +257,6 @@ func (s *Store) ReverseIterator(start, end []byte) store.Iterator {
 	return s.newIterator(parentItr, start, end, true)
 }
 
-func (s *Store) newIterator(parentItr store.Iterator, start, end []byte, reverse bool) *iterator {
-	startStr := string(start)
-	endStr := string(end)
-
-	keys := make([]string, 0, len(s.changeset))
-	for key := range s.changeset {
-		switch {
-		case start != nil && end != nil:
-			if key >= startStr && key < endStr {
-				keys = append(keys, key)
-			}
-
-		case start != nil:
-			if key >= startStr {
-				keys = append(keys, key)
-			}
-
-		case end != nil:
-			if key < endStr {
-				keys = append(keys, key)
-			}
-
-		default:
-			keys = append(keys, key)
-		}
-	}
-
-	slices.Sort(keys)
-
-	if reverse {
-		slices.Reverse(keys)
-	}
-
-	values := make([]store.KVPair, len(keys))
-	for i, key := range keys {
-		values[i] = s.changeset[key]
-	}
-
-	itr := &iterator{
-		parentItr: parentItr,
-		start:     start,
-		end:       end,
-		keys:      keys,
-		values:    values,
-		reverse:   reverse,
-		exhausted: !parentItr.Valid(),
-	}
-
-	// call Next() to move the iterator to the first key/value entry
-	_ = itr.Next()
-
-	return itr
+func (s *Store) newIterator(parentItr store.Iterator, start, end []byte, reverse bool) store.Iterator {
+	return NewMergedIterator(parentItr, s.changeset, start, end, reverse)
 }
@@ -9,6 +9,14 @@ type KVPair struct {
 	StoreKey string // optional
 }
 
+// KeyChange defines a change to a key at a given version, where Deleted denotes
+// the key being deleted at that version, in which case Value is nil.
+type KeyChange struct {
+	Version uint64
+	Value   []byte
+	Deleted bool
+}
+
 // Changeset defines a set of KVPair entries.
 type Changeset struct {
 	Pairs []KVPair
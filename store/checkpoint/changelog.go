@@ -0,0 +1,31 @@
+package checkpoint
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+// encodeChangeset encodes the key-value pairs of a single store key.
+func encodeChangeset(pairs []store.KVPair) []byte {
+	return encoding.AppendChangeset(nil, store.NewChangeset(pairs...))
+}
+
+// decodeChangeset decodes the key-value pairs encoded via encodeChangeset into a
+// changeset for the given store key.
+func decodeChangeset(storeKey string, bz []byte) (*store.Changeset, error) {
+	d := encoding.NewDecoder(bz)
+	cs := d.Changeset()
+	if err := d.Err(); err != nil {
+		return nil, fmt.Errorf("failed to decode changeset: %w", err)
+	}
+
+	for _, kvPair := range cs.Pairs {
+		if kvPair.StoreKey != storeKey {
+			return nil, fmt.Errorf("unexpected store key %s of key %X", kvPair.StoreKey, kvPair.Key)
+		}
+	}
+
+	return cs, nil
+}
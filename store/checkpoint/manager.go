@@ -0,0 +1,517 @@
+package checkpoint
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+	ics23 "github.com/cosmos/ics23/go"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/pruning"
+)
+
+const (
+	// checkpointPrefix prefixes the index of completed checkpoints, mapping the
+	// version to the store keys checkpointed at that version.
+	checkpointPrefix = "c/"
+
+	// treePrefix prefixes the nodes of every checkpointed tree.
+	treePrefix = "t/"
+
+	// changelogPrefix prefixes the changesets of every version, per store key.
+	changelogPrefix = "l/"
+)
+
+// Manager maintains sparse checkpoints of the State Commitment (SC) trees in
+// order to serve ICS23 proofs for historical versions that have been pruned
+// from the SC trees.
+//
+// Every interval versions, each SC tree is exported into the checkpoint
+// database in the background. In addition, the changeset of every committed
+// version is recorded as a compact changelog. Note, the SS backend does not
+// reflect writes that leave a value unchanged, which nonetheless modify the
+// IAVL tree, hence the changelog. A proof for a historical version is served
+// by restoring the closest prior checkpoint into memory and replaying the
+// changelog up to the requested version, where the value side of the proof is
+// verified against the versioned SS backend. The restored tree of each store key
+// is kept in memory, so that subsequent proofs from the same checkpoint only
+// replay the versions that were not replayed yet.
+//
+// Checkpoints, along with the changelog they make redundant, are pruned via
+// Prune according to the pruning options, the same way SC trees are. The
+// interval trades disk usage for the latency of historical proofs.
+type Manager struct {
+	logger   log.Logger
+	db       dbm.DB
+	ss       store.VersionedDatabase
+	interval uint64
+	opts     pruning.Options
+
+	// wg tracks any in-flight checkpoints
+	wg sync.WaitGroup
+
+	// mtx guards checkpoints and restored
+	mtx sync.RWMutex
+
+	// checkpoints reflects the completed checkpoints, mapped to the store keys
+	// that were checkpointed
+	checkpoints map[uint64][]string
+
+	// restored reflects the last checkpoint restored into memory per store key
+	restored map[string]*restoredTree
+}
+
+// restoredTree is a checkpoint restored into memory, on top of which the
+// changelog is replayed up to version.
+type restoredTree struct {
+	// mtx guards version and tree
+	mtx sync.Mutex
+
+	checkpoint uint64
+	version    uint64
+	tree       store.Tree
+}
+
+// NewManager returns a new checkpoint Manager which writes checkpoints every
+// interval versions to the given database, and verifies historical values
+// against the given SS backend. Checkpoints are pruned according to the given
+// pruning options. The interval must be greater than 0.
+func NewManager(
+	logger log.Logger,
+	db dbm.DB,
+	ss store.VersionedDatabase,
+	interval uint64,
+	opts pruning.Options,
+) (*Manager, error) {
+	if interval == 0 {
+		return nil, fmt.Errorf("checkpoint interval must be greater than 0")
+	}
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint pruning options: %w", err)
+	}
+
+	m := &Manager{
+		logger:      logger.With("module", "checkpoint"),
+		db:          db,
+		ss:          ss,
+		interval:    interval,
+		opts:        opts,
+		checkpoints: make(map[uint64][]string),
+		restored:    make(map[string]*restoredTree),
+	}
+
+	if err := m.loadCheckpoints(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *Manager) loadCheckpoints() error {
+	itr, err := dbm.IteratePrefix(m.db, []byte(checkpointPrefix))
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		version := binary.BigEndian.Uint64(itr.Key()[len(checkpointPrefix):])
+
+		storeKeys, err := decodeStoreKeys(itr.Value())
+		if err != nil {
+			return fmt.Errorf("failed to decode checkpoint at version %d: %w", version, err)
+		}
+
+		m.checkpoints[version] = storeKeys
+	}
+
+	return itr.Error()
+}
+
+// ShouldCheckpoint returns true if a checkpoint should be written after the
+// given version is committed.
+func (m *Manager) ShouldCheckpoint(version uint64) bool {
+	return version%m.interval == 0
+}
+
+// Checkpoints returns the versions of all completed checkpoints in ascending
+// order.
+func (m *Manager) Checkpoints() []uint64 {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	versions := make([]uint64, 0, len(m.checkpoints))
+	for version := range m.checkpoints {
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+
+	return versions
+}
+
+// Record records the changeset committed at the given version to the changelog.
+func (m *Manager) Record(version uint64, cs *store.Changeset) error {
+	pairs := make(map[string][]store.KVPair)
+	for _, kvPair := range cs.Pairs {
+		pairs[kvPair.StoreKey] = append(pairs[kvPair.StoreKey], kvPair)
+	}
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	for storeKey, kvPairs := range pairs {
+		if err := batch.Set(changelogKey(version, storeKey), encodeChangeset(kvPairs)); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// Checkpoint writes a checkpoint of every SC tree at the given version in the
+// background. The version must not be pruned from the SC trees until release
+// is called, which happens once the checkpoint is complete, regardless of its
+// outcome.
+func (m *Manager) Checkpoint(version uint64, sc *commitment.Database, release func()) {
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		defer release()
+
+		if err := m.checkpoint(version, sc); err != nil {
+			m.logger.Error("failed to checkpoint", "version", version, "err", err)
+		}
+	}()
+}
+
+func (m *Manager) checkpoint(version uint64, sc *commitment.Database) error {
+	m.logger.Debug("checkpointing", "version", version)
+
+	storeKeys := sc.StoreKeys()
+	for _, storeKey := range storeKeys {
+		tree := sc.GetTree(storeKey)
+		if tree == nil {
+			return fmt.Errorf("%w: %s", store.ErrUnknownStoreKey, storeKey)
+		}
+
+		if err := copyTree(tree, m.checkpointTree(version, storeKey), version); err != nil {
+			return fmt.Errorf("failed to checkpoint tree %s: %w", storeKey, err)
+		}
+	}
+
+	if err := m.db.SetSync(checkpointKey(version), encodeStoreKeys(storeKeys)); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	m.checkpoints[version] = storeKeys
+	m.mtx.Unlock()
+
+	return nil
+}
+
+// GetProof returns a proof for the given key at the given version of the given
+// store key, reconstructed from the closest checkpoint at or prior to the
+// version. An error is returned if no such checkpoint exists or if the proof is
+// inconsistent with the value found in the SS backend.
+func (m *Manager) GetProof(storeKey string, version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	checkpoint, ok := m.closestCheckpoint(storeKey, version)
+	if !ok {
+		return nil, fmt.Errorf("no checkpoint of store %s found at or prior to version %d", storeKey, version)
+	}
+
+	var (
+		proof *ics23.CommitmentProof
+		err   error
+	)
+	if checkpoint == version {
+		proof, err = m.checkpointTree(checkpoint, storeKey).GetProof(version, key)
+	} else {
+		proof, err = m.restoredProof(storeKey, checkpoint, version, key)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// verify the value side of the proof against SS
+	value, err := m.ss.Get(storeKey, version, key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get value from SS: %w", err)
+	}
+
+	if value != nil {
+		if exist := proof.GetExist(); exist == nil || !bytes.Equal(exist.Value, value) {
+			return nil, fmt.Errorf("reconstructed proof of key %X at version %d does not match SS", key, version)
+		}
+	} else if proof.GetNonexist() == nil {
+		return nil, fmt.Errorf("reconstructed proof of key %X at version %d does not match SS", key, version)
+	}
+
+	return proof, nil
+}
+
+// closestCheckpoint returns the latest checkpoint of the given store key at or
+// prior to the given version.
+func (m *Manager) closestCheckpoint(storeKey string, version uint64) (uint64, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	var (
+		closest uint64
+		found   bool
+	)
+	for checkpoint, storeKeys := range m.checkpoints {
+		if checkpoint > version || (found && checkpoint < closest) {
+			continue
+		}
+
+		if slices.Contains(storeKeys, storeKey) {
+			closest, found = checkpoint, true
+		}
+	}
+
+	return closest, found
+}
+
+// restoredProof returns a proof for the given key at the given version of the
+// given store key, from the given checkpoint restored into memory with the
+// changelog replayed on top of it up to the version.
+func (m *Manager) restoredProof(storeKey string, checkpoint, version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	rt := m.restoredTree(storeKey, checkpoint)
+
+	rt.mtx.Lock()
+	defer rt.mtx.Unlock()
+
+	if rt.tree == nil {
+		tree := iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())
+		if err := copyTree(m.checkpointTree(checkpoint, storeKey), tree, checkpoint); err != nil {
+			return nil, fmt.Errorf("failed to restore checkpoint %d: %w", checkpoint, err)
+		}
+
+		rt.tree, rt.version = tree, checkpoint
+	}
+
+	for v := rt.version + 1; v <= version; v++ {
+		if err := m.replay(rt.tree, storeKey, v); err != nil {
+			// the tree may be partially written, so restore it again next time
+			rt.tree = nil
+			return nil, fmt.Errorf("failed to replay version %d: %w", v, err)
+		}
+
+		rt.version = v
+	}
+
+	return rt.tree.GetProof(version, key)
+}
+
+// restoredTree returns the tree of the given store key restored from the given
+// checkpoint, evicting any tree of the store key restored from another one.
+func (m *Manager) restoredTree(storeKey string, checkpoint uint64) *restoredTree {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	rt, ok := m.restored[storeKey]
+	if !ok || rt.checkpoint != checkpoint {
+		rt = &restoredTree{checkpoint: checkpoint}
+		m.restored[storeKey] = rt
+	}
+
+	return rt
+}
+
+// Prune prunes the checkpoints, and the changelog up to them, that are no longer
+// needed to serve proofs for the versions retained by the pruning options after
+// the given version is committed. The closest checkpoint prior to the oldest
+// retained version is retained, such that the version can still be restored.
+func (m *Manager) Prune(version uint64) error {
+	pruneVersion, ok := m.opts.ShouldPrune(version)
+	if !ok {
+		return nil
+	}
+
+	m.mtx.Lock()
+	var (
+		base  uint64
+		found bool
+	)
+	for checkpoint := range m.checkpoints {
+		if checkpoint <= pruneVersion+1 && checkpoint > base {
+			base, found = checkpoint, true
+		}
+	}
+	if !found {
+		m.mtx.Unlock()
+		return nil
+	}
+
+	pruned := make(map[uint64][]string)
+	for checkpoint, storeKeys := range m.checkpoints {
+		if checkpoint < base {
+			pruned[checkpoint] = storeKeys
+			delete(m.checkpoints, checkpoint)
+		}
+	}
+	for storeKey, rt := range m.restored {
+		if rt.checkpoint < base {
+			delete(m.restored, storeKey)
+		}
+	}
+	m.mtx.Unlock()
+
+	m.logger.Debug("pruning checkpoints", "version", base)
+
+	batch := m.db.NewBatch()
+	defer batch.Close()
+
+	for checkpoint := range pruned {
+		if err := batch.Delete(checkpointKey(checkpoint)); err != nil {
+			return err
+		}
+
+		start := binary.BigEndian.AppendUint64([]byte(treePrefix), checkpoint)
+		end := binary.BigEndian.AppendUint64([]byte(treePrefix), checkpoint+1)
+		if err := m.deleteRange(batch, start, end); err != nil {
+			return err
+		}
+	}
+
+	// the changelog is only replayed on top of retained checkpoints
+	end := binary.BigEndian.AppendUint64([]byte(changelogPrefix), base+1)
+	if err := m.deleteRange(batch, []byte(changelogPrefix), end); err != nil {
+		return err
+	}
+
+	return batch.WriteSync()
+}
+
+// deleteRange adds the deletion of every key within [start, end) to the batch.
+func (m *Manager) deleteRange(batch dbm.Batch, start, end []byte) error {
+	itr, err := m.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		if err := batch.Delete(slices.Clone(itr.Key())); err != nil {
+			return err
+		}
+	}
+
+	return itr.Error()
+}
+
+func (m *Manager) replay(tree store.Tree, storeKey string, version uint64) error {
+	bz, err := m.db.Get(changelogKey(version, storeKey))
+	if err != nil {
+		return err
+	}
+
+	cs, err := decodeChangeset(storeKey, bz)
+	if err != nil {
+		return err
+	}
+
+	if err := tree.WriteBatch(cs); err != nil {
+		return err
+	}
+
+	_, err = tree.Commit()
+	return err
+}
+
+func (m *Manager) checkpointTree(version uint64, storeKey string) store.Tree {
+	prefix := append([]byte(treePrefix), versionStoreKey(version, storeKey)...)
+	return iavl.NewIavlTree(dbm.NewPrefixDB(m.db, prefix), log.NewNopLogger(), iavl.DefaultConfig())
+}
+
+// Wait blocks until all in-flight checkpoints have completed.
+func (m *Manager) Wait() {
+	m.wg.Wait()
+}
+
+// Close waits for all in-flight checkpoints and closes the checkpoint database.
+func (m *Manager) Close() error {
+	m.Wait()
+	return m.db.Close()
+}
+
+// copyTree copies the given version of the src tree into the empty dst tree.
+func copyTree(src, dst store.Tree, version uint64) (err error) {
+	exporter, err := src.Export(version)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, exporter.Close()) }()
+
+	importer, err := dst.Import(version)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, importer.Close()) }()
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, store.ErrExportDone) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if err := importer.Add(item); err != nil {
+			return err
+		}
+	}
+
+	return importer.Commit()
+}
+
+func checkpointKey(version uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte(checkpointPrefix), version)
+}
+
+func changelogKey(version uint64, storeKey string) []byte {
+	return append([]byte(changelogPrefix), versionStoreKey(version, storeKey)...)
+}
+
+// versionStoreKey encodes the version followed by the length-prefixed store key,
+// such that no encoding is a prefix of another.
+func versionStoreKey(version uint64, storeKey string) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, version)
+	bz = binary.AppendUvarint(bz, uint64(len(storeKey)))
+
+	return append(bz, storeKey...)
+}
+
+func encodeStoreKeys(storeKeys []string) []byte {
+	var bz []byte
+	for _, storeKey := range storeKeys {
+		bz = binary.AppendUvarint(bz, uint64(len(storeKey)))
+		bz = append(bz, storeKey...)
+	}
+
+	return bz
+}
+
+func decodeStoreKeys(bz []byte) ([]string, error) {
+	var storeKeys []string
+	for len(bz) > 0 {
+		n, read := binary.Uvarint(bz)
+		if read <= 0 || uint64(len(bz)-read) < n {
+			return nil, fmt.Errorf("invalid length prefix")
+		}
+
+		storeKeys = append(storeKeys, string(bz[read:read+int(n)]))
+		bz = bz[read+int(n):]
+	}
+
+	return storeKeys, nil
+}
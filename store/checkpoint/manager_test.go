@@ -0,0 +1,189 @@
+package checkpoint
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+func TestChangesetEncoding(t *testing.T) {
+	pairs := []store.KVPair{
+		{StoreKey: "store1", Key: []byte("foo"), Value: []byte("bar")},
+		{StoreKey: "store1", Key: []byte("empty"), Value: []byte{}},
+		{StoreKey: "store1", Key: []byte("deleted")},
+	}
+
+	cs, err := decodeChangeset("store1", encodeChangeset(pairs))
+	require.NoError(t, err)
+	require.Equal(t, pairs, cs.Pairs)
+
+	_, err = decodeChangeset("store1", []byte{0x05, 'a'})
+	require.Error(t, err)
+}
+
+// commitVersion commits a version writing the same key of every store key, and
+// checkpoints it if applicable.
+func commitVersion(t *testing.T, m *Manager, sc *commitment.Database, ss store.VersionedDatabase, storeKeys []string, v uint64) {
+	t.Helper()
+
+	cs := store.NewChangeset()
+	for _, storeKey := range storeKeys {
+		cs.AddKVPair(store.KVPair{StoreKey: storeKey, Key: []byte("foo"), Value: []byte(fmt.Sprintf("val%03d", v))})
+	}
+
+	require.NoError(t, sc.WriteBatch(cs))
+	_, err := sc.Commit()
+	require.NoError(t, err)
+	require.NoError(t, ss.ApplyChangeset(v, cs))
+	require.NoError(t, m.Record(v, cs))
+
+	if m.ShouldCheckpoint(v) {
+		released := make(chan struct{})
+		m.Checkpoint(v, sc, func() { close(released) })
+		<-released
+	}
+}
+
+func TestManager(t *testing.T) {
+	_, err := NewManager(log.NewNopLogger(), dbm.NewMemDB(), nil, 0, pruning.NothingOptions())
+	require.Error(t, err)
+
+	ss, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	defer ss.Close()
+
+	storeKeys := []string{"store1", "store2"}
+	trees := make(map[string]store.Tree)
+	for _, storeKey := range storeKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())
+	}
+	sc := commitment.NewDatabase(trees)
+
+	db := dbm.NewMemDB()
+	m, err := NewManager(log.NewNopLogger(), db, ss, 5, pruning.NothingOptions())
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 12; v++ {
+		commitVersion(t, m, sc, ss, storeKeys, v)
+	}
+
+	require.Equal(t, []uint64{5, 10}, m.Checkpoints())
+
+	// the checkpoint index is reloaded from the database
+	m2, err := NewManager(log.NewNopLogger(), db, ss, 5, pruning.NothingOptions())
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 10}, m2.Checkpoints())
+
+	_, err = m2.GetProof("store1", 4, []byte("foo"))
+	require.Error(t, err)
+
+	_, err = m2.GetProof("unknown", 7, []byte("foo"))
+	require.Error(t, err)
+
+	for v := uint64(5); v <= 12; v++ {
+		for _, storeKey := range storeKeys {
+			expected, err := sc.GetProof(storeKey, v, []byte("foo"))
+			require.NoError(t, err)
+
+			proof, err := m2.GetProof(storeKey, v, []byte("foo"))
+			require.NoError(t, err)
+			require.Equal(t, expected, proof)
+		}
+	}
+
+	// the tree restored from the last checkpoint is reused for prior versions
+	rt := m2.restored["store1"]
+	require.Equal(t, uint64(10), rt.checkpoint)
+	require.Equal(t, uint64(12), rt.version)
+
+	proof, err := m2.GetProof("store1", 11, []byte("foo"))
+	require.NoError(t, err)
+	expected, err := sc.GetProof("store1", 11, []byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, expected, proof)
+	require.Same(t, rt, m2.restored["store1"])
+	require.Equal(t, uint64(12), rt.version)
+
+	// restoring another checkpoint evicts the restored tree
+	_, err = m2.GetProof("store1", 7, []byte("foo"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), m2.restored["store1"].checkpoint)
+	require.Equal(t, uint64(7), m2.restored["store1"].version)
+
+	require.NoError(t, m.Close())
+}
+
+func TestManager_Prune(t *testing.T) {
+	_, err := NewManager(log.NewNopLogger(), dbm.NewMemDB(), nil, 5, pruning.NewCustomOptions(0, 10))
+	require.Error(t, err)
+
+	ss, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+	defer ss.Close()
+
+	storeKeys := []string{"store1"}
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		"store1": iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig()),
+	})
+
+	db := dbm.NewMemDB()
+	m, err := NewManager(log.NewNopLogger(), db, ss, 5, pruning.NewCustomOptions(2, 10))
+	require.NoError(t, err)
+	defer m.Close()
+
+	for v := uint64(1); v <= 30; v++ {
+		commitVersion(t, m, sc, ss, storeKeys, v)
+
+		// serve a proof from the oldest checkpoint prior to pruning it
+		if v == 20 {
+			_, err := m.GetProof("store1", 8, []byte("foo"))
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, m.Prune(v))
+	}
+
+	// versions up to and including 27 are pruned, so the checkpoint at version 25
+	// is retained to serve version 28
+	require.Equal(t, []uint64{25, 30}, m.Checkpoints())
+	require.Empty(t, m.restored)
+
+	m2, err := NewManager(log.NewNopLogger(), db, ss, 5, pruning.NothingOptions())
+	require.NoError(t, err)
+	require.Equal(t, []uint64{25, 30}, m2.Checkpoints())
+
+	// neither the trees of the pruned checkpoints nor the changelog up to the
+	// retained ones are left in the database
+	itr, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	for ; itr.Valid(); itr.Next() {
+		key := itr.Key()
+		switch string(key[:2]) {
+		case treePrefix, changelogPrefix:
+			require.GreaterOrEqual(t, binary.BigEndian.Uint64(key[2:]), uint64(25), "key %X", key)
+		}
+	}
+	require.NoError(t, itr.Close())
+
+	for v := uint64(25); v <= 30; v++ {
+		expected, err := sc.GetProof("store1", v, []byte("foo"))
+		require.NoError(t, err)
+
+		proof, err := m.GetProof("store1", v, []byte("foo"))
+		require.NoError(t, err, "version %d", v)
+		require.Equal(t, expected, proof)
+	}
+
+	_, err = m.GetProof("store1", 24, []byte("foo"))
+	require.Error(t, err)
+}
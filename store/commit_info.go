@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"time"
 
+	ics23 "github.com/cosmos/ics23/go"
+
 	"cosmossdk.io/store/v2/internal/maps"
+	"cosmossdk.io/store/v2/internal/proofs"
 )
 
 type (
@@ -54,6 +57,31 @@ func (ci CommitInfo) Hash() []byte {
 	return rootHash
 }
 
+// GetStoreProof returns an ICS23 existence proof of the given store's commit
+// hash against the CommitInfo root hash. Together with a proof from the store's
+// SC tree, it proves a key against the app hash, in the same manner as the
+// legacy multi-store's simple merkle proof op.
+func (ci CommitInfo) GetStoreProof(storeKey string) (*ics23.CommitmentProof, error) {
+	m := ci.toMap()
+
+	_, storeProofs, _ := maps.ProofsFromMap(m)
+	proof, ok := storeProofs[storeKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStoreKey, storeKey)
+	}
+
+	existProof, err := proofs.ConvertExistenceProof(proof, []byte(storeKey), m[storeKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert store proof to existence proof: %w", err)
+	}
+
+	return &ics23.CommitmentProof{
+		Proof: &ics23.CommitmentProof_Exist{
+			Exist: existProof,
+		},
+	}, nil
+}
+
 func (ci CommitInfo) toMap() map[string][]byte {
 	m := make(map[string][]byte, len(ci.StoreInfos))
 	for _, storeInfo := range ci.StoreInfos {
@@ -1,80 +1,455 @@
 package commitment
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
 	"sync"
 
+	protoio "github.com/cosmos/gogoproto/io"
 	ics23 "github.com/cosmos/ics23/go"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/store/v2"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
 // Database represents a state commitment store. It is designed to securely store
 // and manage the most recent state information, crucial for achieving consensus.
-// Each module creates its own instance of Database for managing its specific state.
+// It wraps one or more Tree instances, each mapped by a unique store key, where
+// each store key reflects dedicated and unique usage within a module.
 type Database struct {
-	mu   sync.Mutex
-	tree store.Tree
+	mu         sync.Mutex
+	multiTrees map[string]store.Tree
 }
 
-// NewDatabase creates a new Database instance.
-func NewDatabase(tree store.Tree) *Database {
+// NewDatabase creates a new Database instance from a set of trees keyed by
+// store key. The map may be empty, in which case trees can be mounted later via
+// MountTree.
+func NewDatabase(multiTrees map[string]store.Tree) *Database {
+	trees := make(map[string]store.Tree, len(multiTrees))
+	for storeKey, tree := range multiTrees {
+		trees[storeKey] = tree
+	}
+
 	return &Database{
-		tree: tree,
+		multiTrees: trees,
 	}
 }
 
-// WriteBatch writes a batch of key-value pairs to the database.
+// MountTree mounts a tree under the given store key. An error is returned if a
+// tree is already mounted for the store key.
+func (db *Database) MountTree(storeKey string, tree store.Tree) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if tree == nil {
+		return fmt.Errorf("cannot mount nil tree for store key %s", storeKey)
+	}
+	if _, ok := db.multiTrees[storeKey]; ok {
+		return fmt.Errorf("tree already mounted for store key %s", storeKey)
+	}
+
+	db.multiTrees[storeKey] = tree
+	return nil
+}
+
+// GetTree returns the tree mounted for the given store key, or nil if no tree
+// is mounted.
+func (db *Database) GetTree(storeKey string) store.Tree {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.multiTrees[storeKey]
+}
+
+// StoreKeys returns the store keys of all mounted trees, sorted lexicographically.
+func (db *Database) StoreKeys() []string {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return db.storeKeys()
+}
+
+func (db *Database) storeKeys() []string {
+	storeKeys := maps.Keys(db.multiTrees)
+	slices.Sort(storeKeys)
+
+	return storeKeys
+}
+
+// WriteBatch writes a batch of key-value pairs to the database. Each pair is
+// routed to the tree mounted under the pair's store key, preserving the relative
+// order of pairs within the same store key, where trees are written to
+// concurrently. An error is returned if a pair references a store key that has
+// no mounted tree.
 func (db *Database) WriteBatch(cs *store.Changeset) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.WriteBatch(cs)
+	batches := make(map[string]*store.Changeset)
+	for _, kv := range cs.Pairs {
+		if _, ok := db.multiTrees[kv.StoreKey]; !ok {
+			return fmt.Errorf("%w: %s", store.ErrUnknownStoreKey, kv.StoreKey)
+		}
+
+		batch, ok := batches[kv.StoreKey]
+		if !ok {
+			batch = store.NewChangeset()
+			batches[kv.StoreKey] = batch
+		}
+
+		batch.AddKVPair(kv)
+	}
+
+	storeKeys := maps.Keys(batches)
+	slices.Sort(storeKeys)
+
+	return db.forEachTree(storeKeys, func(_ int, storeKey string, tree store.Tree) error {
+		if err := tree.WriteBatch(batches[storeKey]); err != nil {
+			return fmt.Errorf("failed to write batch to tree %s: %w", storeKey, err)
+		}
+
+		return nil
+	})
 }
 
-// WorkingHash returns the working hash of the database.
-func (db *Database) WorkingHash() []byte {
+// WorkingStoreInfos returns the StoreInfo of every mounted tree, based on each
+// tree's working hash, sorted by store key. The working hash of each tree is
+// computed concurrently.
+func (db *Database) WorkingStoreInfos(version uint64) []store.StoreInfo {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.WorkingHash()
+	storeKeys := db.storeKeys()
+	storeInfos := make([]store.StoreInfo, len(storeKeys))
+
+	// computing the working hash never fails
+	_ = db.forEachTree(storeKeys, func(i int, storeKey string, tree store.Tree) error {
+		storeInfos[i] = store.StoreInfo{
+			Name: storeKey,
+			CommitID: store.CommitID{
+				Version: version,
+				Hash:    tree.WorkingHash(),
+			},
+		}
+
+		return nil
+	})
+
+	return storeInfos
 }
 
-// LoadVersion loads the state at the given version.
+// LoadVersion loads the state at the given version for all mounted trees. If the
+// version is 0, every tree loads its own latest version.
 func (db *Database) LoadVersion(version uint64) error {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.LoadVersion(version)
+	for _, storeKey := range db.storeKeys() {
+		if err := db.multiTrees[storeKey].LoadVersion(version); err != nil {
+			return fmt.Errorf("failed to load version %d for tree %s: %w", version, storeKey, err)
+		}
+	}
+
+	return nil
 }
 
-// Commit commits the current state to the database.
-func (db *Database) Commit() ([]byte, error) {
+// Commit commits the current state of all mounted trees to the database and
+// returns the resulting StoreInfo of every tree, sorted by store key. Trees are
+// committed concurrently.
+func (db *Database) Commit() ([]store.StoreInfo, error) {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.Commit()
+	storeKeys := db.storeKeys()
+	storeInfos := make([]store.StoreInfo, len(storeKeys))
+
+	err := db.forEachTree(storeKeys, func(i int, storeKey string, tree store.Tree) error {
+		hash, err := tree.Commit()
+		if err != nil {
+			return fmt.Errorf("failed to commit tree %s: %w", storeKey, err)
+		}
+
+		storeInfos[i] = store.StoreInfo{
+			Name: storeKey,
+			CommitID: store.CommitID{
+				Version: tree.GetLatestVersion(),
+				Hash:    hash,
+			},
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storeInfos, nil
 }
 
-// GetProof returns a proof for the given key and version.
-func (db *Database) GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error) {
+// forEachTree concurrently calls fn for the tree of every given store key, along
+// with the store key's index. Since each call is provided its index, callers can
+// collect results deterministically regardless of scheduling. All errors are
+// joined in store key order. The caller must hold the database lock.
+func (db *Database) forEachTree(storeKeys []string, fn func(i int, storeKey string, tree store.Tree) error) error {
+	errs := make([]error, len(storeKeys))
+
+	var wg sync.WaitGroup
+	for i, storeKey := range storeKeys {
+		wg.Add(1)
+		go func(i int, storeKey string, tree store.Tree) {
+			defer wg.Done()
+			errs[i] = fn(i, storeKey, tree)
+		}(i, storeKey, db.multiTrees[storeKey])
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// GetProof returns a proof for the given key and version from the tree mounted
+// under the given store key.
+func (db *Database) GetProof(storeKey string, version uint64, key []byte) (*ics23.CommitmentProof, error) {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.GetProof(version, key)
+	tree, ok := db.multiTrees[storeKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", store.ErrUnknownStoreKey, storeKey)
+	}
+
+	return tree.GetProof(version, key)
 }
 
-// GetLatestVersion returns the latest version of the database.
+// GetLatestVersion returns the latest version of the database, i.e. the highest
+// latest version across all mounted trees.
 func (db *Database) GetLatestVersion() uint64 {
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.GetLatestVersion()
+	var latestVersion uint64
+	for _, tree := range db.multiTrees {
+		if v := tree.GetLatestVersion(); v > latestVersion {
+			latestVersion = v
+		}
+	}
+
+	return latestVersion
 }
 
-// Close closes the database and releases all resources.
-func (db *Database) Close() error {
+// Prune prunes all versions up to and including the provided version for all
+// mounted trees. Note, the database lock is only held while collecting the
+// trees, so pruning does not block writes and commits to the trees.
+func (db *Database) Prune(version uint64) (err error) {
+	db.mu.Lock()
+	storeKeys := db.storeKeys()
+	trees := make([]store.Tree, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		trees[i] = db.multiTrees[storeKey]
+	}
+	db.mu.Unlock()
+
+	for i, tree := range trees {
+		if pErr := tree.Prune(version); pErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to prune tree %s: %w", storeKeys[i], pErr))
+		}
+	}
+
+	return err
+}
+
+// Snapshot writes a snapshot of all mounted trees at the given version into the
+// protobuf writer. Trees are serialized, sorted by store key, as a stream of
+// SnapshotItem messages. The first item of each tree contains a SnapshotStoreItem
+// with the store key, followed by a SnapshotIAVLItem for each exported node.
+//
+// Note, similar to Prune, the database lock is only held while collecting the
+// trees, so a snapshot does not block writes and commits to the trees.
+func (db *Database) Snapshot(version uint64, protoWriter protoio.Writer) error {
+	if version == 0 {
+		return fmt.Errorf("the snapshot version must be greater than 0")
+	}
+
+	db.mu.Lock()
+	storeKeys := db.storeKeys()
+	trees := make([]store.Tree, len(storeKeys))
+	for i, storeKey := range storeKeys {
+		trees[i] = db.multiTrees[storeKey]
+	}
+	db.mu.Unlock()
+
+	for i, tree := range trees {
+		if err := snapshotTree(storeKeys[i], tree, version, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func snapshotTree(storeKey string, tree store.Tree, version uint64, protoWriter protoio.Writer) error {
+	exporter, err := tree.Export(version)
+	if err != nil {
+		return fmt.Errorf("failed to export tree %s: %w", storeKey, err)
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: storeKey,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write store item for tree %s: %w", storeKey, err)
+	}
+
+	for {
+		item, err := exporter.Next()
+		if errors.Is(err, store.ErrExportDone) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to export node of tree %s: %w", storeKey, err)
+		}
+
+		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: item,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write node item for tree %s: %w", storeKey, err)
+		}
+	}
+}
+
+// Restore restores the mounted trees at the given version from the protobuf
+// reader, as written by Snapshot. Every restored leaf node is additionally sent
+// as a KVPair to chStorage, such that the caller may restore the State Storage
+// (SS) backend concurrently. chStorage is closed once the restoration completes.
+//
+// Restore returns the first snapshot item which does not belong to the trees,
+// e.g. an extension item, or an empty item once the reader is exhausted.
+func (db *Database) Restore(
+	version uint64,
+	format uint32,
+	protoReader protoio.Reader,
+	chStorage chan<- *store.KVPair,
+) (snapshottypes.SnapshotItem, error) {
+	defer close(chStorage)
+
+	if format != snapshottypes.CurrentFormat {
+		return snapshottypes.SnapshotItem{}, fmt.Errorf("%w: %d", snapshottypes.ErrUnknownFormat, format)
+	}
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
-	return db.tree.Close()
+	var (
+		storeKey     string
+		importer     store.Importer
+		snapshotItem snapshottypes.SnapshotItem
+	)
+
+	// ensure an importer which failed prior to committing is closed
+	defer func() {
+		if importer != nil {
+			_ = importer.Close()
+		}
+	}()
+
+	commitImporter := func() error {
+		if importer == nil {
+			return nil
+		}
+
+		err := importer.Commit()
+		_ = importer.Close()
+		importer = nil
+
+		if err != nil {
+			return fmt.Errorf("failed to commit import of tree %s: %w", storeKey, err)
+		}
+
+		return nil
+	}
+
+loop:
+	for {
+		snapshotItem = snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, fmt.Errorf("invalid protobuf message: %w", err)
+		}
+
+		switch item := snapshotItem.Item.(type) {
+		case *snapshottypes.SnapshotItem_Store:
+			if err := commitImporter(); err != nil {
+				return snapshottypes.SnapshotItem{}, err
+			}
+
+			storeKey = item.Store.Name
+			tree, ok := db.multiTrees[storeKey]
+			if !ok {
+				return snapshottypes.SnapshotItem{}, fmt.Errorf("%w: %s", store.ErrUnknownStoreKey, storeKey)
+			}
+
+			importer, err = tree.Import(version)
+			if err != nil {
+				return snapshottypes.SnapshotItem{}, fmt.Errorf("failed to import tree %s: %w", storeKey, err)
+			}
+
+		case *snapshottypes.SnapshotItem_IAVL:
+			if importer == nil {
+				return snapshottypes.SnapshotItem{}, fmt.Errorf("received IAVL node item before store item")
+			}
+
+			if err := importer.Add(item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, fmt.Errorf("failed to import node of tree %s: %w", storeKey, err)
+			}
+
+			// only leaf nodes reflect key-value pairs
+			if item.IAVL.Height == 0 {
+				value := item.IAVL.Value
+				if value == nil {
+					value = []byte{}
+				}
+
+				chStorage <- &store.KVPair{
+					StoreKey: storeKey,
+					Key:      item.IAVL.Key,
+					Value:    value,
+				}
+			}
+
+		default:
+			break loop
+		}
+	}
+
+	if err := commitImporter(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return snapshotItem, nil
+}
+
+// Close closes all mounted trees and releases all resources.
+func (db *Database) Close() (err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	for _, storeKey := range db.storeKeys() {
+		err = errors.Join(err, db.multiTrees[storeKey].Close())
+	}
+
+	clear(db.multiTrees)
+
+	return err
 }
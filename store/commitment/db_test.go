@@ -1,6 +1,7 @@
 package commitment
 
 import (
+	"fmt"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
@@ -82,3 +83,83 @@ func TestIavlTree(t *testing.T) {
 	// close the db
 	require.NoError(t, tree.Close())
 }
+
+func TestDatabase_MultiTrees(t *testing.T) {
+	db := NewDatabase(map[string]store.Tree{
+		"store1": generateTree("iavl"),
+		"store2": generateTree("iavl"),
+	})
+	require.Equal(t, []string{"store1", "store2"}, db.StoreKeys())
+
+	// mount a third tree and ensure duplicates are rejected
+	require.NoError(t, db.MountTree("store3", generateTree("iavl")))
+	require.Error(t, db.MountTree("store3", generateTree("iavl")))
+	require.Equal(t, []string{"store1", "store2", "store3"}, db.StoreKeys())
+
+	// write the same key to every store, except store3
+	cs := store.NewChangeset(
+		store.KVPair{StoreKey: "store2", Key: []byte("key"), Value: []byte("value2")},
+		store.KVPair{StoreKey: "store1", Key: []byte("key"), Value: []byte("value1")},
+	)
+	require.NoError(t, db.WriteBatch(cs))
+
+	// writing to an unknown store key should fail
+	err := db.WriteBatch(store.NewChangeset(store.KVPair{StoreKey: "unknown", Key: []byte("key"), Value: []byte("value")}))
+	require.ErrorIs(t, err, store.ErrUnknownStoreKey)
+
+	workingInfos := db.WorkingStoreInfos(1)
+	require.Len(t, workingInfos, 3)
+	require.NotEqual(t, workingInfos[0].GetHash(), workingInfos[1].GetHash())
+
+	storeInfos, err := db.Commit()
+	require.NoError(t, err)
+	require.Equal(t, workingInfos, storeInfos)
+	require.Equal(t, uint64(1), db.GetLatestVersion())
+
+	proof, err := db.GetProof("store1", 1, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value1"), proof.GetExist().Value)
+
+	proof, err = db.GetProof("store2", 1, []byte("other"))
+	require.NoError(t, err)
+	require.NotNil(t, proof.GetNonexist())
+
+	_, err = db.GetProof("unknown", 1, []byte("key"))
+	require.ErrorIs(t, err, store.ErrUnknownStoreKey)
+
+	require.NoError(t, db.Close())
+}
+
+func TestDatabase_ConcurrentHashing(t *testing.T) {
+	trees := make(map[string]store.Tree)
+	for i := 0; i < 25; i++ {
+		trees[fmt.Sprintf("store%03d", i)] = generateTree("iavl")
+	}
+	db := NewDatabase(trees)
+
+	cs := store.NewChangeset()
+	for i := 0; i < 25; i++ {
+		for j := 0; j < 10; j++ {
+			cs.AddKVPair(store.KVPair{
+				StoreKey: fmt.Sprintf("store%03d", i),
+				Key:      []byte(fmt.Sprintf("key%03d", j)),
+				Value:    []byte(fmt.Sprintf("value%03d-%03d", i, j)),
+			})
+		}
+	}
+	require.NoError(t, db.WriteBatch(cs))
+
+	// store infos must be sorted by store key and reflect each tree's hash
+	workingInfos := db.WorkingStoreInfos(1)
+	require.Len(t, workingInfos, 25)
+	for i, si := range workingInfos {
+		storeKey := fmt.Sprintf("store%03d", i)
+		require.Equal(t, storeKey, si.Name)
+		require.Equal(t, trees[storeKey].WorkingHash(), si.GetHash())
+	}
+
+	storeInfos, err := db.Commit()
+	require.NoError(t, err)
+	require.Equal(t, workingInfos, storeInfos)
+	require.Equal(t, store.CommitInfo{StoreInfos: workingInfos}.Hash(), store.CommitInfo{StoreInfos: storeInfos}.Hash())
+}
@@ -0,0 +1,43 @@
+package iavl
+
+import (
+	"errors"
+
+	"github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/v2"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ store.Exporter = (*Exporter)(nil)
+
+// Exporter is a wrapper around iavl.Exporter.
+type Exporter struct {
+	exporter *iavl.Exporter
+}
+
+// Next returns the next exported node, or store.ErrExportDone once all nodes
+// have been exported.
+func (e *Exporter) Next() (*snapshottypes.SnapshotIAVLItem, error) {
+	node, err := e.exporter.Next()
+	if err != nil {
+		if errors.Is(err, iavl.ErrorExportDone) {
+			return nil, store.ErrExportDone
+		}
+
+		return nil, err
+	}
+
+	return &snapshottypes.SnapshotIAVLItem{
+		Key:     node.Key,
+		Value:   node.Value,
+		Version: node.Version,
+		Height:  int32(node.Height),
+	}, nil
+}
+
+// Close closes the exporter.
+func (e *Exporter) Close() error {
+	e.exporter.Close()
+	return nil
+}
@@ -0,0 +1,55 @@
+package iavl
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cosmos/iavl"
+
+	"cosmossdk.io/store/v2"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ store.Importer = (*Importer)(nil)
+
+// Importer is a wrapper around iavl.Importer.
+type Importer struct {
+	importer *iavl.Importer
+}
+
+// Add adds the given node to the tree being imported.
+func (i *Importer) Add(item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return fmt.Errorf("node height %d cannot exceed %d", item.Height, math.MaxInt8)
+	}
+
+	node := &iavl.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Version: item.Version,
+		Height:  int8(item.Height),
+	}
+
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately
+	// IAVL does not allow nil keys nor nil values for leaf nodes, so we can always
+	// set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+
+	return i.importer.Add(node)
+}
+
+// Commit commits the imported nodes to the tree.
+func (i *Importer) Commit() error {
+	return i.importer.Commit()
+}
+
+// Close closes the importer.
+func (i *Importer) Close() error {
+	i.importer.Close()
+	return nil
+}
@@ -52,8 +52,15 @@ func (t *IavlTree) WorkingHash() []byte {
 	return t.tree.WorkingHash()
 }
 
-// LoadVersion loads the state at the given version.
+// LoadVersion loads the state at the given version, discarding any version
+// after it. If the version is 0, the latest version is loaded and no version is
+// discarded.
 func (t *IavlTree) LoadVersion(version uint64) error {
+	if version == 0 {
+		_, err := t.tree.Load()
+		return err
+	}
+
 	return t.tree.LoadVersionForOverwriting(int64(version))
 }
 
@@ -78,6 +85,36 @@ func (t *IavlTree) GetLatestVersion() uint64 {
 	return uint64(t.tree.Version())
 }
 
+// Prune prunes all versions up to and including the provided version.
+func (t *IavlTree) Prune(version uint64) error {
+	return t.tree.DeleteVersionsTo(int64(version))
+}
+
+// Export exports the tree at the given version.
+func (t *IavlTree) Export(version uint64) (store.Exporter, error) {
+	tree, err := t.tree.GetImmutable(int64(version))
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := tree.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Exporter{exporter: exporter}, nil
+}
+
+// Import imports the tree at the given version. The tree must be empty.
+func (t *IavlTree) Import(version uint64) (store.Importer, error) {
+	importer, err := t.tree.Import(int64(version))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Importer{importer: importer}, nil
+}
+
 // Close closes the iavl tree.
 func (t *IavlTree) Close() error {
 	return nil
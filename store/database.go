@@ -51,6 +51,11 @@ type VersionedDatabase interface {
 	Iterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
 	ReverseIterator(storeKey string, version uint64, start, end []byte) (Iterator, error)
 
+	// History returns every change, i.e. a write or a deletion, to the given key
+	// at versions in the inclusive range [fromVersion, toVersion], ordered by
+	// version in ascending order. Changes at pruned versions are not returned.
+	History(storeKey string, key []byte, fromVersion, toVersion uint64) ([]KeyChange, error)
+
 	ApplyChangeset(version uint64, cs *Changeset) error
 
 	// Prune attempts to prune all versions up to and including the provided
@@ -67,3 +72,10 @@ type VersionedDatabase interface {
 type Committer interface {
 	Commit() error
 }
+
+// Pruner defines a contract for pruning versioned state.
+type Pruner interface {
+	// Prune attempts to prune all versions up to and including the provided
+	// version argument.
+	Prune(version uint64) error
+}
@@ -4,8 +4,10 @@ import (
 	"cosmossdk.io/errors"
 )
 
-// StoreCodespace defines the store package's unique error code space.
-const StoreCodespace = "store"
+// StoreCodespace defines the store package's unique error code space. It
+// differs from the one of store/v1 so that both may be linked in the same app,
+// e.g. to migrate from one to the other.
+const StoreCodespace = "store/v2"
 
 var (
 	// ErrInvalidProof is returned when a proof is invalid
@@ -35,4 +37,7 @@ var (
 	ErrInvalidVersion  = errors.Register(StoreCodespace, 11, "invalid version")
 	ErrKeyEmpty        = errors.Register(StoreCodespace, 12, "key empty")
 	ErrStartAfterEnd   = errors.Register(StoreCodespace, 13, "start key after end key")
+
+	// ErrExportDone is returned by an Exporter once all nodes have been exported.
+	ErrExportDone = errors.Register(StoreCodespace, 14, "export is complete")
 )
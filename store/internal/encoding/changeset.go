@@ -0,0 +1,135 @@
+// Package encoding implements the binary encoding of changesets shared by the
+// write-ahead log, the append log of the in-memory SS backend and the changelog
+// of the checkpoint manager.
+package encoding
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"cosmossdk.io/store/v2"
+)
+
+// AppendChangeset appends the encoding of the changeset to bz, i.e. the uvarint
+// number of key-value pairs followed by every pair, encoded as its uvarint
+// length-prefixed store key and key, a tombstone flag and, unless deleted, its
+// uvarint length-prefixed value.
+func AppendChangeset(bz []byte, cs *store.Changeset) []byte {
+	bz = binary.AppendUvarint(bz, uint64(len(cs.Pairs)))
+	for _, kvPair := range cs.Pairs {
+		bz = AppendBytes(bz, []byte(kvPair.StoreKey))
+		bz = AppendBytes(bz, kvPair.Key)
+
+		if kvPair.Value == nil {
+			bz = append(bz, 1)
+			continue
+		}
+
+		bz = append(bz, 0)
+		bz = AppendBytes(bz, kvPair.Value)
+	}
+
+	return bz
+}
+
+// AppendBytes appends the uvarint length-prefixed byte slice to bz.
+func AppendBytes(bz, b []byte) []byte {
+	bz = binary.AppendUvarint(bz, uint64(len(b)))
+	return append(bz, b...)
+}
+
+// Decoder decodes a byte slice, retaining the first error encountered such that
+// callers only need to check Err once done.
+type Decoder struct {
+	bz  []byte
+	err error
+}
+
+// NewDecoder returns a Decoder of the given byte slice.
+func NewDecoder(bz []byte) *Decoder {
+	return &Decoder{bz: bz}
+}
+
+// Err returns the first error encountered, or an error if any bytes were left
+// undecoded.
+func (d *Decoder) Err() error {
+	if d.err == nil && len(d.bz) > 0 {
+		return fmt.Errorf("%d trailing bytes", len(d.bz))
+	}
+
+	return d.err
+}
+
+// Byte decodes a single byte.
+func (d *Decoder) Byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.bz) == 0 {
+		d.err = io.ErrUnexpectedEOF
+		return 0
+	}
+
+	b := d.bz[0]
+	d.bz = d.bz[1:]
+
+	return b
+}
+
+// Uvarint decodes a uvarint.
+func (d *Decoder) Uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint(d.bz)
+	if n <= 0 {
+		d.err = errors.New("invalid uvarint")
+		return 0
+	}
+
+	d.bz = d.bz[n:]
+	return v
+}
+
+// Bytes decodes a uvarint length-prefixed byte slice into a copy. An empty slice
+// is decoded as non-nil, so an empty value is distinguished from a deletion.
+func (d *Decoder) Bytes() []byte {
+	n := d.Uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.bz)) < n {
+		d.err = io.ErrUnexpectedEOF
+		return nil
+	}
+
+	b := make([]byte, n)
+	copy(b, d.bz)
+	d.bz = d.bz[n:]
+
+	return b
+}
+
+// Changeset decodes a changeset encoded by AppendChangeset.
+func (d *Decoder) Changeset() *store.Changeset {
+	n := d.Uvarint()
+
+	cs := store.NewChangeset()
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		kvPair := store.KVPair{
+			StoreKey: string(d.Bytes()),
+			Key:      d.Bytes(),
+		}
+
+		if d.Byte() == 0 {
+			kvPair.Value = d.Bytes()
+		}
+
+		cs.AddKVPair(kvPair)
+	}
+
+	return cs
+}
@@ -0,0 +1,36 @@
+package encoding
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2"
+)
+
+func TestChangeset(t *testing.T) {
+	cs := store.NewChangeset(
+		store.KVPair{StoreKey: "store1", Key: []byte("foo"), Value: []byte("bar")},
+		store.KVPair{StoreKey: "store1", Key: []byte("empty"), Value: []byte{}},
+		store.KVPair{StoreKey: "store2", Key: []byte("deleted")},
+	)
+
+	bz := AppendChangeset([]byte{0xff}, cs)
+	require.Equal(t, byte(0xff), bz[0])
+
+	d := NewDecoder(bz[1:])
+	decoded := d.Changeset()
+	require.NoError(t, d.Err())
+	require.Equal(t, cs, decoded)
+	require.NotNil(t, decoded.Pairs[1].Value)
+	require.Nil(t, decoded.Pairs[2].Value)
+
+	// truncated and trailing bytes are detected
+	d = NewDecoder(bz[1 : len(bz)-1])
+	d.Changeset()
+	require.Error(t, d.Err())
+
+	d = NewDecoder(append(bz[1:], 0))
+	d.Changeset()
+	require.ErrorContains(t, d.Err(), "1 trailing bytes")
+}
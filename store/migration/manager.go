@@ -0,0 +1,328 @@
+package migration
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+// snapshotMaxItemSize defines the maximum size of a single item of the migration
+// stream. The SDK has no key/value size limit, so we set an arbitrary limit.
+const snapshotMaxItemSize = int(64e6)
+
+// Exporter defines the source of a migration, i.e. a store that is capable of
+// writing a state-sync snapshot of its state at a given height, such as the
+// store/v1 multistore.
+type Exporter interface {
+	Snapshot(height uint64, protoWriter protoio.Writer) error
+}
+
+// VersionedChangeset defines a changeset committed to the source store at a
+// given version, along with the resulting app hash, if known.
+type VersionedChangeset struct {
+	Version   uint64
+	Changeset *store.Changeset
+	AppHash   []byte
+}
+
+// Manager manages the live migration of the state of a store/v1 multistore into
+// a store/v2 RootStore, i.e. its SS and SC backends.
+//
+// The migration is started at a given height via Start, upon which the state at
+// that height is streamed from the source store into the target RootStore in the
+// background while the chain keeps running on the source store. Every changeset
+// committed to the source store after the migration height must be recorded via
+// Record, after which it is replayed onto the target RootStore once the state is
+// migrated. The replayed app hash of every version is verified against the app
+// hash of the source store.
+//
+// Finally, Finalize returns the target RootStore once it has caught up with the
+// source store, at which point the application switches to the target RootStore.
+type Manager struct {
+	logger      log.Logger
+	exporter    Exporter
+	target      store.RootStore
+	snapshotter snapshottypes.Snapshotter
+
+	// done is closed once the background migration has exited
+	done chan struct{}
+
+	// mtx guards the fields below
+	mtx sync.Mutex
+
+	// cond is signaled whenever the state of the migration changes
+	cond *sync.Cond
+
+	started   bool
+	finalized bool
+
+	// migrated reflects whether the state at the migration height has been
+	// migrated into the target RootStore
+	migrated bool
+
+	// recordedVersion reflects the latest version recorded via Record
+	recordedVersion uint64
+
+	// syncedVersion reflects the latest version of the target RootStore
+	syncedVersion uint64
+
+	// pending reflects the recorded changesets yet to be replayed
+	pending []*VersionedChangeset
+
+	// err reflects the error, if any, that caused the migration to fail
+	err error
+}
+
+// NewManager returns a new migration Manager which migrates the state of the
+// given exporter into the target RootStore. The target RootStore must support
+// restoring state-sync snapshots and all of its SC trees must be empty.
+func NewManager(logger log.Logger, exporter Exporter, target store.RootStore) (*Manager, error) {
+	snapshotter, ok := target.(snapshottypes.Snapshotter)
+	if !ok {
+		return nil, fmt.Errorf("target store %T does not support restoring snapshots", target)
+	}
+
+	m := &Manager{
+		logger:      logger.With("module", "migration"),
+		exporter:    exporter,
+		target:      target,
+		snapshotter: snapshotter,
+		done:        make(chan struct{}),
+	}
+	m.cond = sync.NewCond(&m.mtx)
+
+	return m, nil
+}
+
+// Start starts migrating the state at the given height in the background. The
+// given height must already be committed to the source store. Start may only be
+// called once.
+func (m *Manager) Start(height uint64) error {
+	if height == 0 {
+		return fmt.Errorf("cannot migrate height 0")
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.started {
+		return fmt.Errorf("migration already started")
+	}
+
+	m.started = true
+	m.recordedVersion = height
+
+	go m.run(height)
+
+	return nil
+}
+
+// Record records the changeset committed to the source store at the given
+// version, along with the resulting app hash, such that it is replayed onto the
+// target RootStore. Versions must be recorded sequentially, starting right after
+// the migration height. A nil app hash skips verification of the version.
+func (m *Manager) Record(version uint64, cs *store.Changeset, appHash []byte) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	switch {
+	case !m.started:
+		return fmt.Errorf("migration not started")
+
+	case m.finalized:
+		return fmt.Errorf("migration already finalized")
+
+	case m.err != nil:
+		return m.err
+
+	case version != m.recordedVersion+1:
+		return fmt.Errorf("unexpected version recorded; got: %d, expected: %d", version, m.recordedVersion+1)
+	}
+
+	m.pending = append(m.pending, &VersionedChangeset{
+		Version:   version,
+		Changeset: cs,
+		AppHash:   appHash,
+	})
+	m.recordedVersion = version
+	m.cond.Broadcast()
+
+	return nil
+}
+
+// SyncedVersion returns the latest version of the target RootStore and whether
+// the state at the migration height has been migrated.
+func (m *Manager) SyncedVersion() (uint64, bool) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.syncedVersion, m.migrated
+}
+
+// Finalize blocks until the target RootStore has caught up with the given
+// version, which must be the latest recorded version, and returns the target
+// RootStore. No changeset may be recorded once the migration is finalized, so
+// the application must switch to the returned RootStore prior to committing the
+// next version.
+func (m *Manager) Finalize(version uint64) (store.RootStore, error) {
+	m.mtx.Lock()
+	if !m.started {
+		m.mtx.Unlock()
+		return nil, fmt.Errorf("migration not started")
+	}
+	if version != m.recordedVersion {
+		m.mtx.Unlock()
+		return nil, fmt.Errorf("cannot finalize version %d; latest recorded version: %d", version, m.recordedVersion)
+	}
+
+	for (!m.migrated || m.syncedVersion < version) && m.err == nil {
+		m.cond.Wait()
+	}
+
+	m.finalized = true
+	m.cond.Broadcast()
+	err := m.err
+	m.mtx.Unlock()
+
+	<-m.done
+
+	if err != nil {
+		return nil, err
+	}
+
+	m.logger.Info("migration finalized", "version", version)
+
+	return m.target, nil
+}
+
+// run migrates the state at the given height and then replays the recorded
+// changesets until the migration is finalized or fails.
+func (m *Manager) run(height uint64) {
+	defer close(m.done)
+
+	m.logger.Info("migrating state", "height", height)
+
+	if err := m.migrate(height); err != nil {
+		m.fail(fmt.Errorf("failed to migrate state at height %d: %w", height, err))
+		return
+	}
+
+	m.logger.Info("state migrated", "height", height)
+
+	m.mtx.Lock()
+	m.migrated = true
+	m.syncedVersion = height
+	m.cond.Broadcast()
+
+	for {
+		for len(m.pending) == 0 && !m.finalized {
+			m.cond.Wait()
+		}
+		if len(m.pending) == 0 {
+			m.mtx.Unlock()
+			return
+		}
+
+		vcs := m.pending[0]
+		m.pending = m.pending[1:]
+		m.mtx.Unlock()
+
+		if err := m.replay(vcs); err != nil {
+			m.fail(fmt.Errorf("failed to replay version %d: %w", vcs.Version, err))
+			return
+		}
+
+		m.mtx.Lock()
+		m.syncedVersion = vcs.Version
+		m.cond.Broadcast()
+	}
+}
+
+// migrate streams a snapshot of the source store at the given height into the
+// target RootStore.
+func (m *Manager) migrate(height uint64) error {
+	pr, pw := io.Pipe()
+
+	chExport := make(chan error, 1)
+	go func() {
+		err := m.exporter.Snapshot(height, protoio.NewDelimitedWriter(pw))
+		_ = pw.CloseWithError(err)
+		chExport <- err
+	}()
+
+	_, err := m.snapshotter.Restore(height, snapshottypes.CurrentFormat, protoio.NewDelimitedReader(pr, snapshotMaxItemSize))
+
+	// unblock the exporter in case the restoration stopped early
+	_ = pr.CloseWithError(io.ErrClosedPipe)
+
+	if exportErr := <-chExport; exportErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to export state: %w", exportErr))
+	}
+
+	return err
+}
+
+// replay commits the given changeset to the target RootStore and verifies the
+// resulting version and app hash.
+func (m *Manager) replay(vcs *VersionedChangeset) error {
+	for _, kvPair := range vcs.Changeset.Pairs {
+		if m.target.GetSCStore(kvPair.StoreKey) == nil {
+			return fmt.Errorf("%w: %s", store.ErrUnknownStoreKey, kvPair.StoreKey)
+		}
+
+		// store/v1 records the deletion of keys that do not exist, which the SC
+		// backend of the target rejects
+		kvStore := m.target.GetKVStore(kvPair.StoreKey)
+		if kvPair.Value == nil {
+			if kvStore.Has(kvPair.Key) {
+				kvStore.Delete(kvPair.Key)
+			}
+		} else {
+			kvStore.Set(kvPair.Key, kvPair.Value)
+		}
+	}
+
+	if _, err := m.target.WorkingHash(); err != nil {
+		return err
+	}
+
+	appHash, err := m.target.Commit()
+	if err != nil {
+		return err
+	}
+
+	version, err := m.target.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+	if version != vcs.Version {
+		return fmt.Errorf("unexpected version committed; got: %d, expected: %d", version, vcs.Version)
+	}
+
+	if vcs.AppHash != nil && !bytes.Equal(appHash, vcs.AppHash) {
+		return fmt.Errorf("app hash mismatch; got: %X, expected: %X", appHash, vcs.AppHash)
+	}
+
+	return nil
+}
+
+func (m *Manager) fail(err error) {
+	m.logger.Error("migration failed", "err", err)
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.err == nil {
+		m.err = err
+	}
+
+	m.cond.Broadcast()
+}
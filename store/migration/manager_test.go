@@ -0,0 +1,193 @@
+package migration
+
+import (
+	"fmt"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/root"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+var storeKeys = []string{"store1", "store2", "store3"}
+
+func newRootStore(t *testing.T) store.RootStore {
+	t.Helper()
+
+	noopLog := log.NewNopLogger()
+
+	ss, err := sqlite.New(t.TempDir())
+	require.NoError(t, err)
+
+	trees := make(map[string]store.Tree, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		trees[storeKey] = iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	}
+
+	rs, err := root.New(noopLog, 1, ss, commitment.NewDatabase(trees), pruning.NothingOptions(), pruning.NothingOptions())
+	require.NoError(t, err)
+
+	return rs
+}
+
+// commit writes a changeset for the given version to the root store, returning
+// the changeset and resulting app hash.
+func commit(t *testing.T, rs store.RootStore, version int) (*store.Changeset, []byte) {
+	t.Helper()
+
+	cs := store.NewChangeset()
+	for _, storeKey := range storeKeys {
+		for i := 0; i < 10; i++ {
+			cs.AddKVPair(store.KVPair{
+				StoreKey: storeKey,
+				Key:      []byte(fmt.Sprintf("key%03d_%03d", version, i)),
+				Value:    []byte(fmt.Sprintf("%s_val%03d_%03d", storeKey, version, i)),
+			})
+		}
+
+		// delete a key written by the previous version
+		if version > 1 {
+			cs.AddKVPair(store.KVPair{
+				StoreKey: storeKey,
+				Key:      []byte(fmt.Sprintf("key%03d_%03d", version-1, 0)),
+			})
+		}
+	}
+
+	for _, kvPair := range cs.Pairs {
+		kvStore := rs.GetKVStore(kvPair.StoreKey)
+		if kvPair.Value == nil {
+			kvStore.Delete(kvPair.Key)
+		} else {
+			kvStore.Set(kvPair.Key, kvPair.Value)
+		}
+	}
+
+	_, err := rs.WorkingHash()
+	require.NoError(t, err)
+
+	appHash, err := rs.Commit()
+	require.NoError(t, err)
+
+	return cs, appHash
+}
+
+func TestMigrate(t *testing.T) {
+	source := newRootStore(t)
+	defer source.Close()
+
+	for v := 1; v <= 5; v++ {
+		commit(t, source, v)
+	}
+
+	target := newRootStore(t)
+	defer target.Close()
+
+	m, err := NewManager(log.NewNopLogger(), source.(Exporter), target)
+	require.NoError(t, err)
+
+	// changesets cannot be recorded prior to the migration starting
+	require.Error(t, m.Record(6, store.NewChangeset(), nil))
+
+	require.NoError(t, m.Start(5))
+	require.Error(t, m.Start(5))
+
+	// the chain keeps running on the source store while the state is migrated
+	var appHash []byte
+	for v := 6; v <= 10; v++ {
+		var cs *store.Changeset
+		cs, appHash = commit(t, source, v)
+		require.NoError(t, m.Record(uint64(v), cs, appHash))
+	}
+
+	// versions must be recorded sequentially
+	require.Error(t, m.Record(12, store.NewChangeset(), nil))
+
+	// only the latest recorded version can be finalized
+	_, err = m.Finalize(9)
+	require.Error(t, err)
+
+	rs, err := m.Finalize(10)
+	require.NoError(t, err)
+	require.Equal(t, target, rs)
+
+	syncedVersion, migrated := m.SyncedVersion()
+	require.True(t, migrated)
+	require.Equal(t, uint64(10), syncedVersion)
+
+	// no changeset may be recorded once finalized
+	require.Error(t, m.Record(11, store.NewChangeset(), nil))
+
+	lastCommitID, err := rs.(*root.Store).LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), lastCommitID.Version)
+	require.Equal(t, appHash, lastCommitID.Hash)
+
+	for _, storeKey := range storeKeys {
+		kvStore := rs.GetKVStore(storeKey)
+		for v := 1; v <= 10; v++ {
+			for i := 0; i < 10; i++ {
+				key := []byte(fmt.Sprintf("key%03d_%03d", v, i))
+				if i == 0 && v < 10 {
+					require.Nil(t, kvStore.Get(key))
+					continue
+				}
+
+				require.Equal(t, []byte(fmt.Sprintf("%s_val%03d_%03d", storeKey, v, i)), kvStore.Get(key))
+			}
+		}
+	}
+
+	// the application keeps committing on the target store
+	_, appHash = commit(t, source, 11)
+	_, targetAppHash := commit(t, rs, 11)
+	require.Equal(t, appHash, targetAppHash)
+}
+
+func TestMigrate_AppHashMismatch(t *testing.T) {
+	source := newRootStore(t)
+	defer source.Close()
+
+	for v := 1; v <= 3; v++ {
+		commit(t, source, v)
+	}
+
+	target := newRootStore(t)
+	defer target.Close()
+
+	m, err := NewManager(log.NewNopLogger(), source.(Exporter), target)
+	require.NoError(t, err)
+	require.NoError(t, m.Start(3))
+
+	cs, _ := commit(t, source, 4)
+	require.NoError(t, m.Record(4, cs, []byte("invalid app hash")))
+
+	_, err = m.Finalize(4)
+	require.ErrorContains(t, err, "app hash mismatch")
+}
+
+func TestMigrate_ExportFailure(t *testing.T) {
+	source := newRootStore(t)
+	defer source.Close()
+
+	commit(t, source, 1)
+
+	target := newRootStore(t)
+	defer target.Close()
+
+	m, err := NewManager(log.NewNopLogger(), source.(Exporter), target)
+	require.NoError(t, err)
+
+	// the height is not committed to the source store
+	require.NoError(t, m.Start(5))
+
+	_, err = m.Finalize(5)
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package pruning
+
+import (
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+)
+
+// Manager is an abstraction to handle pruning of the State Storage (SS) and
+// State Commitment (SC) backends. Each backend is pruned independently based on
+// its own Options. Pruning is expected to be triggered after each Commit via
+// Prune and is, unless configured otherwise, performed asynchronously.
+//
+// Versions may be pinned, e.g. by an in-progress state-sync snapshot, in which
+// case neither backend is pruned up to or beyond the lowest pinned version until
+// it is unpinned.
+type Manager struct {
+	logger log.Logger
+
+	stateStorage    *pruner
+	stateCommitment *pruner
+
+	// pinnedMtx guards pinnedVersions
+	pinnedMtx sync.Mutex
+
+	// pinnedVersions reflects the versions that must not be pruned, mapped to
+	// the number of times they've been pinned
+	pinnedVersions map[uint64]int
+}
+
+// pruner defines the pruning state of a single backend.
+type pruner struct {
+	name   string
+	db     store.Pruner
+	opts   Options
+	logger log.Logger
+
+	// wg tracks any in-flight asynchronous pruning
+	wg sync.WaitGroup
+
+	// mtx guards pruning and lastPrunedVersion
+	mtx               sync.Mutex
+	pruning           bool
+	lastPrunedVersion uint64
+}
+
+// NewManager returns a new pruning Manager for the given SS and SC backends and
+// their respective pruning options.
+func NewManager(
+	logger log.Logger,
+	ss store.Pruner,
+	ssOpts Options,
+	sc store.Pruner,
+	scOpts Options,
+) *Manager {
+	logger = logger.With("module", "pruning")
+
+	return &Manager{
+		logger:          logger,
+		stateStorage:    &pruner{name: "SS", db: ss, opts: ssOpts, logger: logger},
+		stateCommitment: &pruner{name: "SC", db: sc, opts: scOpts, logger: logger},
+		pinnedVersions:  make(map[uint64]int),
+	}
+}
+
+// PinVersion marks the given version as in use, preventing it, and any version
+// after it, from being pruned until UnpinVersion is called. Pins are counted,
+// so every call must be matched by a call to UnpinVersion.
+func (m *Manager) PinVersion(version uint64) {
+	m.pinnedMtx.Lock()
+	defer m.pinnedMtx.Unlock()
+
+	m.pinnedVersions[version]++
+}
+
+// UnpinVersion releases a pin previously acquired via PinVersion.
+func (m *Manager) UnpinVersion(version uint64) {
+	m.pinnedMtx.Lock()
+	defer m.pinnedMtx.Unlock()
+
+	if m.pinnedVersions[version] <= 1 {
+		delete(m.pinnedVersions, version)
+		return
+	}
+
+	m.pinnedVersions[version]--
+}
+
+// Prune prunes the SS and SC backends, if applicable, after the given version
+// has been committed. Backends configured to prune asynchronously are pruned in
+// a background goroutine, where a pruning round is skipped if the previous one
+// for the same backend has not yet completed. An error is only returned upon
+// failure of a synchronous pruning.
+func (m *Manager) Prune(version uint64) error {
+	if err := m.prune(m.stateStorage, version); err != nil {
+		return err
+	}
+
+	return m.prune(m.stateCommitment, version)
+}
+
+// Wait blocks until all in-flight asynchronous pruning has completed. It must be
+// called prior to closing the underlying backends.
+func (m *Manager) Wait() {
+	m.stateStorage.wg.Wait()
+	m.stateCommitment.wg.Wait()
+}
+
+func (m *Manager) prune(p *pruner, version uint64) error {
+	pruneVersion, ok := p.opts.ShouldPrune(version)
+	if !ok {
+		return nil
+	}
+
+	pruneVersion, ok = m.capToPinned(pruneVersion)
+	if !ok {
+		p.logger.Debug("skipping pruning; versions are pinned", "store", p.name, "version", version)
+		return nil
+	}
+
+	p.mtx.Lock()
+	if p.pruning {
+		p.mtx.Unlock()
+		p.logger.Debug("skipping pruning; previous pruning in progress", "store", p.name, "version", version)
+		return nil
+	}
+	if pruneVersion <= p.lastPrunedVersion {
+		p.mtx.Unlock()
+		return nil
+	}
+	p.pruning = true
+	p.mtx.Unlock()
+
+	if p.opts.Sync {
+		return p.prune(pruneVersion)
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		if err := p.prune(pruneVersion); err != nil {
+			p.logger.Error("failed to prune", "store", p.name, "version", pruneVersion, "err", err)
+		}
+	}()
+
+	return nil
+}
+
+// capToPinned caps the given prune version such that no pinned version is
+// pruned. It returns false if nothing can be pruned.
+func (m *Manager) capToPinned(pruneVersion uint64) (uint64, bool) {
+	m.pinnedMtx.Lock()
+	defer m.pinnedMtx.Unlock()
+
+	for pinned := range m.pinnedVersions {
+		if pinned <= pruneVersion {
+			if pinned == 0 {
+				return 0, false
+			}
+
+			pruneVersion = pinned - 1
+		}
+	}
+
+	return pruneVersion, pruneVersion > 0
+}
+
+func (p *pruner) prune(version uint64) error {
+	p.logger.Debug("pruning", "store", p.name, "version", version)
+
+	err := p.db.Prune(version)
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.pruning = false
+	if err != nil {
+		return fmt.Errorf("failed to prune %s up to version %d: %w", p.name, version, err)
+	}
+
+	p.lastPrunedVersion = version
+	return nil
+}
@@ -0,0 +1,117 @@
+package pruning
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+)
+
+type mockPruner struct {
+	mtx      sync.Mutex
+	versions []uint64
+	err      error
+
+	// block, if set, is waited on before returning from Prune
+	block chan struct{}
+}
+
+func (p *mockPruner) Prune(version uint64) error {
+	if p.block != nil {
+		<-p.block
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.versions = append(p.versions, version)
+	return p.err
+}
+
+func (p *mockPruner) prunedVersions() []uint64 {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	return p.versions
+}
+
+func TestManager_PruneIndependently(t *testing.T) {
+	ss, sc := &mockPruner{}, &mockPruner{}
+	ssOpts := NewCustomOptions(2, 10)
+	scOpts := NewCustomOptions(5, 20)
+	scOpts.Sync = true
+
+	m := NewManager(log.NewNopLogger(), ss, ssOpts, sc, scOpts)
+	for v := uint64(1); v <= 40; v++ {
+		require.NoError(t, m.Prune(v))
+		m.Wait()
+	}
+
+	require.Equal(t, []uint64{7, 17, 27, 37}, ss.prunedVersions())
+	require.Equal(t, []uint64{14, 34}, sc.prunedVersions())
+}
+
+func TestManager_PinnedVersions(t *testing.T) {
+	ss, sc := &mockPruner{}, &mockPruner{}
+	opts := NewCustomOptions(2, 10)
+	opts.Sync = true
+
+	m := NewManager(log.NewNopLogger(), ss, opts, sc, opts)
+
+	// pin a version and ensure pruning never reaches it
+	m.PinVersion(15)
+	m.PinVersion(15)
+	require.NoError(t, m.Prune(20))
+	require.NoError(t, m.Prune(30))
+	require.Equal(t, []uint64{14}, ss.prunedVersions())
+	require.Equal(t, []uint64{14}, sc.prunedVersions())
+
+	// pins are counted, so the version remains pinned
+	m.UnpinVersion(15)
+	require.NoError(t, m.Prune(40))
+	require.Equal(t, []uint64{14}, ss.prunedVersions())
+
+	// once fully unpinned, pruning resumes
+	m.UnpinVersion(15)
+	require.NoError(t, m.Prune(50))
+	require.Equal(t, []uint64{14, 47}, ss.prunedVersions())
+	require.Equal(t, []uint64{14, 47}, sc.prunedVersions())
+}
+
+func TestManager_AsyncSkipsInFlight(t *testing.T) {
+	ss, sc := &mockPruner{block: make(chan struct{})}, &mockPruner{}
+	opts := NewCustomOptions(2, 10)
+
+	m := NewManager(log.NewNopLogger(), ss, opts, sc, NothingOptions())
+
+	// the first prune blocks, so the second one must be skipped
+	require.NoError(t, m.Prune(10))
+	require.NoError(t, m.Prune(20))
+
+	close(ss.block)
+	m.Wait()
+	require.Equal(t, []uint64{7}, ss.prunedVersions())
+	require.Empty(t, sc.prunedVersions())
+
+	require.NoError(t, m.Prune(30))
+	m.Wait()
+	require.Equal(t, []uint64{7, 27}, ss.prunedVersions())
+}
+
+func TestManager_SyncError(t *testing.T) {
+	expErr := errors.New("prune error")
+	ss := &mockPruner{err: expErr}
+	opts := NewCustomOptions(2, 10)
+	opts.Sync = true
+
+	m := NewManager(log.NewNopLogger(), ss, opts, &mockPruner{}, NothingOptions())
+	require.ErrorIs(t, m.Prune(10), expErr)
+
+	// a failed prune is retried on the next interval
+	ss.err = nil
+	require.NoError(t, m.Prune(20))
+	require.Equal(t, []uint64{7, 17}, ss.prunedVersions())
+}
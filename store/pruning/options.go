@@ -0,0 +1,131 @@
+package pruning
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Options defines the pruning configuration of a single backend, i.e. SS or SC.
+type Options struct {
+	// KeepRecent defines how many recent versions to keep, in addition to the
+	// latest committed version.
+	KeepRecent uint64
+
+	// Interval defines the version interval at which pruning is performed. If
+	// set to 0, no pruning is performed.
+	Interval uint64
+
+	// Sync when set to true ensures that pruning is performed synchronously,
+	// otherwise, by default, it is performed asynchronously.
+	Sync bool
+}
+
+// Pruning option string constants, which mirror the `pruning` option in app.toml.
+const (
+	OptionDefault    = "default"
+	OptionEverything = "everything"
+	OptionNothing    = "nothing"
+	OptionCustom     = "custom"
+)
+
+const (
+	defaultKeepRecent = 362880
+	defaultInterval   = 10
+
+	pruneEverythingKeepRecent = 2
+	pruneEverythingInterval   = 10
+)
+
+var (
+	ErrPruningIntervalZero       = errors.New("'pruning-interval' must not be 0. If you want to disable pruning, select pruning = \"nothing\"")
+	ErrPruningIntervalTooSmall   = fmt.Errorf("'pruning-interval' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingInterval)
+	ErrPruningKeepRecentTooSmall = fmt.Errorf("'pruning-keep-recent' must not be less than %d. For the most aggressive pruning, select pruning = \"everything\"", pruneEverythingKeepRecent)
+)
+
+// DefaultOptions returns the default pruning options, which keep the last
+// 362880 versions (approximately 3.5 weeks worth of state assuming a 6s block
+// time) and prune every 10th version.
+func DefaultOptions() Options {
+	return Options{
+		KeepRecent: defaultKeepRecent,
+		Interval:   defaultInterval,
+	}
+}
+
+// EverythingOptions returns pruning options that only keep the latest version
+// and the two before it, pruning every 10th version.
+func EverythingOptions() Options {
+	return Options{
+		KeepRecent: pruneEverythingKeepRecent,
+		Interval:   pruneEverythingInterval,
+	}
+}
+
+// NothingOptions returns pruning options that keep all versions.
+func NothingOptions() Options {
+	return Options{}
+}
+
+// NewCustomOptions returns pruning options with the given keep-recent and
+// interval values.
+func NewCustomOptions(keepRecent, interval uint64) Options {
+	return Options{
+		KeepRecent: keepRecent,
+		Interval:   interval,
+	}
+}
+
+// NewOptionsFromString returns the pruning options corresponding to the given
+// strategy. Unknown strategies, including "custom", fallback to the default
+// options and must be populated by the caller.
+func NewOptionsFromString(strategy string) Options {
+	switch strategy {
+	case OptionEverything:
+		return EverythingOptions()
+
+	case OptionNothing:
+		return NothingOptions()
+
+	default:
+		return DefaultOptions()
+	}
+}
+
+// Enabled returns true if the options result in any version being pruned.
+func (o Options) Enabled() bool {
+	return o.Interval > 0
+}
+
+// Validate returns an error if the options are not valid. Options that disable
+// pruning are always valid.
+func (o Options) Validate() error {
+	if o.Interval == 0 && o.KeepRecent == 0 {
+		return nil
+	}
+	if o.Interval == 0 {
+		return ErrPruningIntervalZero
+	}
+	if o.Interval < pruneEverythingInterval {
+		return ErrPruningIntervalTooSmall
+	}
+	if o.KeepRecent < pruneEverythingKeepRecent {
+		return ErrPruningKeepRecentTooSmall
+	}
+
+	return nil
+}
+
+// ShouldPrune returns the version up to which, inclusive, state should be pruned
+// after committing the given version, and whether pruning should occur at all.
+func (o Options) ShouldPrune(version uint64) (uint64, bool) {
+	if !o.Enabled() || version%o.Interval != 0 {
+		return 0, false
+	}
+
+	// keep the current version and KeepRecent versions prior to it
+	if version <= o.KeepRecent+1 {
+		return 0, false
+	}
+
+	return version - o.KeepRecent - 1, true
+}
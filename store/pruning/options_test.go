@@ -0,0 +1,58 @@
+package pruning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptions_Validate(t *testing.T) {
+	testCases := []struct {
+		opts      Options
+		expectErr error
+	}{
+		{DefaultOptions(), nil},
+		{EverythingOptions(), nil},
+		{NothingOptions(), nil},
+		{NewCustomOptions(2, 10), nil},
+		{NewCustomOptions(100, 0), ErrPruningIntervalZero},
+		{NewCustomOptions(100, 9), ErrPruningIntervalTooSmall},
+		{NewCustomOptions(1, 10), ErrPruningKeepRecentTooSmall},
+	}
+
+	for _, tc := range testCases {
+		err := tc.opts.Validate()
+		require.Equal(t, tc.expectErr, err, "options: %v, err: %s", tc.opts, err)
+	}
+}
+
+func TestNewOptionsFromString(t *testing.T) {
+	require.Equal(t, EverythingOptions(), NewOptionsFromString(OptionEverything))
+	require.Equal(t, NothingOptions(), NewOptionsFromString(OptionNothing))
+	require.Equal(t, DefaultOptions(), NewOptionsFromString(OptionDefault))
+	require.Equal(t, DefaultOptions(), NewOptionsFromString("invalid"))
+}
+
+func TestOptions_ShouldPrune(t *testing.T) {
+	testCases := []struct {
+		name          string
+		opts          Options
+		version       uint64
+		expectPrune   bool
+		expectVersion uint64
+	}{
+		{"nothing", NothingOptions(), 100, false, 0},
+		{"not at interval", NewCustomOptions(2, 10), 15, false, 0},
+		{"at interval", NewCustomOptions(2, 10), 20, true, 17},
+		{"within keep recent", NewCustomOptions(20, 10), 20, false, 0},
+		{"just past keep recent", NewCustomOptions(18, 10), 20, true, 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, ok := tc.opts.ShouldPrune(tc.version)
+			require.Equal(t, tc.expectPrune, ok)
+			require.Equal(t, tc.expectVersion, version)
+		})
+	}
+}
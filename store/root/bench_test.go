@@ -0,0 +1,94 @@
+package root
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage/async"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+// BenchmarkCommit benchmarks WorkingHash and Commit over a varying number of
+// stores. Each store's tree is hashed and committed concurrently, so the serial
+// case, which limits execution to a single OS thread, serves as the baseline.
+//
+// Note, SS writes are flushed asynchronously such that the benchmark is
+// dominated by tree hashing.
+func BenchmarkCommit(b *testing.B) {
+	const numKeysPerStore = 500
+
+	for _, numStores := range []int{1, 20, 50} {
+		for _, parallel := range []bool{false, true} {
+			mode := "serial"
+			if parallel {
+				mode = "parallel"
+			}
+
+			b.Run(fmt.Sprintf("stores_%d_%s", numStores, mode), func(b *testing.B) {
+				if !parallel {
+					defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+				}
+
+				rs := newBenchRootStore(b, numStores)
+				defer func() {
+					require.NoError(b, rs.Close())
+				}()
+
+				rng := rand.New(rand.NewSource(567320))
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					b.StopTimer()
+					for s := 0; s < numStores; s++ {
+						kvStore := rs.GetKVStore(fmt.Sprintf("store%03d", s))
+						for k := 0; k < numKeysPerStore; k++ {
+							key := make([]byte, 32)
+							val := make([]byte, 128)
+							_, _ = rng.Read(key)
+							_, _ = rng.Read(val)
+
+							kvStore.Set(key, val)
+						}
+					}
+					b.StartTimer()
+
+					_, err := rs.WorkingHash()
+					require.NoError(b, err)
+
+					_, err = rs.Commit()
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
+
+func newBenchRootStore(b *testing.B, numStores int) store.RootStore {
+	noopLog := log.NewNopLogger()
+
+	pebbleDB, err := pebbledb.New(b.TempDir())
+	require.NoError(b, err)
+
+	ss, err := async.New(noopLog, pebbleDB, 1024)
+	require.NoError(b, err)
+
+	trees := make(map[string]store.Tree, numStores)
+	for s := 0; s < numStores; s++ {
+		trees[fmt.Sprintf("store%03d", s)] = iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	}
+
+	rs, err := New(noopLog, 1, ss, commitment.NewDatabase(trees), pruning.NothingOptions(), pruning.NothingOptions())
+	require.NoError(b, err)
+
+	return rs
+}
@@ -0,0 +1,190 @@
+package root
+
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/wal"
+)
+
+// SetWAL sets the write-ahead log of the store, to which every changeset is
+// durably written prior to being committed to SS and SC. Upon LoadLatestVersion,
+// the WAL is used to recover from SS and SC disagreeing on the latest version,
+// e.g. due to a crash mid-Commit, by replaying the missing changesets.
+func (s *Store) SetWAL(w *wal.WAL) {
+	s.wal = w
+}
+
+// recover detects any skew between the latest version of SS and of each SC
+// tree and makes them consistent. Backends behind are rolled forward by
+// replaying the changesets logged in the WAL, up to the latest version for which
+// every changeset is available. SC trees beyond that version are rolled back. SS
+// cannot be rolled back, so an error is returned if SS is ahead of the version
+// that SC can be recovered to.
+//
+// SC trees with no committed version, e.g. the tree of a module added by an
+// upgrade, are left untouched, unless no tree has a committed version at all.
+func (s *Store) recover() error {
+	// load the latest version of every SC tree, as persisted
+	if err := s.stateCommitment.LoadVersion(0); err != nil {
+		return fmt.Errorf("failed to load latest SC version: %w", err)
+	}
+
+	ssVersion, err := s.stateStore.GetLatestVersion()
+	if err != nil {
+		return err
+	}
+
+	storeKeys := s.stateCommitment.StoreKeys()
+	scVersions := make(map[string]uint64, len(storeKeys))
+
+	// trees with no committed version are excluded from the recovery
+	recoveredKeys := make([]string, 0, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		v := s.stateCommitment.GetTree(storeKey).GetLatestVersion()
+		scVersions[storeKey] = v
+
+		if v > 0 {
+			recoveredKeys = append(recoveredKeys, storeKey)
+		}
+	}
+	if len(recoveredKeys) == 0 {
+		recoveredKeys = storeKeys
+	}
+
+	skewed := false
+	baseVersion := ssVersion
+	for _, storeKey := range recoveredKeys {
+		v := scVersions[storeKey]
+		skewed = skewed || v != ssVersion
+		baseVersion = min(baseVersion, v)
+	}
+
+	if !skewed {
+		return nil
+	}
+
+	s.logger.Info("detected SS and SC version skew", "ss_version", ssVersion, "sc_versions", scVersions)
+
+	// collect the contiguous changesets following the version every backend has
+	// reached, where the first version may be the initial version
+	target := baseVersion
+	changesets := make(map[uint64]*store.Changeset)
+	if s.wal != nil {
+		for _, v := range s.wal.Versions() {
+			if v <= target {
+				continue
+			}
+			if v != target+1 && target != 0 {
+				break
+			}
+
+			cs, err := s.wal.Read(v)
+			if err != nil {
+				return err
+			}
+
+			changesets[v] = cs
+			target = v
+		}
+	}
+
+	// only versions up to the latest one of either backend need to be recovered
+	latestVersion := ssVersion
+	for _, storeKey := range recoveredKeys {
+		latestVersion = max(latestVersion, scVersions[storeKey])
+	}
+	target = min(target, latestVersion)
+
+	if target < ssVersion {
+		return fmt.Errorf("SS is at version %d, but SC can only be recovered up to version %d", ssVersion, target)
+	}
+
+	versions := make([]uint64, 0, len(changesets))
+	for v := range changesets {
+		if v <= target {
+			versions = append(versions, v)
+		}
+	}
+	slices.Sort(versions)
+
+	for _, storeKey := range recoveredKeys {
+		if err := recoverTree(storeKey, s.stateCommitment.GetTree(storeKey), target, versions, changesets); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range versions {
+		if v <= ssVersion {
+			continue
+		}
+
+		if err := s.stateStore.ApplyChangeset(v, changesets[v]); err != nil {
+			return fmt.Errorf("failed to replay changeset to SS at version %d: %w", v, err)
+		}
+	}
+
+	s.logger.Info("recovered SS and SC", "version", target)
+	return nil
+}
+
+// recoverTree rolls the given tree back or forward to the target version, where
+// rolling forward replays the store key's writes of the given changesets.
+func recoverTree(storeKey string, tree store.Tree, target uint64, versions []uint64, changesets map[uint64]*store.Changeset) error {
+	latestVersion := tree.GetLatestVersion()
+
+	if latestVersion > target {
+		if target == 0 {
+			return fmt.Errorf("cannot roll back tree %s from version %d to an empty state", storeKey, latestVersion)
+		}
+
+		if err := tree.LoadVersion(target); err != nil {
+			return fmt.Errorf("failed to roll back tree %s to version %d: %w", storeKey, target, err)
+		}
+
+		return nil
+	}
+
+	for _, v := range versions {
+		if v <= latestVersion {
+			continue
+		}
+
+		cs := store.NewChangeset()
+		for _, kvPair := range changesets[v].Pairs {
+			if kvPair.StoreKey == storeKey {
+				cs.AddKVPair(kvPair)
+			}
+		}
+
+		if err := tree.WriteBatch(cs); err != nil {
+			return fmt.Errorf("failed to replay changeset to tree %s at version %d: %w", storeKey, v, err)
+		}
+		if _, err := tree.Commit(); err != nil {
+			return fmt.Errorf("failed to commit tree %s at version %d: %w", storeKey, v, err)
+		}
+		if committed := tree.GetLatestVersion(); committed != v {
+			return fmt.Errorf("unexpected version of tree %s after replay; got: %d, expected: %d", storeKey, committed, v)
+		}
+	}
+
+	return nil
+}
+
+// truncateWAL removes the changesets up to and including the given committed
+// version from the WAL, except those not yet durably flushed by SS, e.g. when
+// SS is wrapped by the storage/async package.
+func (s *Store) truncateWAL(version uint64) error {
+	if p, ok := s.stateStore.(interface{ PendingVersions() []uint64 }); ok {
+		if pending := p.PendingVersions(); len(pending) > 0 {
+			version = min(version, pending[0]-1)
+		}
+	}
+
+	if err := s.wal.Truncate(version); err != nil {
+		return fmt.Errorf("failed to truncate WAL: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"cosmossdk.io/store/v2"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
+)
+
+var _ snapshottypes.Snapshotter = (*Store)(nil)
+
+// restoreBatchSize defines the number of key-value pairs restored into the SS
+// backend per changeset during a state-sync restoration.
+const restoreBatchSize = 10_000
+
+// Snapshot writes a state-sync snapshot of the SC trees at the given height into
+// the protobuf writer. The height is pinned for the duration of the snapshot so
+// that it cannot be pruned while it is being exported.
+func (s *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	if height == 0 {
+		return fmt.Errorf("cannot snapshot height 0")
+	}
+
+	if latestVersion := s.stateCommitment.GetLatestVersion(); height > latestVersion {
+		return fmt.Errorf("cannot snapshot future height %d; latest version: %d", height, latestVersion)
+	}
+
+	s.pruningManager.PinVersion(height)
+	defer s.pruningManager.UnpinVersion(height)
+
+	s.logger.Debug("creating snapshot", "height", height)
+
+	return s.stateCommitment.Snapshot(height, protoWriter)
+}
+
+// PruneSnapshotHeight releases the given height, which was pinned upon Commit
+// as it matched the snapshot interval, such that it may be pruned.
+func (s *Store) PruneSnapshotHeight(height int64) {
+	if height <= 0 {
+		return
+	}
+
+	s.pruningManager.UnpinVersion(uint64(height))
+}
+
+// SetSnapshotInterval sets the interval at which snapshots are taken. Committed
+// versions matching the interval are pinned until PruneSnapshotHeight is called,
+// so they are not pruned prior to the snapshot being taken.
+func (s *Store) SetSnapshotInterval(snapshotInterval uint64) {
+	s.snapshotInterval = snapshotInterval
+}
+
+// Restore restores the SC trees and the SS backend at the given height from a
+// state-sync snapshot. The SC trees are restored from the protobuf reader while
+// the SS backend is concurrently restored from the leaf nodes of the trees. Once
+// restored, the store is loaded at the given height.
+//
+// Restore returns the next snapshot item, if any, which does not belong to the
+// SC trees, e.g. an extension item.
+func (s *Store) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	if height == 0 {
+		return snapshottypes.SnapshotItem{}, fmt.Errorf("cannot restore height 0")
+	}
+
+	s.logger.Debug("restoring snapshot", "height", height)
+
+	chStorage := make(chan *store.KVPair, restoreBatchSize)
+	chDone := make(chan error, 1)
+	go func() {
+		chDone <- s.restoreSS(height, chStorage)
+	}()
+
+	nextItem, err := s.stateCommitment.Restore(height, format, protoReader, chStorage)
+	if ssErr := <-chDone; ssErr != nil {
+		err = errors.Join(err, fmt.Errorf("failed to restore SS: %w", ssErr))
+	}
+	if err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	return nextItem, s.loadVersion(height, nil)
+}
+
+// restoreSS writes the key-value pairs received from chStorage to the SS backend
+// at the given version in batches of restoreBatchSize until chStorage is closed.
+// Upon failure, chStorage is still drained so that the SC restoration does not
+// block.
+func (s *Store) restoreSS(version uint64, chStorage <-chan *store.KVPair) error {
+	var err error
+
+	cs := store.NewChangeset()
+	for kvPair := range chStorage {
+		if err != nil {
+			continue
+		}
+
+		cs.AddKVPair(*kvPair)
+		if cs.Size() >= restoreBatchSize {
+			err = s.stateStore.ApplyChangeset(version, cs)
+			cs = store.NewChangeset()
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if cs.Size() > 0 {
+		if err := s.stateStore.ApplyChangeset(version, cs); err != nil {
+			return err
+		}
+	}
+
+	return s.stateStore.SetLatestVersion(version)
+}
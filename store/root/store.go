@@ -8,25 +8,25 @@ import (
 
 	"github.com/cockroachdb/errors"
 	ics23 "github.com/cosmos/ics23/go"
+	"golang.org/x/exp/maps"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
 	"cosmossdk.io/store/v2/branchkv"
+	"cosmossdk.io/store/v2/checkpoint"
 	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/pruning"
 	"cosmossdk.io/store/v2/tracekv"
+	"cosmossdk.io/store/v2/wal"
 )
 
-// defaultStoreKey defines the default store key used for the single SC backend.
-// Note, however, this store key is essentially irrelevant as it's not exposed
-// to the user and it only needed to fulfill usage of StoreInfo during Commit.
-const defaultStoreKey = "default"
-
 var _ store.RootStore = (*Store)(nil)
 
 // Store defines the SDK's default RootStore implementation. It contains a single
-// State Storage (SS) backend and a single State Commitment (SC) backend. Note,
-// this means all store keys are ignored and commitments exist in a single commitment
-// tree.
+// State Storage (SS) backend and a State Commitment (SC) backend that holds one
+// tree per store key. Each store key is paired with a root BranchedKVStore that
+// accumulates writes for that store key, which are committed to the store key's
+// SC tree and to the shared SS backend upon Commit().
 type Store struct {
 	logger         log.Logger
 	initialVersion uint64
@@ -37,9 +37,9 @@ type Store struct {
 	// stateCommitment reflects the state commitment (SC) backend
 	stateCommitment *commitment.Database
 
-	// rootKVStore reflects the root BranchedKVStore that is used to accumulate writes
-	// and branch off of.
-	rootKVStore store.BranchedKVStore
+	// kvStores reflects the root BranchedKVStore, keyed by store key, that is
+	// used to accumulate writes and branch off of.
+	kvStores map[string]store.BranchedKVStore
 
 	// commitHeader reflects the header used when committing state (note, this isn't required and only used for query purposes)
 	commitHeader store.CommitHeader
@@ -55,17 +55,50 @@ type Store struct {
 
 	// traceContext defines the tracing context, if any, for trace operations
 	traceContext store.TraceContext
+
+	// pruningManager manages pruning of the SS and SC backends
+	pruningManager *pruning.Manager
+
+	// snapshotInterval defines the interval, in versions, at which state-sync
+	// snapshots are taken, where 0 means snapshots are disabled
+	snapshotInterval uint64
+
+	// checkpointManager, if set, maintains sparse SC checkpoints which serve
+	// proofs for versions pruned from the SC trees
+	checkpointManager *checkpoint.Manager
+
+	// wal, if set, durably logs every changeset prior to it being committed so
+	// that SS and SC can be recovered to a consistent version upon restart
+	wal *wal.WAL
 }
 
+// New creates a new root Store. A root KVStore is created for every tree that
+// is already mounted on the provided SC backend. Additional trees may be mounted
+// via MountSCStore. The SS and SC backends are pruned independently after each
+// Commit according to the provided pruning options.
 func New(
 	logger log.Logger,
 	initVersion uint64,
 	ss store.VersionedDatabase,
 	sc *commitment.Database,
+	ssOpts, scOpts pruning.Options,
 ) (store.RootStore, error) {
-	rootKVStore, err := branchkv.New(defaultStoreKey, ss)
-	if err != nil {
-		return nil, err
+	if err := ssOpts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SS pruning options: %w", err)
+	}
+	if err := scOpts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid SC pruning options: %w", err)
+	}
+
+	storeKeys := sc.StoreKeys()
+	kvStores := make(map[string]store.BranchedKVStore, len(storeKeys))
+	for _, storeKey := range storeKeys {
+		kvStore, err := branchkv.New(storeKey, ss)
+		if err != nil {
+			return nil, err
+		}
+
+		kvStores[storeKey] = kvStore
 	}
 
 	return &Store{
@@ -73,36 +106,71 @@ func New(
 		initialVersion:  initVersion,
 		stateStore:      ss,
 		stateCommitment: sc,
-		rootKVStore:     rootKVStore,
+		kvStores:        kvStores,
+		pruningManager:  pruning.NewManager(logger, ss, ssOpts, sc, scOpts),
 	}, nil
 }
 
 // Close closes the store and resets all internal fields. Note, Close() is NOT
 // idempotent and should only be called once.
 func (s *Store) Close() (err error) {
+	// wait for any in-flight pruning and checkpoints prior to closing the backends
+	s.pruningManager.Wait()
+	if s.checkpointManager != nil {
+		err = errors.Join(err, s.checkpointManager.Close())
+	}
+
 	err = errors.Join(err, s.stateStore.Close())
 	err = errors.Join(err, s.stateCommitment.Close())
 
 	s.stateStore = nil
 	s.stateCommitment = nil
+	s.kvStores = nil
+	s.checkpointManager = nil
+	s.wal = nil
 	s.lastCommitInfo = nil
 	s.commitHeader = nil
 
 	return err
 }
 
-// MountSCStore performs a no-op as a SC backend must be provided at initialization.
-func (s *Store) MountSCStore(_ string, _ store.Tree) error {
-	return errors.New("cannot mount SC store; SC must be provided on initialization")
+// MountSCStore mounts a SC tree under the given store key and creates the root
+// KVStore for that store key. Stores must be mounted prior to loading a version
+// and an error is returned if the store key is already mounted.
+func (s *Store) MountSCStore(storeKey string, sc store.Tree) error {
+	s.logger.Debug("mounting SC store", "store_key", storeKey)
+
+	if _, ok := s.kvStores[storeKey]; ok {
+		return fmt.Errorf("SC store already mounted for store key %s", storeKey)
+	}
+
+	kvStore, err := branchkv.New(storeKey, s.stateStore)
+	if err != nil {
+		return err
+	}
+
+	if err := s.stateCommitment.MountTree(storeKey, sc); err != nil {
+		return err
+	}
+
+	s.kvStores[storeKey] = kvStore
+	return nil
 }
 
-// GetSCStore returns the store's state commitment (SC) backend. Note, the store
-// key is ignored as there exists only a single SC tree.
-func (s *Store) GetSCStore(_ string) store.Tree {
-	return s.stateCommitment
+// GetSCStore returns the SC tree mounted under the given store key, or nil if
+// no tree is mounted.
+func (s *Store) GetSCStore(storeKey string) store.Tree {
+	return s.stateCommitment.GetTree(storeKey)
 }
 
+// LoadLatestVersion loads the latest version. Prior to loading, any skew between
+// the latest versions of SS and SC, e.g. due to a crash mid-Commit, is recovered
+// from. See SetWAL for details.
 func (s *Store) LoadLatestVersion() error {
+	if err := s.recover(); err != nil {
+		return fmt.Errorf("failed to recover SS and SC: %w", err)
+	}
+
 	lv, err := s.GetLatestVersion()
 	if err != nil {
 		return err
@@ -150,9 +218,31 @@ func (s *Store) GetLatestVersion() (uint64, error) {
 	return lastCommitID.Version, nil
 }
 
-// GetProof delegates the GetProof to the store's underlying SC backend.
-func (s *Store) GetProof(_ string, version uint64, key []byte) (*ics23.CommitmentProof, error) {
-	return s.stateCommitment.GetProof(version, key)
+// SetCheckpointManager sets the checkpoint manager of the store, which writes
+// sparse SC checkpoints upon Commit and serves proofs for versions that have
+// been pruned from the SC trees. The store takes ownership of the manager and
+// closes it upon Close.
+func (s *Store) SetCheckpointManager(m *checkpoint.Manager) {
+	s.checkpointManager = m
+}
+
+// GetProof delegates the GetProof to the SC tree mounted under the given store
+// key. If the version is no longer present in the SC tree, the proof is served
+// by the checkpoint manager, if set. Note, the returned proof is against the
+// store's tree root. To prove the tree root against the app hash, use the
+// CommitInfo's GetStoreProof.
+func (s *Store) GetProof(storeKey string, version uint64, key []byte) (*ics23.CommitmentProof, error) {
+	proof, err := s.stateCommitment.GetProof(storeKey, version, key)
+	if err == nil || s.checkpointManager == nil || errors.Is(err, store.ErrUnknownStoreKey) {
+		return proof, err
+	}
+
+	proof, cpErr := s.checkpointManager.GetProof(storeKey, version, key)
+	if cpErr != nil {
+		return nil, errors.Join(err, fmt.Errorf("failed to get proof from checkpoint: %w", cpErr))
+	}
+
+	return proof, nil
 }
 
 // LoadVersion loads a specific version returning an error upon failure.
@@ -160,31 +250,36 @@ func (s *Store) LoadVersion(v uint64) (err error) {
 	return s.loadVersion(v, nil)
 }
 
-// GetKVStore returns the store's root KVStore. Any writes to this store without
-// branching will be committed to SC and SS upon Commit(). Branching will create
-// a branched KVStore that allow writes to be discarded and propagated to the
-// root KVStore using Write().
-func (s *Store) GetKVStore(_ string) store.KVStore {
-	if s.TracingEnabled() {
-		return tracekv.New(s.rootKVStore, s.traceWriter, s.traceContext)
-	}
-
-	return s.rootKVStore
+// GetKVStore returns the root KVStore for the given store key. Any writes to
+// this store without branching will be committed to SC and SS upon Commit().
+// Branching will create a branched KVStore that allow writes to be discarded
+// and propagated to the root KVStore using Write().
+//
+// It will panic if no store is mounted for the given store key.
+func (s *Store) GetKVStore(storeKey string) store.KVStore {
+	return s.GetBranchedKVStore(storeKey)
 }
 
-func (s *Store) GetBranchedKVStore(_ string) store.BranchedKVStore {
+// GetBranchedKVStore returns the root BranchedKVStore for the given store key.
+// It will panic if no store is mounted for the given store key.
+func (s *Store) GetBranchedKVStore(storeKey string) store.BranchedKVStore {
+	kvStore, ok := s.kvStores[storeKey]
+	if !ok {
+		panic(fmt.Sprintf("store does not exist for key: %s", storeKey))
+	}
+
 	if s.TracingEnabled() {
-		return tracekv.New(s.rootKVStore, s.traceWriter, s.traceContext)
+		return tracekv.New(kvStore, s.traceWriter, s.traceContext)
 	}
 
-	return s.rootKVStore
+	return kvStore
 }
 
 func (s *Store) loadVersion(v uint64, upgrades any) error {
 	s.logger.Debug("loading version", "version", v)
 
 	if err := s.stateCommitment.LoadVersion(v); err != nil {
-		return fmt.Errorf("failed to load SS version %d: %w", v, err)
+		return fmt.Errorf("failed to load SC version %d: %w", v, err)
 	}
 
 	// TODO: Complete this method to handle upgrades. See legacy RMS loadVersion()
@@ -192,6 +287,22 @@ func (s *Store) loadVersion(v uint64, upgrades any) error {
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/17314
 
+	for _, kvStore := range s.kvStores {
+		if err := kvStore.Reset(); err != nil {
+			return fmt.Errorf("failed to reset KVStore %s: %w", kvStore.GetStoreKey(), err)
+		}
+	}
+
+	s.workingHash = nil
+	s.lastCommitInfo = nil
+	if v > 0 {
+		// once loaded, the working hash of every tree reflects its committed hash
+		s.lastCommitInfo = &store.CommitInfo{
+			Version:    v,
+			StoreInfos: s.stateCommitment.WorkingStoreInfos(v),
+		}
+	}
+
 	return nil
 }
 
@@ -211,21 +322,29 @@ func (s *Store) SetCommitHeader(h store.CommitHeader) {
 	s.commitHeader = h
 }
 
-// Branch a copy of the Store with a branched underlying root KVStore. Any call
-// to GetKVStore and GetBranchedKVStore returns the branched KVStore.
+// Branch a copy of the Store with a branched underlying root KVStore for every
+// store key. Any call to GetKVStore and GetBranchedKVStore returns the branched
+// KVStore.
 func (s *Store) Branch() store.BranchedRootStore {
-	branch := s.rootKVStore.Branch()
+	kvStores := make(map[string]store.BranchedKVStore, len(s.kvStores))
+	for storeKey, kvStore := range s.kvStores {
+		kvStores[storeKey] = kvStore.Branch()
+	}
 
 	return &Store{
-		logger:          s.logger,
-		initialVersion:  s.initialVersion,
-		stateStore:      s.stateStore,
-		stateCommitment: s.stateCommitment,
-		rootKVStore:     branch,
-		commitHeader:    s.commitHeader,
-		lastCommitInfo:  s.lastCommitInfo,
-		traceWriter:     s.traceWriter,
-		traceContext:    s.traceContext,
+		logger:            s.logger,
+		initialVersion:    s.initialVersion,
+		stateStore:        s.stateStore,
+		stateCommitment:   s.stateCommitment,
+		kvStores:          kvStores,
+		commitHeader:      s.commitHeader,
+		lastCommitInfo:    s.lastCommitInfo,
+		traceWriter:       s.traceWriter,
+		traceContext:      s.traceContext,
+		pruningManager:    s.pruningManager,
+		snapshotInterval:  s.snapshotInterval,
+		checkpointManager: s.checkpointManager,
+		wal:               s.wal,
 	}
 }
 
@@ -235,7 +354,7 @@ func (s *Store) Branch() store.BranchedRootStore {
 //
 // If working hash is nil, then we need to compute and set it on the root store
 // by constructing a CommitInfo object, which in turn creates and writes a batch
-// of the current changeset to the SC tree.
+// of the current changeset to the SC trees.
 func (s *Store) WorkingHash() ([]byte, error) {
 	if s.workingHash == nil {
 		if err := s.writeSC(); err != nil {
@@ -249,17 +368,25 @@ func (s *Store) WorkingHash() ([]byte, error) {
 }
 
 func (s *Store) Write() {
-	s.rootKVStore.Write()
+	for _, storeKey := range s.storeKeys() {
+		s.kvStores[storeKey].Write()
+	}
 }
 
 // Commit commits all state changes to the underlying SS and SC backends. Note,
 // at the time of Commit(), we expect WorkingHash() to have already been called,
 // which internally sets the working hash, retrieved by writing a batch of the
-// changeset to the SC tree, and CommitInfo on the root store. The changeset is
-// retrieved from the rootKVStore and represents the entire set of writes to be
-// committed. The same changeset is used to flush writes to the SS backend.
+// changeset to the SC trees, and CommitInfo on the root store. The changeset is
+// retrieved from the root KVStores and represents the entire set of writes to
+// be committed. The same changeset is used to flush writes to the SS backend.
 //
-// Note, Commit() commits SC and SC synchronously.
+// Note, Commit() commits SS and SC synchronously, unless the SS backend flushes
+// writes asynchronously, e.g. when wrapped by the storage/async package, in
+// which case Commit() only waits for the changeset to be enqueued and reads of
+// the committed version are served from memory until it is flushed. Once
+// committed, SS and SC are pruned as configured by their respective pruning
+// options. If a WAL is set, the changeset is durably logged prior to committing
+// either backend.
 func (s *Store) Commit() ([]byte, error) {
 	if s.workingHash == nil {
 		return nil, fmt.Errorf("working hash is nil; must call WorkingHash() before Commit()")
@@ -271,7 +398,14 @@ func (s *Store) Commit() ([]byte, error) {
 		s.logger.Debug("commit header and version mismatch", "header_height", s.commitHeader.GetHeight(), "version", version)
 	}
 
-	changeset := s.rootKVStore.GetChangeset()
+	changeset := s.getChangeset()
+
+	// log the changeset prior to committing it to either backend
+	if s.wal != nil {
+		if err := s.wal.Write(version, changeset); err != nil {
+			return nil, fmt.Errorf("failed to write changeset to WAL: %w", err)
+		}
+	}
 
 	// commit SS
 	if err := s.stateStore.ApplyChangeset(version, changeset); err != nil {
@@ -287,23 +421,90 @@ func (s *Store) Commit() ([]byte, error) {
 		s.lastCommitInfo.Timestamp = s.commitHeader.GetTime()
 	}
 
-	if err := s.rootKVStore.Reset(); err != nil {
-		return nil, fmt.Errorf("failed to reset root KVStore: %w", err)
+	for _, storeKey := range s.storeKeys() {
+		if err := s.kvStores[storeKey].Reset(); err != nil {
+			return nil, fmt.Errorf("failed to reset KVStore %s: %w", storeKey, err)
+		}
 	}
 
 	s.workingHash = nil
 
+	if s.checkpointManager != nil {
+		if err := s.checkpoint(version, changeset); err != nil {
+			return nil, err
+		}
+	}
+
+	// retain the version until its state-sync snapshot is taken
+	if s.snapshotInterval > 0 && version%s.snapshotInterval == 0 {
+		s.pruningManager.PinVersion(version)
+	}
+
+	// prune SS and SC, which happens asynchronously unless configured otherwise
+	if err := s.pruningManager.Prune(version); err != nil {
+		return nil, fmt.Errorf("failed to prune: %w", err)
+	}
+
+	if s.wal != nil {
+		if err := s.truncateWAL(version); err != nil {
+			return nil, err
+		}
+	}
+
 	return s.lastCommitInfo.Hash(), nil
 }
 
-// writeSC gets the current changeset from the rootKVStore and writes that as a
-// batch to the underlying SC tree, which allows us to retrieve the working hash
-// of the SC tree. Finally, we construct a *CommitInfo and return the hash.
-// Note, this should only be called once per block!
-func (s *Store) writeSC() error {
-	changeSet := s.rootKVStore.GetChangeset()
+// checkpoint records the changeset committed at the given version and, if
+// applicable, writes a SC checkpoint in the background and prunes the prior
+// checkpoints. The version is pinned until the checkpoint is complete so it
+// cannot be pruned in the meantime.
+func (s *Store) checkpoint(version uint64, cs *store.Changeset) error {
+	if err := s.checkpointManager.Record(version, cs); err != nil {
+		return fmt.Errorf("failed to record changeset: %w", err)
+	}
+
+	if s.checkpointManager.ShouldCheckpoint(version) {
+		s.pruningManager.PinVersion(version)
+		s.checkpointManager.Checkpoint(version, s.stateCommitment, func() {
+			s.pruningManager.UnpinVersion(version)
+		})
+	}
+
+	if err := s.checkpointManager.Prune(version); err != nil {
+		return fmt.Errorf("failed to prune checkpoints: %w", err)
+	}
 
-	if err := s.stateCommitment.WriteBatch(changeSet); err != nil {
+	return nil
+}
+
+// storeKeys returns the store keys of all mounted stores, sorted lexicographically.
+func (s *Store) storeKeys() []string {
+	storeKeys := maps.Keys(s.kvStores)
+	slices.Sort(storeKeys)
+
+	return storeKeys
+}
+
+// getChangeset returns the combined changeset of all root KVStores, ordered by
+// store key and then by key.
+func (s *Store) getChangeset() *store.Changeset {
+	cs := store.NewChangeset()
+	for _, storeKey := range s.storeKeys() {
+		for _, kvPair := range s.kvStores[storeKey].GetChangeset().Pairs {
+			kvPair.StoreKey = storeKey
+			cs.AddKVPair(kvPair)
+		}
+	}
+
+	return cs
+}
+
+// writeSC gets the current changeset from the root KVStores and writes that as
+// a batch to the underlying SC trees, which allows us to retrieve the working
+// hash of each SC tree. Finally, we construct a *CommitInfo from the per-store
+// working hashes. Note, this should only be called once per block!
+func (s *Store) writeSC() error {
+	if err := s.stateCommitment.WriteBatch(s.getChangeset()); err != nil {
 		return fmt.Errorf("failed to write batch to SC store: %w", err)
 	}
 
@@ -323,41 +524,33 @@ func (s *Store) writeSC() error {
 		version = previousHeight + 1
 	}
 
-	workingHash := s.stateCommitment.WorkingHash()
-
 	s.lastCommitInfo = &store.CommitInfo{
-		Version: version,
-		StoreInfos: []store.StoreInfo{
-			{
-				Name: defaultStoreKey,
-				CommitID: store.CommitID{
-					Version: version,
-					Hash:    workingHash,
-				},
-			},
-		},
+		Version:    version,
+		StoreInfos: s.stateCommitment.WorkingStoreInfos(version),
 	}
 
 	return nil
 }
 
-// commitSC commits the SC store. At this point, a batch of the current changeset
-// should have already been written to the SC via WorkingHash(). This method
-// solely commits that batch. An error is returned if commit fails or if the
-// resulting commit hash is not equivalent to the working hash.
+// commitSC commits the SC trees. At this point, a batch of the current changeset
+// should have already been written to the SC trees via WorkingHash(). This method
+// solely commits that batch. An error is returned if commit fails or if any
+// resulting tree hash is not equivalent to its working hash.
 func (s *Store) commitSC() error {
-	commitBz, err := s.stateCommitment.Commit()
+	storeInfos, err := s.stateCommitment.Commit()
 	if err != nil {
 		return fmt.Errorf("failed to commit SC store: %w", err)
 	}
 
-	workingHash, err := s.WorkingHash()
-	if err != nil {
-		return fmt.Errorf("failed to get working hash: %w", err)
+	if len(storeInfos) != len(s.lastCommitInfo.StoreInfos) {
+		return fmt.Errorf("unexpected number of committed SC stores; got: %d, expected: %d", len(storeInfos), len(s.lastCommitInfo.StoreInfos))
 	}
 
-	if bytes.Equal(commitBz, workingHash) {
-		return fmt.Errorf("unexpected commit hash; got: %X, expected: %X", commitBz, workingHash)
+	for i, si := range storeInfos {
+		expected := s.lastCommitInfo.StoreInfos[i]
+		if si.Name != expected.Name || !bytes.Equal(si.GetHash(), expected.GetHash()) {
+			return fmt.Errorf("unexpected commit hash for store %s; got: %X, expected: %X", si.Name, si.GetHash(), expected.GetHash())
+		}
 	}
 
 	return nil
@@ -1,18 +1,32 @@
 package root
 
 import (
+	"bytes"
 	"fmt"
 	"io"
 	"testing"
 
 	dbm "github.com/cosmos/cosmos-db"
+	protoio "github.com/cosmos/gogoproto/io"
+	ics23 "github.com/cosmos/ics23/go"
 	"github.com/stretchr/testify/suite"
 
 	"cosmossdk.io/log"
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/checkpoint"
 	"cosmossdk.io/store/v2/commitment"
 	"cosmossdk.io/store/v2/commitment/iavl"
+	"cosmossdk.io/store/v2/pruning"
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
+	"cosmossdk.io/store/v2/storage/async"
 	"cosmossdk.io/store/v2/storage/sqlite"
+	"cosmossdk.io/store/v2/wal"
+)
+
+const (
+	testStoreKey  = "test"
+	testStoreKey2 = "test2"
+	testStoreKey3 = "test3"
 )
 
 type RootStoreTestSuite struct {
@@ -31,10 +45,13 @@ func (s *RootStoreTestSuite) SetupTest() {
 	ss, err := sqlite.New(s.T().TempDir())
 	s.Require().NoError(err)
 
-	tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
-	sc := commitment.NewDatabase(tree)
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		testStoreKey:  iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey2: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey3: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+	})
 
-	rs, err := New(noopLog, 1, ss, sc)
+	rs, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), pruning.NothingOptions())
 	s.Require().NoError(err)
 
 	rs.SetTracer(io.Discard)
@@ -51,31 +68,48 @@ func (s *RootStoreTestSuite) TearDownTest() {
 }
 
 func (s *RootStoreTestSuite) TestMountSCStore() {
-	s.Require().Error(s.rootStore.MountSCStore("", nil))
+	// mounting an existing store key should fail
+	s.Require().Error(s.rootStore.MountSCStore(testStoreKey, iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())))
+
+	// mounting a nil tree should fail
+	s.Require().Error(s.rootStore.MountSCStore("new", nil))
+	s.Require().Panics(func() { s.rootStore.GetKVStore("new") })
+
+	tree := iavl.NewIavlTree(dbm.NewMemDB(), log.NewNopLogger(), iavl.DefaultConfig())
+	s.Require().NoError(s.rootStore.MountSCStore("new", tree))
+	s.Require().Equal(tree, s.rootStore.GetSCStore("new"))
+	s.Require().NotNil(s.rootStore.GetKVStore("new"))
 }
 
 func (s *RootStoreTestSuite) TestGetSCStore() {
-	s.Require().Equal(s.rootStore.GetSCStore(""), s.rootStore.(*Store).stateCommitment)
+	tree := s.rootStore.GetSCStore(testStoreKey)
+	s.Require().NotNil(tree)
+	s.Require().Equal(s.rootStore.(*Store).stateCommitment.GetTree(testStoreKey), tree)
+	s.Require().NotEqual(tree, s.rootStore.GetSCStore(testStoreKey2))
+	s.Require().Nil(s.rootStore.GetSCStore("unknown"))
 }
 
 func (s *RootStoreTestSuite) TestGetKVStore() {
-	kvs := s.rootStore.GetKVStore("")
+	kvs := s.rootStore.GetKVStore(testStoreKey)
 	s.Require().NotNil(kvs)
+	s.Require().Equal(testStoreKey, kvs.GetStoreKey())
+
+	s.Require().Panics(func() { s.rootStore.GetKVStore("unknown") })
 }
 
 func (s *RootStoreTestSuite) TestGetBranchedKVStore() {
-	bs := s.rootStore.GetBranchedKVStore("")
+	bs := s.rootStore.GetBranchedKVStore(testStoreKey)
 	s.Require().NotNil(bs)
 	s.Require().Empty(bs.GetChangeset().Pairs)
 }
 
 func (s *RootStoreTestSuite) TestGetProof() {
-	p, err := s.rootStore.GetProof("", 1, []byte("foo"))
+	p, err := s.rootStore.GetProof(testStoreKey, 1, []byte("foo"))
 	s.Require().Error(err)
 	s.Require().Nil(p)
 
 	// write and commit a changeset
-	bs := s.rootStore.GetBranchedKVStore("")
+	bs := s.rootStore.GetBranchedKVStore(testStoreKey)
 	bs.Set([]byte("foo"), []byte("bar"))
 
 	workingHash, err := s.rootStore.WorkingHash()
@@ -88,7 +122,7 @@ func (s *RootStoreTestSuite) TestGetProof() {
 	s.Require().Equal(workingHash, commitHash)
 
 	// ensure the proof is non-nil for the corresponding version
-	p, err = s.rootStore.GetProof("", 1, []byte("foo"))
+	p, err = s.rootStore.GetProof(testStoreKey, 1, []byte("foo"))
 	s.Require().NoError(err)
 	s.Require().NotNil(p)
 	s.Require().Equal([]byte("foo"), p.GetExist().Key)
@@ -97,7 +131,7 @@ func (s *RootStoreTestSuite) TestGetProof() {
 
 func (s *RootStoreTestSuite) TestBranch() {
 	// write and commit a changeset
-	bs := s.rootStore.GetKVStore("")
+	bs := s.rootStore.GetKVStore(testStoreKey)
 	bs.Set([]byte("foo"), []byte("bar"))
 
 	workingHash, err := s.rootStore.WorkingHash()
@@ -113,7 +147,7 @@ func (s *RootStoreTestSuite) TestBranch() {
 	rs2 := s.rootStore.Branch()
 
 	// ensure we can perform reads which pass through to the original root store
-	bs2 := rs2.GetKVStore("")
+	bs2 := rs2.GetKVStore(testStoreKey)
 	s.Require().Equal([]byte("bar"), bs2.Get([]byte("foo")))
 
 	// make a change to the branched root store
@@ -131,7 +165,7 @@ func (s *RootStoreTestSuite) TestBranch() {
 
 func (s *RootStoreTestSuite) TestMultiBranch() {
 	// write and commit a changeset
-	bs := s.rootStore.GetKVStore("")
+	bs := s.rootStore.GetKVStore(testStoreKey)
 	bs.Set([]byte("foo"), []byte("bar"))
 
 	workingHash, err := s.rootStore.WorkingHash()
@@ -153,7 +187,7 @@ func (s *RootStoreTestSuite) TestMultiBranch() {
 	rs2 := branchedRootStores[4]
 
 	// ensure we can perform reads which pass through to the original root store
-	bs2 := rs2.GetKVStore("")
+	bs2 := rs2.GetKVStore(testStoreKey)
 	s.Require().Equal([]byte("bar"), bs2.Get([]byte("foo")))
 
 	// make a change to the branched root store
@@ -178,7 +212,7 @@ func (s *RootStoreTestSuite) TestCommit() {
 	rs2 := s.rootStore.Branch()
 
 	// perform changes
-	bs2 := rs2.GetKVStore("")
+	bs2 := rs2.GetKVStore(testStoreKey)
 	for i := 0; i < 100; i++ {
 		key := fmt.Sprintf("key%03d", i) // key000, key001, ..., key099
 		val := fmt.Sprintf("val%03d", i) // val000, val001, ..., val099
@@ -207,10 +241,10 @@ func (s *RootStoreTestSuite) TestCommit() {
 	s.Require().Equal(uint64(1), lv)
 
 	// ensure the root KVStore is cleared
-	s.Require().Empty(s.rootStore.(*Store).rootKVStore.GetChangeset().Pairs)
+	s.Require().Empty(s.rootStore.(*Store).getChangeset().Pairs)
 
 	// perform reads on the updated root store
-	bs := s.rootStore.GetKVStore("")
+	bs := s.rootStore.GetKVStore(testStoreKey)
 	for i := 0; i < 100; i++ {
 		key := fmt.Sprintf("key%03d", i) // key000, key001, ..., key099
 		val := fmt.Sprintf("val%03d", i) // val000, val001, ..., val099
@@ -218,3 +252,486 @@ func (s *RootStoreTestSuite) TestCommit() {
 		s.Require().Equal([]byte(val), bs.Get([]byte(key)))
 	}
 }
+
+func (s *RootStoreTestSuite) TestMultiStoreCommit() {
+	// write to every store key, using the same key with a different value
+	for _, storeKey := range []string{testStoreKey, testStoreKey2, testStoreKey3} {
+		bs := s.rootStore.GetKVStore(storeKey)
+		bs.Set([]byte("foo"), []byte(fmt.Sprintf("bar_%s", storeKey)))
+	}
+
+	wHash, err := s.rootStore.WorkingHash()
+	s.Require().NoError(err)
+
+	cHash, err := s.rootStore.Commit()
+	s.Require().NoError(err)
+	s.Require().Equal(wHash, cHash)
+
+	// ensure writes are isolated per store key
+	for _, storeKey := range []string{testStoreKey, testStoreKey2, testStoreKey3} {
+		bs := s.rootStore.GetKVStore(storeKey)
+		s.Require().Equal([]byte(fmt.Sprintf("bar_%s", storeKey)), bs.Get([]byte("foo")))
+	}
+
+	// ensure the commit info contains a store info per store key, sorted by name
+	commitInfo := s.rootStore.(*Store).lastCommitInfo
+	s.Require().Len(commitInfo.StoreInfos, 3)
+	s.Require().Equal(testStoreKey, commitInfo.StoreInfos[0].Name)
+	s.Require().Equal(testStoreKey2, commitInfo.StoreInfos[1].Name)
+	s.Require().Equal(testStoreKey3, commitInfo.StoreInfos[2].Name)
+
+	for _, si := range commitInfo.StoreInfos {
+		s.Require().Equal(uint64(1), si.CommitID.Version)
+		s.Require().Equal(s.rootStore.GetSCStore(si.Name).WorkingHash(), si.GetHash())
+
+		// ensure the key proof and the store proof chain up to the app hash
+		keyProof, err := s.rootStore.GetProof(si.Name, 1, []byte("foo"))
+		s.Require().NoError(err)
+
+		storeRoot, err := keyProof.Calculate()
+		s.Require().NoError(err)
+		s.Require().Equal(si.GetHash(), []byte(storeRoot))
+
+		storeProof, err := commitInfo.GetStoreProof(si.Name)
+		s.Require().NoError(err)
+		s.Require().True(ics23.VerifyMembership(ics23.TendermintSpec, cHash, storeProof, []byte(si.Name), si.GetHash()))
+	}
+
+	_, err = commitInfo.GetStoreProof("unknown")
+	s.Require().Error(err)
+}
+
+func (s *RootStoreTestSuite) TestLoadVersion() {
+	var hashes [][]byte
+	for v := 1; v <= 3; v++ {
+		bs := s.rootStore.GetKVStore(testStoreKey2)
+		bs.Set([]byte("foo"), []byte(fmt.Sprintf("val%03d", v)))
+
+		_, err := s.rootStore.WorkingHash()
+		s.Require().NoError(err)
+
+		cHash, err := s.rootStore.Commit()
+		s.Require().NoError(err)
+
+		hashes = append(hashes, cHash)
+	}
+
+	// load the latest version and ensure the commit info is restored
+	s.Require().NoError(s.rootStore.LoadLatestVersion())
+
+	lastCommitID, err := s.rootStore.(*Store).LastCommitID()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), lastCommitID.Version)
+	s.Require().Equal(hashes[2], lastCommitID.Hash)
+
+	// load an older version and ensure the commit info is restored
+	s.Require().NoError(s.rootStore.LoadVersion(2))
+
+	lastCommitID, err = s.rootStore.(*Store).LastCommitID()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), lastCommitID.Version)
+	s.Require().Equal(hashes[1], lastCommitID.Hash)
+}
+
+func (s *RootStoreTestSuite) TestPruneSC() {
+	noopLog := log.NewNopLogger()
+
+	ss, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		testStoreKey: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+	})
+
+	scOpts := pruning.EverythingOptions()
+	scOpts.Sync = true
+
+	rs, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), scOpts)
+	s.Require().NoError(err)
+	defer func() { s.Require().NoError(rs.Close()) }()
+
+	for v := 1; v <= 20; v++ {
+		bs := rs.GetKVStore(testStoreKey)
+		bs.Set([]byte("foo"), []byte(fmt.Sprintf("val%03d", v)))
+
+		_, err := rs.WorkingHash()
+		s.Require().NoError(err)
+
+		_, err = rs.Commit()
+		s.Require().NoError(err)
+	}
+
+	// versions up to and including 17 are pruned from SC
+	for v := uint64(1); v <= 17; v++ {
+		_, err := rs.GetProof(testStoreKey, v, []byte("foo"))
+		s.Require().Error(err, "version %d", v)
+	}
+
+	for v := uint64(18); v <= 20; v++ {
+		p, err := rs.GetProof(testStoreKey, v, []byte("foo"))
+		s.Require().NoError(err)
+		s.Require().Equal([]byte(fmt.Sprintf("val%03d", v)), p.GetExist().Value)
+	}
+
+	// SS is not pruned
+	bz, err := ss.Get(testStoreKey, 1, []byte("foo"))
+	s.Require().NoError(err)
+	s.Require().Equal([]byte("val001"), bz)
+}
+
+func (s *RootStoreTestSuite) TestHistoricalProofs() {
+	noopLog := log.NewNopLogger()
+
+	ss, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		testStoreKey: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+	})
+
+	scOpts := pruning.EverythingOptions()
+	scOpts.Sync = true
+
+	rs, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), scOpts)
+	s.Require().NoError(err)
+	defer func() { s.Require().NoError(rs.Close()) }()
+
+	cm, err := checkpoint.NewManager(noopLog, dbm.NewMemDB(), ss, 10, pruning.NothingOptions())
+	s.Require().NoError(err)
+	rs.(*Store).SetCheckpointManager(cm)
+
+	// record the proofs and store roots of every version prior to pruning
+	proofs := make(map[uint64]*ics23.CommitmentProof)
+	roots := make(map[uint64][]byte)
+	for v := uint64(1); v <= 30; v++ {
+		bs := rs.GetKVStore(testStoreKey)
+		bs.Set([]byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)))
+
+		// rewrite an unchanged value, which still modifies the tree
+		bs.Set([]byte("foo"), []byte("bar"))
+
+		if v%3 == 0 {
+			bs.Delete([]byte(fmt.Sprintf("key%03d", v-1)))
+		}
+
+		_, err := rs.WorkingHash()
+		s.Require().NoError(err)
+
+		_, err = rs.Commit()
+		s.Require().NoError(err)
+
+		// wait for any checkpoint so that the version is unpinned prior to
+		// pruning in the next commit
+		cm.Wait()
+
+		proofs[v], err = rs.GetProof(testStoreKey, v, []byte(fmt.Sprintf("key%03d", v-1)))
+		s.Require().NoError(err)
+		roots[v] = rs.GetSCStore(testStoreKey).WorkingHash()
+	}
+
+	s.Require().Equal([]uint64{10, 20, 30}, cm.Checkpoints())
+
+	// versions up to and including 27 are pruned from SC, so versions prior to
+	// the first checkpoint cannot be proven
+	for v := uint64(1); v < 10; v++ {
+		_, err := rs.GetProof(testStoreKey, v, []byte("foo"))
+		s.Require().Error(err, "version %d", v)
+	}
+
+	for v := uint64(10); v <= 30; v++ {
+		key := []byte(fmt.Sprintf("key%03d", v-1))
+
+		proof, err := rs.GetProof(testStoreKey, v, key)
+		s.Require().NoError(err, "version %d", v)
+		s.Require().Equal(proofs[v], proof, "version %d", v)
+
+		if v%3 == 0 {
+			s.Require().True(ics23.VerifyNonMembership(ics23.IavlSpec, roots[v], proof, key), "version %d", v)
+		} else {
+			s.Require().True(ics23.VerifyMembership(ics23.IavlSpec, roots[v], proof, key, []byte(fmt.Sprintf("val%03d", v-1))), "version %d", v)
+		}
+	}
+}
+
+func (s *RootStoreTestSuite) TestCommitAsyncSS() {
+	noopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+
+	ss, err := async.New(noopLog, sqliteDB, async.DefaultQueueSize)
+	s.Require().NoError(err)
+
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		testStoreKey: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+	})
+
+	rs, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), pruning.NothingOptions())
+	s.Require().NoError(err)
+
+	for v := 1; v <= 10; v++ {
+		bs := rs.GetKVStore(testStoreKey)
+		bs.Set([]byte(fmt.Sprintf("key%03d", v)), []byte(fmt.Sprintf("val%03d", v)))
+
+		_, err := rs.WorkingHash()
+		s.Require().NoError(err)
+
+		_, err = rs.Commit()
+		s.Require().NoError(err)
+
+		// all committed writes are readable, regardless of whether they've been
+		// flushed to SS
+		for i := 1; i <= v; i++ {
+			s.Require().Equal([]byte(fmt.Sprintf("val%03d", i)), bs.Get([]byte(fmt.Sprintf("key%03d", i))))
+		}
+	}
+
+	lv, err := rs.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), lv)
+
+	s.Require().NoError(ss.Flush())
+
+	ssVersion, err := sqliteDB.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), ssVersion)
+
+	s.Require().NoError(rs.Close())
+}
+
+func (s *RootStoreTestSuite) TestSnapshotRestore() {
+	storeKeys := []string{testStoreKey, testStoreKey2, testStoreKey3}
+
+	for v := 1; v <= 5; v++ {
+		for _, storeKey := range storeKeys {
+			bs := s.rootStore.GetKVStore(storeKey)
+			for i := 0; i < 10; i++ {
+				bs.Set([]byte(fmt.Sprintf("key%03d_%03d", v, i)), []byte(fmt.Sprintf("%s_val%03d_%03d", storeKey, v, i)))
+			}
+		}
+
+		_, err := s.rootStore.WorkingHash()
+		s.Require().NoError(err)
+
+		_, err = s.rootStore.Commit()
+		s.Require().NoError(err)
+	}
+
+	lastCommitID, err := s.rootStore.(*Store).LastCommitID()
+	s.Require().NoError(err)
+
+	snapshotter, ok := s.rootStore.(snapshottypes.Snapshotter)
+	s.Require().True(ok)
+
+	// snapshotting a future or zero height should fail
+	s.Require().Error(snapshotter.Snapshot(6, protoio.NewDelimitedWriter(io.Discard)))
+	s.Require().Error(snapshotter.Snapshot(0, protoio.NewDelimitedWriter(io.Discard)))
+
+	// write the snapshot followed by an extension item
+	buf := new(bytes.Buffer)
+	protoWriter := protoio.NewDelimitedWriter(buf)
+	s.Require().NoError(snapshotter.Snapshot(5, protoWriter))
+
+	extItem := snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Extension{
+			Extension: &snapshottypes.SnapshotExtensionMeta{Name: "test", Format: 1},
+		},
+	}
+	s.Require().NoError(protoWriter.WriteMsg(&extItem))
+
+	// restore into an empty root store
+	noopLog := log.NewNopLogger()
+
+	ss, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+
+	sc := commitment.NewDatabase(map[string]store.Tree{
+		testStoreKey:  iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey2: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+		testStoreKey3: iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig()),
+	})
+
+	target, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), pruning.NothingOptions())
+	s.Require().NoError(err)
+
+	targetSnapshotter := target.(snapshottypes.Snapshotter)
+	maxItemSize := int(64e6)
+
+	// restoring an unknown format should fail
+	_, err = targetSnapshotter.Restore(5, 0, protoio.NewDelimitedReader(bytes.NewReader(buf.Bytes()), maxItemSize))
+	s.Require().ErrorIs(err, snapshottypes.ErrUnknownFormat)
+
+	nextItem, err := targetSnapshotter.Restore(5, snapshottypes.CurrentFormat, protoio.NewDelimitedReader(buf, maxItemSize))
+	s.Require().NoError(err)
+	s.Require().Equal(extItem.GetExtension(), nextItem.GetExtension())
+
+	// the restored store should reflect the same app hash and state
+	restoredCommitID, err := target.(*Store).LastCommitID()
+	s.Require().NoError(err)
+	s.Require().Equal(lastCommitID, restoredCommitID)
+
+	ssVersion, err := ss.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), ssVersion)
+
+	for v := 1; v <= 5; v++ {
+		for _, storeKey := range storeKeys {
+			bs := target.GetKVStore(storeKey)
+			for i := 0; i < 10; i++ {
+				s.Require().Equal([]byte(fmt.Sprintf("%s_val%03d_%03d", storeKey, v, i)), bs.Get([]byte(fmt.Sprintf("key%03d_%03d", v, i))))
+			}
+		}
+	}
+
+	// the restored store should continue committing from the restored height
+	target.GetKVStore(testStoreKey).Set([]byte("key"), []byte("val"))
+
+	_, err = target.WorkingHash()
+	s.Require().NoError(err)
+
+	_, err = target.Commit()
+	s.Require().NoError(err)
+
+	lv, err := target.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), lv)
+
+	s.Require().NoError(target.Close())
+}
+
+func (s *RootStoreTestSuite) TestRecovery() {
+	noopLog := log.NewNopLogger()
+	ssDir, walDir := s.T().TempDir(), s.T().TempDir()
+	treeDBs := map[string]dbm.DB{
+		testStoreKey:  dbm.NewMemDB(),
+		testStoreKey2: dbm.NewMemDB(),
+	}
+
+	// open returns a root store, as if restarted, over the same SS, SC and WAL
+	open := func(withWAL bool) (*Store, *sqlite.Database, *commitment.Database) {
+		ss, err := sqlite.New(ssDir)
+		s.Require().NoError(err)
+
+		trees := make(map[string]store.Tree, len(treeDBs))
+		for storeKey, db := range treeDBs {
+			trees[storeKey] = iavl.NewIavlTree(db, noopLog, iavl.DefaultConfig())
+		}
+		sc := commitment.NewDatabase(trees)
+
+		rs, err := New(noopLog, 1, ss, sc, pruning.NothingOptions(), pruning.NothingOptions())
+		s.Require().NoError(err)
+
+		if withWAL {
+			w, err := wal.Open(walDir)
+			s.Require().NoError(err)
+			rs.(*Store).SetWAL(w)
+		}
+
+		return rs.(*Store), ss, sc
+	}
+
+	changeset := func(v uint64) *store.Changeset {
+		cs := store.NewChangeset()
+		for _, storeKey := range []string{testStoreKey, testStoreKey2} {
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey, Key: []byte(fmt.Sprintf("key%03d", v)), Value: []byte(fmt.Sprintf("%s_val%03d", storeKey, v))})
+		}
+
+		return cs
+	}
+
+	commit := func(rs *Store, v uint64) []byte {
+		for _, kvPair := range changeset(v).Pairs {
+			rs.GetKVStore(kvPair.StoreKey).Set(kvPair.Key, kvPair.Value)
+		}
+
+		_, err := rs.WorkingHash()
+		s.Require().NoError(err)
+
+		hash, err := rs.Commit()
+		s.Require().NoError(err)
+
+		return hash
+	}
+
+	rs, _, _ := open(true)
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	var hash []byte
+	for v := uint64(1); v <= 4; v++ {
+		hash = commit(rs, v)
+	}
+
+	// committed changesets are truncated from the WAL
+	s.Require().NoError(rs.Close())
+	w, err := wal.Open(walDir)
+	s.Require().NoError(err)
+	s.Require().Empty(w.Versions())
+
+	// crash after committing SS, but prior to committing SC
+	rs, _, sc := open(true)
+	s.Require().NoError(sc.LoadVersion(3))
+	s.Require().NoError(w.Write(4, changeset(4)))
+	s.Require().NoError(rs.Close())
+
+	rs, _, _ = open(true)
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	lastCommitID, err := rs.LastCommitID()
+	s.Require().NoError(err)
+	s.Require().Equal(store.CommitID{Version: 4, Hash: hash}, lastCommitID)
+
+	// crash after committing SC, but prior to committing SS
+	cs := changeset(5)
+	s.Require().NoError(w.Write(5, cs))
+	s.Require().NoError(rs.stateCommitment.WriteBatch(cs))
+	_, err = rs.stateCommitment.Commit()
+	s.Require().NoError(err)
+	s.Require().NoError(rs.Close())
+
+	rs, ss, _ := open(true)
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	lv, err := rs.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), lv)
+	s.Require().Equal([]byte(testStoreKey2+"_val005"), rs.GetKVStore(testStoreKey2).Get([]byte("key005")))
+
+	ssVersion, err := ss.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), ssVersion)
+
+	// the store continues committing from the recovered version
+	commit(rs, 6)
+	s.Require().NoError(rs.Close())
+
+	// without a WAL, SC is rolled back to SS
+	rs, _, _ = open(false)
+	cs = changeset(7)
+	s.Require().NoError(rs.stateCommitment.LoadVersion(0))
+	s.Require().NoError(rs.stateCommitment.WriteBatch(cs))
+	_, err = rs.stateCommitment.Commit()
+	s.Require().NoError(err)
+	s.Require().NoError(rs.LoadLatestVersion())
+
+	lv, err = rs.GetLatestVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(6), lv)
+	s.Require().Nil(rs.GetKVStore(testStoreKey).Get([]byte("key007")))
+	s.Require().NoError(rs.Close())
+
+	// a tree with no committed version, e.g. added by an upgrade, is neither
+	// considered skewed nor recovered
+	treeDBs[testStoreKey3] = dbm.NewMemDB()
+	rs, _, _ = open(false)
+	s.Require().NoError(rs.recover())
+	s.Require().Equal(uint64(6), rs.stateCommitment.GetTree(testStoreKey).GetLatestVersion())
+	s.Require().Zero(rs.stateCommitment.GetTree(testStoreKey3).GetLatestVersion())
+	s.Require().NoError(rs.Close())
+	delete(treeDBs, testStoreKey3)
+
+	// without a WAL, SS ahead of SC cannot be recovered
+	rs, ss, _ = open(false)
+	s.Require().NoError(ss.ApplyChangeset(7, changeset(7)))
+	s.Require().Error(rs.LoadLatestVersion())
+	s.Require().NoError(rs.Close())
+}
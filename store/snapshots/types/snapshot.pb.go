@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: cosmos/store/snapshots/v1/snapshot.proto
+// source: cosmos/store/snapshots/v2/snapshot.proto
 
 package types
 
@@ -36,7 +36,7 @@ func (m *Snapshot) Reset()         { *m = Snapshot{} }
 func (m *Snapshot) String() string { return proto.CompactTextString(m) }
 func (*Snapshot) ProtoMessage()    {}
 func (*Snapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{0}
+	return fileDescriptor_6851f1463fcbb80c, []int{0}
 }
 func (m *Snapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -109,7 +109,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{1}
+	return fileDescriptor_6851f1463fcbb80c, []int{1}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +152,6 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
@@ -164,7 +163,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{2}
+	return fileDescriptor_6851f1463fcbb80c, []int{2}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +272,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{3}
+	return fileDescriptor_6851f1463fcbb80c, []int{3}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +324,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{4}
+	return fileDescriptor_6851f1463fcbb80c, []int{4}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,7 +393,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{5}
+	return fileDescriptor_6851f1463fcbb80c, []int{5}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +447,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d5cca1aa5b69183, []int{6}
+	return fileDescriptor_6851f1463fcbb80c, []int{6}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -485,52 +484,52 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 }
 
 func init() {
-	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v1.Snapshot")
-	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v1.Metadata")
-	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v1.SnapshotItem")
-	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v1.SnapshotStoreItem")
-	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v1.SnapshotIAVLItem")
-	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionMeta")
-	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v1.SnapshotExtensionPayload")
+	proto.RegisterType((*Snapshot)(nil), "cosmos.store.snapshots.v2.Snapshot")
+	proto.RegisterType((*Metadata)(nil), "cosmos.store.snapshots.v2.Metadata")
+	proto.RegisterType((*SnapshotItem)(nil), "cosmos.store.snapshots.v2.SnapshotItem")
+	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.store.snapshots.v2.SnapshotStoreItem")
+	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.store.snapshots.v2.SnapshotIAVLItem")
+	proto.RegisterType((*SnapshotExtensionMeta)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionMeta")
+	proto.RegisterType((*SnapshotExtensionPayload)(nil), "cosmos.store.snapshots.v2.SnapshotExtensionPayload")
 }
 
 func init() {
-	proto.RegisterFile("cosmos/store/snapshots/v1/snapshot.proto", fileDescriptor_3d5cca1aa5b69183)
+	proto.RegisterFile("cosmos/store/snapshots/v2/snapshot.proto", fileDescriptor_6851f1463fcbb80c)
 }
 
-var fileDescriptor_3d5cca1aa5b69183 = []byte{
+var fileDescriptor_6851f1463fcbb80c = []byte{
 	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x8d, 0xd7, 0x74, 0x74, 0x37, 0x41, 0xea, 0xac, 0x81, 0x02, 0x0f, 0x59, 0x09, 0x42, 0x44,
+	0x02, 0x12, 0x94, 0xf1, 0x8e, 0x28, 0x4c, 0xca, 0x04, 0x48, 0x93, 0x27, 0xf1, 0xc0, 0xcb, 0xe4,
+	0xad, 0xa6, 0x89, 0xda, 0xc4, 0x55, 0xed, 0x45, 0xf4, 0x2f, 0xf8, 0x11, 0xfe, 0x63, 0x8f, 0x7b,
+	0xe4, 0x69, 0xa0, 0xf6, 0x47, 0x90, 0xed, 0x24, 0x4c, 0x63, 0x45, 0xe3, 0xed, 0x9e, 0x9b, 0x73,
+	0x8e, 0xaf, 0x4f, 0xae, 0x21, 0x3c, 0xe5, 0xa2, 0xe0, 0x22, 0x16, 0x92, 0xcf, 0x59, 0x2c, 0x4a,
+	0x3a, 0x13, 0x19, 0x97, 0x22, 0xae, 0x92, 0x16, 0x44, 0xb3, 0x39, 0x97, 0x1c, 0x3f, 0x30, 0xcc,
+	0x48, 0x33, 0xa3, 0x96, 0x19, 0x55, 0xc9, 0xc3, 0x9d, 0x31, 0x1f, 0x73, 0xcd, 0x8a, 0x55, 0x65,
+	0x04, 0xc1, 0x77, 0x04, 0xbd, 0xa3, 0x9a, 0x86, 0xef, 0xc3, 0x66, 0xc6, 0xf2, 0x71, 0x26, 0x3d,
+	0x34, 0x40, 0xa1, 0x4d, 0x6a, 0xa4, 0xfa, 0x5f, 0xf8, 0xbc, 0xa0, 0xd2, 0xdb, 0x18, 0xa0, 0xf0,
+	0x2e, 0xa9, 0x91, 0xea, 0x9f, 0x66, 0x67, 0xe5, 0x44, 0x78, 0x1d, 0xd3, 0x37, 0x08, 0x63, 0xb0,
+	0x33, 0x2a, 0x32, 0xcf, 0x1e, 0xa0, 0xd0, 0x25, 0xba, 0xc6, 0xfb, 0xd0, 0x2b, 0x98, 0xa4, 0x23,
+	0x2a, 0xa9, 0xd7, 0x1d, 0xa0, 0xd0, 0x49, 0x1e, 0x47, 0x6b, 0x87, 0x8d, 0x3e, 0xd6, 0xd4, 0xa1,
+	0x7d, 0x7e, 0xb9, 0x6b, 0x91, 0x56, 0x1a, 0xbc, 0x80, 0x5e, 0xf3, 0x0d, 0x3f, 0x02, 0x57, 0x1f,
+	0x78, 0xac, 0x0e, 0x60, 0xc2, 0x43, 0x83, 0x4e, 0xe8, 0x12, 0x47, 0xf7, 0x52, 0xdd, 0x0a, 0x7e,
+	0x6e, 0x80, 0xdb, 0x5c, 0xef, 0x40, 0xb2, 0x02, 0xbf, 0x83, 0xae, 0x3e, 0x4e, 0xdf, 0xd0, 0x49,
+	0x9e, 0xff, 0x63, 0x86, 0x46, 0x77, 0xa4, 0x3e, 0x29, 0x71, 0x6a, 0x11, 0x23, 0xc6, 0xef, 0xc1,
+	0xce, 0x69, 0x35, 0xd5, 0x71, 0x38, 0xc9, 0xb3, 0x5b, 0x98, 0x1c, 0xbc, 0xf9, 0xf4, 0x41, 0x79,
+	0x0c, 0x7b, 0xcb, 0xcb, 0x5d, 0x5b, 0xa1, 0xd4, 0x22, 0xda, 0x04, 0x1f, 0xc2, 0x16, 0xfb, 0x2a,
+	0x59, 0x29, 0x72, 0x5e, 0xea, 0x20, 0x9d, 0xe4, 0xe5, 0x2d, 0x1c, 0xf7, 0x1b, 0x8d, 0xca, 0x23,
+	0xb5, 0xc8, 0x1f, 0x13, 0x7c, 0x02, 0xdb, 0x2d, 0x38, 0x9e, 0xd1, 0xc5, 0x94, 0xd3, 0x91, 0xfe,
+	0x19, 0x4e, 0xb2, 0xf7, 0x3f, 0xce, 0x87, 0x46, 0x9a, 0x5a, 0xa4, 0xcf, 0xae, 0xf5, 0x86, 0x9b,
+	0x60, 0xe7, 0x92, 0x15, 0xc1, 0x53, 0xd8, 0xfe, 0x2b, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c, 0xc8,
+	0x5b, 0x44, 0xd7, 0xc1, 0x14, 0xfa, 0xd7, 0xc3, 0xc0, 0x7d, 0xe8, 0x4c, 0xd8, 0x42, 0xd3, 0x5c,
+	0xa2, 0x4a, 0xbc, 0x03, 0xdd, 0x8a, 0x4e, 0xcf, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f, 0xee,
+	0x54, 0x6c, 0xde, 0x06, 0xd4, 0x21, 0x0d, 0xbc, 0xb2, 0xb2, 0xea, 0x7e, 0xdd, 0x66, 0x65, 0x83,
+	0xb7, 0x70, 0xef, 0xc6, 0xa0, 0x6e, 0x1a, 0x6d, 0xdd, 0x7e, 0x07, 0xaf, 0xc0, 0x5b, 0x97, 0x89,
+	0x1a, 0xa9, 0x49, 0xd6, 0x8c, 0xdf, 0xc0, 0xe1, 0xeb, 0xf3, 0xa5, 0x8f, 0x2e, 0x96, 0x3e, 0xfa,
+	0xb5, 0xf4, 0xd1, 0xb7, 0x95, 0x6f, 0x5d, 0xac, 0x7c, 0xeb, 0xc7, 0xca, 0xb7, 0x3e, 0x3f, 0x31,
+	0xd9, 0x8b, 0xd1, 0x24, 0xca, 0x79, 0xfd, 0x9a, 0xaf, 0xbc, 0x61, 0x11, 0xcb, 0xc5, 0x8c, 0x89,
+	0x93, 0x4d, 0xfd, 0x34, 0xf7, 0x7e, 0x0f, 0x00, 0xb2, 0x45, 0xfa, 0x14, 0xf7, 0x03, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -0,0 +1,374 @@
+package async
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/branchkv"
+)
+
+// DefaultQueueSize defines the default number of changesets that may be pending
+// a flush to the underlying SS backend before ApplyChangeset blocks.
+const DefaultQueueSize = 8
+
+var _ store.VersionedDatabase = (*Database)(nil)
+
+// Database wraps a State Storage (SS) backend such that ApplyChangeset returns
+// as soon as the changeset is enqueued, while the changeset is flushed to the
+// underlying backend in a background goroutine. The queue is bounded, so
+// ApplyChangeset blocks when the underlying backend falls too far behind.
+//
+// Reads at a version that has not yet been flushed are served from the pending,
+// in-memory changesets on top of the underlying backend, so callers always
+// observe a consistent view of every applied version.
+type Database struct {
+	logger log.Logger
+	db     store.VersionedDatabase
+
+	queue chan *pendingChangeset
+	done  chan struct{}
+
+	// queueMtx guards sending to the queue against closing it
+	queueMtx sync.RWMutex
+	closed   bool
+
+	// mtx guards the fields below
+	mtx sync.RWMutex
+
+	// pending reflects the changesets not yet flushed, ordered by version
+	pending []*pendingChangeset
+
+	// latestVersion reflects the latest version applied, flushed or not
+	latestVersion uint64
+
+	// err reflects the first error encountered while flushing, after which no
+	// subsequent changeset is flushed and all subsequent writes fail
+	err error
+
+	// flushed is signaled whenever a pending changeset is flushed
+	flushed *sync.Cond
+}
+
+// pendingChangeset defines a changeset that is waiting to be flushed along with
+// an index of its writes by store key and key.
+type pendingChangeset struct {
+	version uint64
+	cs      *store.Changeset
+	writes  map[string]map[string]store.KVPair
+}
+
+func newPendingChangeset(version uint64, cs *store.Changeset) *pendingChangeset {
+	writes := make(map[string]map[string]store.KVPair)
+	for _, kvPair := range cs.Pairs {
+		storeWrites, ok := writes[kvPair.StoreKey]
+		if !ok {
+			storeWrites = make(map[string]store.KVPair)
+			writes[kvPair.StoreKey] = storeWrites
+		}
+
+		storeWrites[string(kvPair.Key)] = kvPair
+	}
+
+	return &pendingChangeset{
+		version: version,
+		cs:      cs,
+		writes:  writes,
+	}
+}
+
+// New returns a Database that asynchronously flushes changesets to the given SS
+// backend, allowing up to queueSize changesets to be pending at any time.
+func New(logger log.Logger, db store.VersionedDatabase, queueSize int) (*Database, error) {
+	if queueSize <= 0 {
+		return nil, fmt.Errorf("invalid queue size: %d", queueSize)
+	}
+
+	latestVersion, err := db.GetLatestVersion()
+	if err != nil {
+		return nil, err
+	}
+
+	asyncDB := &Database{
+		logger:        logger.With("module", "async_ss"),
+		db:            db,
+		queue:         make(chan *pendingChangeset, queueSize),
+		done:          make(chan struct{}),
+		latestVersion: latestVersion,
+	}
+	asyncDB.flushed = sync.NewCond(&asyncDB.mtx)
+
+	go asyncDB.flushLoop()
+
+	return asyncDB, nil
+}
+
+func (db *Database) flushLoop() {
+	defer close(db.done)
+
+	for pcs := range db.queue {
+		// flushing a changeset past a failed one would leave a version gap in
+		// the underlying backend, so the remaining changesets are only drained
+		db.mtx.RLock()
+		failed := db.err != nil
+		db.mtx.RUnlock()
+		if failed {
+			continue
+		}
+
+		err := db.db.ApplyChangeset(pcs.version, pcs.cs)
+
+		db.mtx.Lock()
+		if err != nil {
+			db.logger.Error("failed to flush changeset", "version", pcs.version, "err", err)
+			if db.err == nil {
+				db.err = fmt.Errorf("failed to flush changeset at version %d: %w", pcs.version, err)
+			}
+		} else {
+			// the changeset is now readable from the underlying backend
+			db.pending = slices.DeleteFunc(db.pending, func(p *pendingChangeset) bool { return p == pcs })
+		}
+
+		db.flushed.Broadcast()
+		db.mtx.Unlock()
+	}
+}
+
+// ApplyChangeset enqueues the changeset to be flushed to the underlying backend
+// at the given version. It blocks if the queue is full. An error is returned if
+// a previous flush failed or the database is closed.
+func (db *Database) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	pcs := newPendingChangeset(version, cs)
+
+	db.queueMtx.RLock()
+	defer db.queueMtx.RUnlock()
+
+	if db.closed {
+		return errors.New("cannot apply changeset: database is closed")
+	}
+
+	db.mtx.Lock()
+	if db.err != nil {
+		db.mtx.Unlock()
+		return db.err
+	}
+
+	// make the changeset readable prior to enqueueing it
+	db.pending = append(db.pending, pcs)
+	if version > db.latestVersion {
+		db.latestVersion = version
+	}
+	db.mtx.Unlock()
+
+	db.queue <- pcs
+	return nil
+}
+
+// Flush blocks until all pending changesets have been flushed to the underlying
+// backend, returning an error if any flush failed.
+func (db *Database) Flush() error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	for len(db.pending) > 0 && db.err == nil {
+		db.flushed.Wait()
+	}
+
+	return db.err
+}
+
+// waitFlushed blocks until all pending changesets up to and including the given
+// version have been flushed to the underlying backend.
+func (db *Database) waitFlushed(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	for len(db.pending) > 0 && db.pending[0].version <= version && db.err == nil {
+		db.flushed.Wait()
+	}
+
+	return db.err
+}
+
+// PendingVersions returns the versions that have been applied but not yet
+// flushed to the underlying backend, in ascending order.
+func (db *Database) PendingVersions() []uint64 {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	versions := make([]uint64, len(db.pending))
+	for i, pcs := range db.pending {
+		versions[i] = pcs.version
+	}
+
+	return versions
+}
+
+func (db *Database) GetLatestVersion() (uint64, error) {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	return db.latestVersion, nil
+}
+
+// SetLatestVersion flushes all pending changesets prior to setting the latest
+// version on the underlying backend.
+func (db *Database) SetLatestVersion(version uint64) error {
+	if err := db.Flush(); err != nil {
+		return err
+	}
+
+	if err := db.db.SetLatestVersion(version); err != nil {
+		return err
+	}
+
+	db.mtx.Lock()
+	db.latestVersion = version
+	db.mtx.Unlock()
+
+	return nil
+}
+
+func (db *Database) Has(storeKey string, version uint64, key []byte) (bool, error) {
+	val, err := db.Get(storeKey, version, key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func (db *Database) Get(storeKey string, version uint64, key []byte) ([]byte, error) {
+	db.mtx.RLock()
+	// walk the pending changesets from the latest to the earliest version
+	for i := len(db.pending) - 1; i >= 0; i-- {
+		pcs := db.pending[i]
+		if pcs.version > version {
+			continue
+		}
+
+		if kvPair, ok := pcs.writes[storeKey][string(key)]; ok {
+			db.mtx.RUnlock()
+			return slices.Clone(kvPair.Value), nil
+		}
+	}
+	db.mtx.RUnlock()
+
+	return db.db.Get(storeKey, version, key)
+}
+
+func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	writes := db.pendingWrites(storeKey, version)
+
+	parentItr, err := db.db.Iterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return branchkv.NewMergedIterator(parentItr, writes, start, end, false), nil
+}
+
+func (db *Database) ReverseIterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	writes := db.pendingWrites(storeKey, version)
+
+	parentItr, err := db.db.ReverseIterator(storeKey, version, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return branchkv.NewMergedIterator(parentItr, writes, start, end, true), nil
+}
+
+// History returns the history of the given key from the underlying backend,
+// merged with the changes of the pending changesets within the version range.
+func (db *Database) History(storeKey string, key []byte, fromVersion, toVersion uint64) ([]store.KeyChange, error) {
+	// collect pending changes prior to querying the underlying backend, so that
+	// a changeset flushed in between is reflected by either
+	db.mtx.RLock()
+	var pending []store.KeyChange
+	for _, pcs := range db.pending {
+		if pcs.version < fromVersion || pcs.version > toVersion {
+			continue
+		}
+
+		if kvPair, ok := pcs.writes[storeKey][string(key)]; ok {
+			pending = append(pending, store.KeyChange{
+				Version: pcs.version,
+				Value:   slices.Clone(kvPair.Value),
+				Deleted: kvPair.Value == nil,
+			})
+		}
+	}
+	db.mtx.RUnlock()
+
+	history, err := db.db.History(storeKey, key, fromVersion, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range pending {
+		i, found := slices.BinarySearchFunc(history, change.Version, func(c store.KeyChange, version uint64) int {
+			return cmp.Compare(c.Version, version)
+		})
+		if !found {
+			history = slices.Insert(history, i, change)
+		}
+	}
+
+	return history, nil
+}
+
+// pendingWrites returns the latest pending write of every key in the given
+// store key up to and including the given version.
+//
+// Note, the pending writes must be collected prior to opening the underlying
+// iterator, so that a changeset flushed in between is reflected by both, which
+// is consistent as both reflect the same state, rather than by neither.
+func (db *Database) pendingWrites(storeKey string, version uint64) map[string]store.KVPair {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	writes := make(map[string]store.KVPair)
+	for _, pcs := range db.pending {
+		if pcs.version > version {
+			break
+		}
+
+		for key, kvPair := range pcs.writes[storeKey] {
+			writes[key] = kvPair
+		}
+	}
+
+	return writes
+}
+
+// Prune delegates pruning to the underlying backend once all pending changesets
+// up to and including the given version have been flushed.
+func (db *Database) Prune(version uint64) error {
+	if err := db.waitFlushed(version); err != nil {
+		return err
+	}
+
+	return db.db.Prune(version)
+}
+
+// Close flushes all pending changesets and closes the underlying backend. Note,
+// Close() is NOT idempotent and should only be called once.
+func (db *Database) Close() error {
+	db.queueMtx.Lock()
+	db.closed = true
+	close(db.queue)
+	db.queueMtx.Unlock()
+
+	<-db.done
+
+	db.mtx.RLock()
+	flushErr := db.err
+	db.mtx.RUnlock()
+
+	return errors.Join(flushErr, db.db.Close())
+}
@@ -0,0 +1,198 @@
+package async
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/pebbledb"
+)
+
+const (
+	storeKey1 = "store1"
+)
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (store.VersionedDatabase, error) {
+			db, err := pebbledb.New(dir)
+			if err != nil {
+				return nil, err
+			}
+
+			return New(log.NewNopLogger(), db, DefaultQueueSize)
+		},
+		EmptyBatchSize: 12,
+		SkipTests: []string{
+			"TestStorageTestSuite/TestDatabase_Prune",
+		},
+	}
+	suite.Run(t, s)
+}
+
+// blockingDatabase wraps a VersionedDatabase and blocks ApplyChangeset until
+// unblocked.
+type blockingDatabase struct {
+	store.VersionedDatabase
+
+	unblock chan struct{}
+}
+
+func (db *blockingDatabase) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	<-db.unblock
+	return db.VersionedDatabase.ApplyChangeset(version, cs)
+}
+
+func TestDatabase_PendingReads(t *testing.T) {
+	ss, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+
+	underlying := &blockingDatabase{VersionedDatabase: ss, unblock: make(chan struct{})}
+	db, err := New(log.NewNopLogger(), underlying, 2)
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 2; v++ {
+		cs := new(store.Changeset)
+		for i := 0; i < 10; i++ {
+			key := fmt.Sprintf("key%03d", i)
+			val := fmt.Sprintf("val%03d-%03d", i, v)
+
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte(key), Value: []byte(val)})
+		}
+
+		// delete a key at version 2
+		if v == 2 {
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("key009")})
+		}
+
+		require.NoError(t, db.ApplyChangeset(v, cs))
+	}
+
+	// nothing has been flushed, yet reads are served from pending changesets
+	require.Equal(t, []uint64{1, 2}, db.PendingVersions())
+
+	latestVersion, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latestVersion)
+
+	ssVersion, err := ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Zero(t, ssVersion)
+
+	bz, err := db.Get(storeKey1, 1, []byte("key000"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("val000-001"), bz)
+
+	bz, err = db.Get(storeKey1, 2, []byte("key000"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("val000-002"), bz)
+
+	ok, err := db.Has(storeKey1, 1, []byte("key009"))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = db.Has(storeKey1, 2, []byte("key009"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	history, err := db.History(storeKey1, []byte("key009"), 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []store.KeyChange{
+		{Version: 1, Value: []byte("val009-001")},
+		{Version: 2, Deleted: true},
+	}, history)
+
+	checkIterator := func() {
+		itr, err := db.Iterator(storeKey1, 2, nil, nil)
+		require.NoError(t, err)
+
+		var count int
+		for ; itr.Valid(); itr.Next() {
+			require.Equal(t, []byte(fmt.Sprintf("key%03d", count)), itr.Key())
+			require.Equal(t, []byte(fmt.Sprintf("val%03d-%03d", count, 2)), itr.Value())
+			count++
+		}
+		require.Equal(t, 9, count)
+		require.NoError(t, itr.Error())
+		itr.Close()
+
+		itr, err = db.Iterator(storeKey1, 1, []byte("key005"), nil)
+		require.NoError(t, err)
+
+		count = 0
+		for ; itr.Valid(); itr.Next() {
+			require.Equal(t, []byte(fmt.Sprintf("key%03d", count+5)), itr.Key())
+			require.Equal(t, []byte(fmt.Sprintf("val%03d-%03d", count+5, 1)), itr.Value())
+			count++
+		}
+		require.Equal(t, 5, count)
+		itr.Close()
+	}
+	checkIterator()
+
+	// flush all pending changesets and ensure reads are consistent
+	close(underlying.unblock)
+	require.NoError(t, db.Flush())
+	require.Empty(t, db.PendingVersions())
+
+	ssVersion, err = ss.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ssVersion)
+
+	checkIterator()
+
+	require.NoError(t, db.Close())
+}
+
+// failingDatabase wraps a VersionedDatabase, records the versions of the
+// changesets applied and fails to apply the changeset at the given version.
+type failingDatabase struct {
+	store.VersionedDatabase
+
+	failVersion uint64
+	applied     []uint64
+}
+
+func (db *failingDatabase) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	if version == db.failVersion {
+		return errors.New("failed to apply changeset")
+	}
+
+	db.applied = append(db.applied, version)
+	return db.VersionedDatabase.ApplyChangeset(version, cs)
+}
+
+func TestDatabase_FlushError(t *testing.T) {
+	ss, err := pebbledb.New(t.TempDir())
+	require.NoError(t, err)
+
+	underlying := &failingDatabase{VersionedDatabase: ss, failVersion: 2}
+	db, err := New(log.NewNopLogger(), underlying, DefaultQueueSize)
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 3; v++ {
+		cs := new(store.Changeset)
+		cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte(fmt.Sprintf("val%03d", v))})
+
+		// the flush of version 2 may fail before version 3 is applied
+		if err := db.ApplyChangeset(v, cs); err != nil {
+			require.Equal(t, uint64(3), v)
+		}
+	}
+
+	require.Error(t, db.Flush())
+	require.Error(t, db.Close())
+
+	// no changeset is flushed past the failed one
+	require.Equal(t, []uint64{1}, underlying.applied)
+
+	cs := new(store.Changeset)
+	cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("val")})
+	require.Error(t, db.ApplyChangeset(4, cs), "applying a changeset once closed must not panic")
+}
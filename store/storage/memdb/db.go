@@ -0,0 +1,367 @@
+package memdb
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	"cosmossdk.io/store/v2"
+)
+
+// degree defines the approximate number of items and children per B-tree node.
+const degree = 32
+
+var _ store.VersionedDatabase = (*Database)(nil)
+
+// Database defines a pure-Go, in-memory, versioned database, i.e. a State Storage
+// (SS) backend, which requires neither disk nor cgo. It is primarily intended
+// for unit tests, simulations and light nodes.
+//
+// Every store key is backed by a B-tree of versioned entries, ordered by key and
+// then by version in descending order, where a deletion is reflected by a
+// tombstone entry at the version of the deletion.
+//
+// Optionally, the database may be backed by an append-only log file, see
+// NewWithLog, in which case every write is persisted to the log prior to being
+// applied and the log is replayed upon opening the database.
+type Database struct {
+	// mtx guards the fields below
+	mtx sync.RWMutex
+
+	stores        map[string]*btree.BTreeG[entry]
+	latestVersion uint64
+
+	// log reflects the optional append-only log, which is nil for a purely
+	// in-memory database
+	log *appendLog
+}
+
+// entry defines a single version of a key, where a nil value reflects a tombstone.
+type entry struct {
+	key     []byte
+	version uint64
+	value   []byte
+}
+
+func entryLess(a, b entry) bool {
+	if c := bytes.Compare(a.key, b.key); c != 0 {
+		return c < 0
+	}
+
+	// newer versions come first
+	return a.version > b.version
+}
+
+// New returns a new, purely in-memory, Database.
+func New() *Database {
+	return &Database{
+		stores: make(map[string]*btree.BTreeG[entry]),
+	}
+}
+
+// NewWithLog returns a new Database backed by an append-only log file in the
+// given directory. If the log exists, it is replayed to restore the state of the
+// database, where a torn or corrupted trailing record is discarded. The log is
+// compacted upon Prune.
+func NewWithLog(dataDir string) (*Database, error) {
+	db := New()
+
+	log, err := openAppendLog(dataDir, db.replay)
+	if err != nil {
+		return nil, err
+	}
+
+	db.log = log
+	return db, nil
+}
+
+// replay applies a record of the append log to the in-memory state.
+func (db *Database) replay(r record) error {
+	switch r.typ {
+	case recordChangeset:
+		db.applyChangeset(r.version, r.cs)
+
+	case recordLatestVersion:
+		db.latestVersion = r.version
+
+	default:
+		return fmt.Errorf("unknown record type: %d", r.typ)
+	}
+
+	return nil
+}
+
+func (db *Database) Close() error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if db.stores == nil {
+		panic(store.ErrClosed)
+	}
+
+	db.stores = nil
+
+	if db.log != nil {
+		err := db.log.Close()
+		db.log = nil
+		return err
+	}
+
+	return nil
+}
+
+func (db *Database) GetLatestVersion() (uint64, error) {
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	return db.latestVersion, nil
+}
+
+func (db *Database) SetLatestVersion(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if db.log != nil {
+		if err := db.log.Append(record{typ: recordLatestVersion, version: version}); err != nil {
+			return err
+		}
+	}
+
+	db.latestVersion = version
+	return nil
+}
+
+func (db *Database) Has(storeKey string, version uint64, key []byte) (bool, error) {
+	val, err := db.Get(storeKey, version, key)
+	if err != nil {
+		return false, err
+	}
+
+	return val != nil, nil
+}
+
+func (db *Database) Get(storeKey string, version uint64, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, store.ErrKeyEmpty
+	}
+
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	tree, ok := db.stores[storeKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var value []byte
+
+	// the first entry at or after (key, version) reflects the latest version of
+	// the key at or prior to the given version, if any
+	tree.Ascend(entry{key: key, version: version}, func(e entry) bool {
+		if bytes.Equal(e.key, key) {
+			value = slices.Clone(e.value)
+		}
+
+		return false
+	})
+
+	return value, nil
+}
+
+func (db *Database) History(storeKey string, key []byte, fromVersion, toVersion uint64) ([]store.KeyChange, error) {
+	if len(key) == 0 {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if fromVersion > toVersion {
+		return nil, fmt.Errorf("%w: from version %d is greater than to version %d", store.ErrInvalidVersion, fromVersion, toVersion)
+	}
+
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	tree, ok := db.stores[storeKey]
+	if !ok {
+		return nil, nil
+	}
+
+	// entries of the key are ordered from the newest to the oldest version
+	var history []store.KeyChange
+	tree.Ascend(entry{key: key, version: toVersion}, func(e entry) bool {
+		if !bytes.Equal(e.key, key) || e.version < fromVersion {
+			return false
+		}
+
+		history = append(history, store.KeyChange{
+			Version: e.version,
+			Value:   slices.Clone(e.value),
+			Deleted: e.value == nil,
+		})
+
+		return true
+	})
+
+	slices.Reverse(history)
+
+	return history, nil
+}
+
+func (db *Database) ApplyChangeset(version uint64, cs *store.Changeset) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if db.log != nil {
+		if err := db.log.Append(record{typ: recordChangeset, version: version, cs: cs}); err != nil {
+			return err
+		}
+	}
+
+	db.applyChangeset(version, cs)
+	return nil
+}
+
+func (db *Database) applyChangeset(version uint64, cs *store.Changeset) {
+	for _, kvPair := range cs.Pairs {
+		tree, ok := db.stores[kvPair.StoreKey]
+		if !ok {
+			tree = btree.NewBTreeGOptions(entryLess, btree.Options{Degree: degree})
+			db.stores[kvPair.StoreKey] = tree
+		}
+
+		e := entry{key: slices.Clone(kvPair.Key), version: version}
+		if kvPair.Value != nil {
+			// ensure an empty value is not mistaken for a tombstone
+			e.value = append([]byte{}, kvPair.Value...)
+		}
+
+		tree.Set(e)
+	}
+
+	db.latestVersion = version
+}
+
+// Prune removes all versions up to and including the given version. For every
+// key, only the latest entry at or below the given version is kept, unless it is
+// a tombstone, such that reads at later versions are unaffected. If backed by an
+// append log, the log is compacted to only reflect the remaining versions.
+func (db *Database) Prune(version uint64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	db.prune(version)
+
+	if db.log != nil {
+		return db.log.Compact(db.records())
+	}
+
+	return nil
+}
+
+func (db *Database) prune(version uint64) {
+	for storeKey, tree := range db.stores {
+		// entries of a key are ordered from the newest to the oldest version, so
+		// the first one at or below version is retained
+		var (
+			pruned  []entry
+			prevKey []byte
+		)
+		tree.Scan(func(e entry) bool {
+			if e.version > version {
+				return true
+			}
+
+			// the first entry of a key at or below version is retained, unless it
+			// is a tombstone
+			if !bytes.Equal(e.key, prevKey) {
+				prevKey = e.key
+				if e.value != nil {
+					return true
+				}
+			}
+
+			pruned = append(pruned, e)
+			return true
+		})
+
+		for _, e := range pruned {
+			tree.Delete(e)
+		}
+
+		if tree.Len() == 0 {
+			delete(db.stores, storeKey)
+		}
+	}
+}
+
+// records returns the records which reflect the current state of the database,
+// i.e. a changeset per remaining version followed by the latest version.
+func (db *Database) records() []record {
+	changesets := make(map[uint64]*store.Changeset)
+	for storeKey, tree := range db.stores {
+		tree.Scan(func(e entry) bool {
+			cs, ok := changesets[e.version]
+			if !ok {
+				cs = store.NewChangeset()
+				changesets[e.version] = cs
+			}
+
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey, Key: e.key, Value: e.value})
+			return true
+		})
+	}
+
+	records := make([]record, 0, len(changesets)+1)
+	for version, cs := range changesets {
+		records = append(records, record{typ: recordChangeset, version: version, cs: cs})
+	}
+	slices.SortFunc(records, func(a, b record) int { return cmp.Compare(a.version, b.version) })
+
+	return append(records, record{typ: recordLatestVersion, version: db.latestVersion})
+}
+
+func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	return db.newIterator(storeKey, version, start, end, false)
+}
+
+func (db *Database) ReverseIterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
+	return db.newIterator(storeKey, version, start, end, true)
+}
+
+func (db *Database) newIterator(storeKey string, version uint64, start, end []byte, reverse bool) (store.Iterator, error) {
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		return nil, store.ErrStartAfterEnd
+	}
+
+	db.mtx.RLock()
+	defer db.mtx.RUnlock()
+
+	// iterate over a copy-on-write snapshot of the tree, which is unaffected by
+	// subsequent writes
+	tree, ok := db.stores[storeKey]
+	if ok {
+		tree = tree.Copy()
+	} else {
+		tree = btree.NewBTreeGOptions(entryLess, btree.Options{Degree: degree})
+	}
+
+	return newIterator(tree, version, start, end, reverse), nil
+}
+
+// nextKey returns the smallest key that is greater than the given key.
+func nextKey(key []byte) []byte {
+	return append(slices.Clone(key), 0)
+}
+
+// maxVersionEntry returns the first entry of the given key in the tree order.
+func maxVersionEntry(key []byte) entry {
+	return entry{key: key, version: math.MaxUint64}
+}
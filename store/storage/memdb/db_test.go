@@ -0,0 +1,183 @@
+package memdb
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage"
+)
+
+const (
+	storeKey1 = "store1"
+)
+
+func TestStorageTestSuite(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (store.VersionedDatabase, error) {
+			return New(), nil
+		},
+		EmptyBatchSize: 0,
+	}
+	suite.Run(t, s)
+}
+
+func TestStorageTestSuite_Log(t *testing.T) {
+	s := &storage.StorageTestSuite{
+		NewDB: func(dir string) (store.VersionedDatabase, error) {
+			return NewWithLog(dir)
+		},
+		EmptyBatchSize: 0,
+	}
+	suite.Run(t, s)
+}
+
+func TestDatabase_ReverseIterator(t *testing.T) {
+	db := New()
+	defer db.Close()
+
+	for v := uint64(1); v <= 10; v++ {
+		cs := new(store.Changeset)
+		for i := 0; i < 10; i++ {
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte(fmt.Sprintf("key%03d", i)), Value: []byte(fmt.Sprintf("val%03d-%03d", i, v))})
+		}
+
+		// delete odd keys at version 5
+		if v == 5 {
+			cs = new(store.Changeset)
+			for i := 1; i < 10; i += 2 {
+				cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte(fmt.Sprintf("key%03d", i))})
+			}
+		}
+
+		require.NoError(t, db.ApplyChangeset(v, cs))
+	}
+
+	// iterate over version 5 in reverse, where only even keys are present
+	itr, err := db.ReverseIterator(storeKey1, 5, []byte("key002"), []byte("key009"))
+	require.NoError(t, err)
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
+		require.Equal(t, []byte(fmt.Sprintf("val%s-004", itr.Key()[3:])), itr.Value())
+	}
+	require.Equal(t, []string{"key008", "key006", "key004", "key002"}, keys)
+	require.NoError(t, itr.Error())
+	itr.Close()
+
+	// iterate over the entire domain in reverse at version 10
+	itr, err = db.ReverseIterator(storeKey1, 10, nil, nil)
+	require.NoError(t, err)
+
+	i := 9
+	for ; itr.Valid(); itr.Next() {
+		require.Equal(t, []byte(fmt.Sprintf("key%03d", i)), itr.Key())
+		require.Equal(t, []byte(fmt.Sprintf("val%03d-010", i)), itr.Value())
+		i--
+	}
+	require.Equal(t, -1, i)
+	itr.Close()
+
+	// iterate prior to any write
+	itr, err = db.ReverseIterator(storeKey1, 0, nil, nil)
+	require.NoError(t, err)
+	require.False(t, itr.Valid())
+	itr.Close()
+}
+
+func TestDatabase_IteratorIsolation(t *testing.T) {
+	db := New()
+	defer db.Close()
+
+	require.NoError(t, db.ApplyChangeset(1, store.NewChangeset(
+		store.KVPair{StoreKey: storeKey1, Key: []byte("key001"), Value: []byte("value001")},
+	)))
+
+	itr, err := db.Iterator(storeKey1, 2, nil, nil)
+	require.NoError(t, err)
+	defer itr.Close()
+
+	// writes after the iterator is created are not observed
+	require.NoError(t, db.ApplyChangeset(2, store.NewChangeset(
+		store.KVPair{StoreKey: storeKey1, Key: []byte("key000"), Value: []byte("value002")},
+		store.KVPair{StoreKey: storeKey1, Key: []byte("key001")},
+	)))
+
+	require.True(t, itr.Valid())
+	require.Equal(t, []byte("key001"), itr.Key())
+	require.False(t, itr.Next())
+}
+
+func TestDatabase_LogReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := NewWithLog(dir)
+	require.NoError(t, err)
+
+	for v := uint64(1); v <= 20; v++ {
+		require.NoError(t, db.ApplyChangeset(v, store.NewChangeset(
+			store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte(fmt.Sprintf("value%03d", v))},
+			store.KVPair{StoreKey: storeKey1, Key: []byte(fmt.Sprintf("key%03d", v)), Value: []byte{}},
+			store.KVPair{StoreKey: storeKey1, Key: []byte(fmt.Sprintf("key%03d", v-1))},
+		)))
+	}
+
+	require.NoError(t, db.Prune(10))
+	require.NoError(t, db.SetLatestVersion(25))
+	require.NoError(t, db.Close())
+
+	// simulate a torn write at the end of the log
+	f, err := os.OpenFile(filepath.Join(dir, logName), os.O_APPEND|os.O_WRONLY, 0o644)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 42, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	db, err = NewWithLog(dir)
+	require.NoError(t, err)
+
+	lv, err := db.GetLatestVersion()
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), lv)
+
+	for v := uint64(1); v <= 20; v++ {
+		// only the latest entry of a key at or below the pruned version is
+		// retained, so reads prior to it are unspecified
+		if v < 10 {
+			continue
+		}
+
+		bz, err := db.Get(storeKey1, v, []byte("key"))
+		require.NoError(t, err)
+		require.Equal(t, []byte(fmt.Sprintf("value%03d", v)), bz)
+
+		// empty values are distinguished from deletions
+		bz, err = db.Get(storeKey1, v, []byte(fmt.Sprintf("key%03d", v)))
+		require.NoError(t, err)
+		require.Equal(t, []byte{}, bz)
+
+		ok, err := db.Has(storeKey1, v, []byte(fmt.Sprintf("key%03d", v-1)))
+		require.NoError(t, err)
+		require.False(t, ok)
+	}
+
+	// the torn write is truncated, so writes are appended to the valid log
+	require.NoError(t, db.ApplyChangeset(26, store.NewChangeset(
+		store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value026")},
+	)))
+	require.NoError(t, db.Close())
+
+	db, err = NewWithLog(dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	bz, err := db.Get(storeKey1, 26, []byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value026"), bz)
+}
@@ -0,0 +1,170 @@
+package memdb
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/tidwall/btree"
+
+	"cosmossdk.io/store/v2"
+)
+
+var _ store.Iterator = (*iterator)(nil)
+
+// iterator defines an iterator over the latest version of every key, at or prior
+// to the target version, that is not deleted.
+type iterator struct {
+	tree    *btree.BTreeG[entry]
+	treeItr btree.IterG[entry]
+	version uint64
+	start   []byte
+	end     []byte
+	reverse bool
+	valid   bool
+	closed  bool
+}
+
+func newIterator(tree *btree.BTreeG[entry], version uint64, start, end []byte, reverse bool) *iterator {
+	itr := &iterator{
+		tree:    tree,
+		treeItr: tree.Iter(),
+		version: version,
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+
+	if reverse {
+		if end != nil && itr.treeItr.Seek(maxVersionEntry(end)) {
+			itr.valid = itr.treeItr.Prev() // end is exclusive
+		} else {
+			itr.valid = itr.treeItr.Last()
+		}
+
+		itr.findPrev()
+	} else {
+		if start != nil {
+			itr.valid = itr.treeItr.Seek(maxVersionEntry(start))
+		} else {
+			itr.valid = itr.treeItr.First()
+		}
+
+		itr.findNext()
+	}
+
+	return itr
+}
+
+// findNext moves the iterator, positioned at any entry, forward to the visible
+// version of the first key at or after the key of that entry.
+func (itr *iterator) findNext() {
+	for itr.valid && itr.keyInRange(itr.treeItr.Item().key) {
+		key := itr.treeItr.Item().key
+
+		// position at the latest version of the key at or prior to the target
+		// version, otherwise at the first entry of the next key
+		itr.valid = itr.treeItr.Seek(entry{key: key, version: itr.version})
+		if !itr.valid || !bytes.Equal(itr.treeItr.Item().key, key) {
+			continue
+		}
+
+		if itr.treeItr.Item().value != nil {
+			return
+		}
+
+		// the key is deleted, so move to the next key
+		itr.valid = itr.treeItr.Seek(maxVersionEntry(nextKey(key)))
+	}
+
+	itr.valid = false
+}
+
+// findPrev moves the iterator, positioned at any entry, backward to the visible
+// version of the first key at or prior to the key of that entry.
+func (itr *iterator) findPrev() {
+	for itr.valid && itr.keyInRange(itr.treeItr.Item().key) {
+		key := itr.treeItr.Item().key
+
+		if itr.treeItr.Seek(entry{key: key, version: itr.version}) &&
+			bytes.Equal(itr.treeItr.Item().key, key) &&
+			itr.treeItr.Item().value != nil {
+			return
+		}
+
+		// the key is either deleted or not yet written, so move to the last entry
+		// of the previous key
+		itr.treeItr.Seek(maxVersionEntry(key))
+		itr.valid = itr.treeItr.Prev()
+	}
+
+	itr.valid = false
+}
+
+// Domain returns the domain of the iterator. The caller must not modify the
+// return values.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *iterator) Key() []byte {
+	if !itr.valid {
+		return nil
+	}
+
+	return slices.Clone(itr.treeItr.Item().key)
+}
+
+func (itr *iterator) Value() []byte {
+	if !itr.valid {
+		return nil
+	}
+
+	return slices.Clone(itr.treeItr.Item().value)
+}
+
+func (itr *iterator) Next() bool {
+	if !itr.valid {
+		return false
+	}
+
+	key := itr.treeItr.Item().key
+	if itr.reverse {
+		itr.treeItr.Seek(maxVersionEntry(key))
+		itr.valid = itr.treeItr.Prev()
+		itr.findPrev()
+	} else {
+		itr.valid = itr.treeItr.Seek(maxVersionEntry(nextKey(key)))
+		itr.findNext()
+	}
+
+	return itr.valid
+}
+
+func (itr *iterator) Close() {
+	if itr.closed {
+		panic("iterator already closed")
+	}
+
+	itr.treeItr.Release()
+	itr.tree = nil
+	itr.valid = false
+	itr.closed = true
+}
+
+func (itr *iterator) Error() error {
+	return nil
+}
+
+func (itr *iterator) keyInRange(key []byte) bool {
+	if !itr.reverse && itr.end != nil && bytes.Compare(key, itr.end) >= 0 {
+		return false
+	}
+	if itr.reverse && itr.start != nil && bytes.Compare(key, itr.start) < 0 {
+		return false
+	}
+	return true
+}
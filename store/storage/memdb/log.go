@@ -0,0 +1,194 @@
+package memdb
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+const (
+	logName = "ss.log"
+
+	// recordHeaderSize defines the size of a record header, i.e. the payload
+	// length followed by the payload's CRC-32 checksum.
+	recordHeaderSize = 8
+)
+
+// recordType defines the type of an append log record.
+type recordType byte
+
+const (
+	recordChangeset recordType = iota + 1
+	recordLatestVersion
+)
+
+// record defines a single write to the database.
+type record struct {
+	typ     recordType
+	version uint64
+	cs      *store.Changeset
+}
+
+// appendLog defines an append-only log of records, where every record is framed
+// by its length and CRC-32 checksum such that a torn trailing write is detected.
+type appendLog struct {
+	path string
+	file *os.File
+}
+
+// openAppendLog opens, or creates, the append log in the given directory and
+// replays all of its records via fn. A torn or corrupted trailing record is
+// truncated from the log.
+func openAppendLog(dataDir string, fn func(record) error) (*appendLog, error) {
+	if err := os.MkdirAll(dataDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	path := filepath.Join(dataDir, logName)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open append log: %w", err)
+	}
+
+	offset, err := replayLog(file, fn)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	// discard anything past the last valid record
+	if err := file.Truncate(offset); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to truncate append log: %w", err)
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to seek append log: %w", err)
+	}
+
+	return &appendLog{path: path, file: file}, nil
+}
+
+// replayLog replays every valid record of the log, returning the offset right
+// after the last valid record.
+func replayLog(r io.Reader, fn func(record) error) (int64, error) {
+	var (
+		br     = bufio.NewReader(r)
+		header [recordHeaderSize]byte
+		offset int64
+	)
+
+	for {
+		if _, err := io.ReadFull(br, header[:]); err != nil {
+			// a clean or torn end of the log
+			return offset, nil
+		}
+
+		size := binary.BigEndian.Uint32(header[:4])
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(br, payload); err != nil {
+			return offset, nil
+		}
+
+		if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:]) {
+			return offset, nil
+		}
+
+		rec, err := decodeRecord(payload)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode record at offset %d: %w", offset, err)
+		}
+
+		if err := fn(rec); err != nil {
+			return 0, fmt.Errorf("failed to replay record at offset %d: %w", offset, err)
+		}
+
+		offset += recordHeaderSize + int64(size)
+	}
+}
+
+// Append durably appends the record to the log.
+func (l *appendLog) Append(r record) error {
+	if _, err := l.file.Write(encodeRecord(r)); err != nil {
+		return fmt.Errorf("failed to write to append log: %w", err)
+	}
+
+	return l.file.Sync()
+}
+
+// Compact atomically replaces the log with the given records.
+func (l *appendLog) Compact(records []record) error {
+	tmpPath := l.path + ".tmp"
+
+	tmp, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create compacted append log: %w", err)
+	}
+
+	w := bufio.NewWriter(tmp)
+	for _, r := range records {
+		if _, err := w.Write(encodeRecord(r)); err != nil {
+			_ = tmp.Close()
+			return fmt.Errorf("failed to write compacted append log: %w", err)
+		}
+	}
+
+	if err := errors.Join(w.Flush(), tmp.Sync()); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to flush compacted append log: %w", err)
+	}
+
+	if err := os.Rename(tmpPath, l.path); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to replace append log: %w", err)
+	}
+
+	// the compacted log is positioned at its end, so subsequent records are
+	// appended to it
+	err = l.file.Close()
+	l.file = tmp
+
+	return err
+}
+
+func (l *appendLog) Close() error {
+	return l.file.Close()
+}
+
+func encodeRecord(r record) []byte {
+	payload := []byte{byte(r.typ)}
+	payload = binary.AppendUvarint(payload, r.version)
+
+	if r.typ == recordChangeset {
+		payload = encoding.AppendChangeset(payload, r.cs)
+	}
+
+	bz := make([]byte, recordHeaderSize, recordHeaderSize+len(payload))
+	binary.BigEndian.PutUint32(bz[:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(bz[4:], crc32.ChecksumIEEE(payload))
+
+	return append(bz, payload...)
+}
+
+func decodeRecord(payload []byte) (record, error) {
+	d := encoding.NewDecoder(payload)
+
+	r := record{
+		typ:     recordType(d.Byte()),
+		version: d.Uvarint(),
+	}
+
+	if r.typ == recordChangeset {
+		r.cs = d.Changeset()
+	}
+
+	return r, d.Err()
+}
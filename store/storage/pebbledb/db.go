@@ -17,8 +17,13 @@ const (
 	VersionSize = 8
 
 	StorePrefixTpl   = "s/k:%s/"   // s/k:<storeKey>
+	storePrefixBase  = "s/k:"      // common prefix of every store prefix
 	latestVersionKey = "s/_latest" // NB: latestVersionKey key must be lexically smaller than StorePrefixTpl
 	tombstoneVal     = "TOMBSTONE"
+
+	// pruneBatchSize is the size in bytes above which the deletions of pruned
+	// entries are committed while pruning
+	pruneBatchSize = 4 << 20
 )
 
 var (
@@ -152,13 +157,158 @@ func (db *Database) ApplyChangeset(version uint64, cs *store.Changeset) error {
 	return b.Write()
 }
 
-// Prune for the PebbleDB SS backend is currently not supported. It seems the only
-// reliable way to prune is to iterate over the desired domain and either manually
-// tombstone or delete. Either way, the operation would be timely.
+func (db *Database) History(storeKey string, key []byte, fromVersion, toVersion uint64) (_ []store.KeyChange, err error) {
+	if len(key) == 0 {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if fromVersion > toVersion {
+		return nil, fmt.Errorf("%w: from version %d is greater than to version %d", store.ErrInvalidVersion, fromVersion, toVersion)
+	}
+
+	prefixedKey := prependStoreKey(storeKey, key)
+
+	// end domain is exclusive, so we need to increment the version by 1
+	var upperBound []byte
+	if toVersion < math.MaxUint64 {
+		upperBound = MVCCEncode(prefixedKey, toVersion+1)
+	} else {
+		upperBound = MVCCEncode(append(prefixedKey, 0), 0)
+	}
+
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode(prefixedKey, fromVersion),
+		UpperBound: upperBound,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PebbleDB iterator: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, itr.Close())
+	}()
+
+	var history []store.KeyChange
+	for valid := itr.First(); valid; valid = itr.Next() {
+		userKey, vBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return nil, fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		// the bounds may span the versions of other keys sharing the same prefix
+		if !bytes.Equal(userKey, prefixedKey) {
+			break
+		}
+
+		version, err := decodeUint64Ascending(vBz)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode key version: %w", err)
+		}
+
+		valBz, tombBz, ok := SplitMVCCKey(itr.Value())
+		if !ok {
+			return nil, fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.Value())
+		}
+
+		// a tombstone is only written upon deletion
+		if len(tombBz) > 0 {
+			history = append(history, store.KeyChange{Version: version, Deleted: true})
+			continue
+		}
+
+		history = append(history, store.KeyChange{Version: version, Value: slices.Clone(valBz)})
+	}
+
+	return history, itr.Error()
+}
+
+// Prune prunes all versions up to and including the provided version. For every
+// key, only the latest entry written at or below the provided version is kept,
+// unless it is a deletion, such that reads at later versions are unaffected.
+// Reads at pruned versions may return the retained entry.
 //
-// See: https://github.com/cockroachdb/cockroach/blob/33623e3ee420174a4fd3226d1284b03f0e3caaac/pkg/storage/mvcc.go#L3182
-func (db *Database) Prune(version uint64) error {
-	panic("not implemented!")
+// PebbleDB has no notion of versions, so the entire key space is iterated over
+// and the pruned entries are deleted in batches.
+func (db *Database) Prune(version uint64) (err error) {
+	itr, err := db.storage.NewIter(&pebble.IterOptions{
+		LowerBound: MVCCEncode([]byte(storePrefixBase), 0),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create PebbleDB iterator: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, itr.Close())
+	}()
+
+	batch := db.storage.NewBatch()
+	defer func() {
+		err = errors.Join(err, batch.Close())
+	}()
+
+	var (
+		prevKey []byte
+		// retained is the latest entry of prevKey at or below version, if any
+		retained        []byte
+		retainedDeleted bool
+	)
+
+	for valid := itr.First(); valid; valid = itr.Next() {
+		userKey, vBz, ok := SplitMVCCKey(itr.Key())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC key: %s", itr.Key())
+		}
+
+		if !bytes.Equal(userKey, prevKey) {
+			// the latest entry of the previous key is only pruned if deleted
+			if retainedDeleted {
+				if err := batch.Delete(retained, nil); err != nil {
+					return fmt.Errorf("failed to write PebbleDB batch: %w", err)
+				}
+			}
+
+			prevKey = slices.Clone(userKey)
+			retained, retainedDeleted = nil, false
+		}
+
+		keyVersion, err := decodeUint64Ascending(vBz)
+		if err != nil {
+			return fmt.Errorf("failed to decode key version: %w", err)
+		}
+		if keyVersion > version {
+			continue
+		}
+
+		// entries are ordered by version, so the retained entry is superseded
+		if retained != nil {
+			if err := batch.Delete(retained, nil); err != nil {
+				return fmt.Errorf("failed to write PebbleDB batch: %w", err)
+			}
+		}
+
+		_, tombBz, ok := SplitMVCCKey(itr.Value())
+		if !ok {
+			return fmt.Errorf("invalid PebbleDB MVCC value: %s", itr.Value())
+		}
+
+		retained, retainedDeleted = slices.Clone(itr.Key()), len(tombBz) > 0
+
+		if batch.Len() >= pruneBatchSize {
+			if err := batch.Commit(defaultWriteOpts); err != nil {
+				return fmt.Errorf("failed to commit PebbleDB batch: %w", err)
+			}
+			batch.Reset()
+		}
+	}
+	if err := itr.Error(); err != nil {
+		return err
+	}
+
+	if retainedDeleted {
+		if err := batch.Delete(retained, nil); err != nil {
+			return fmt.Errorf("failed to write PebbleDB batch: %w", err)
+		}
+	}
+
+	return batch.Commit(defaultWriteOpts)
 }
 
 func (db *Database) Iterator(storeKey string, version uint64, start, end []byte) (store.Iterator, error) {
@@ -20,9 +20,6 @@ func TestStorageTestSuite(t *testing.T) {
 			return New(dir)
 		},
 		EmptyBatchSize: 12,
-		SkipTests: []string{
-			"TestStorageTestSuite/TestDatabase_Prune",
-		},
 	}
 	suite.Run(t, s)
 }
@@ -19,6 +19,15 @@ const (
 
 	StorePrefixTpl   = "s/k:%s/"
 	latestVersionKey = "s/latest"
+
+	// internalKeyFooterSize reflects the size of the packed sequence number and
+	// value type suffixed to internal keys, where the value type is the lowest
+	// byte encoded first.
+	internalKeyFooterSize = 8
+
+	// valueTypeValue reflects the RocksDB value type of a regular put, while all
+	// other value types surfaced by an iterator reflect deletions.
+	valueTypeValue = 0x1
 )
 
 var (
@@ -216,6 +225,70 @@ func (db *Database) ReverseIterator(storeKey string, version uint64, start, end
 	return newRocksDBIterator(itr, prefix, start, end, true), nil
 }
 
+// History returns every change of the given key within the inclusive version
+// range. Since the iterator is created with an iteration start timestamp, all
+// versions of a key are surfaced as internal keys, i.e. the user key followed by
+// the timestamp and the sequence number and value type footer.
+func (db *Database) History(storeKey string, key []byte, fromVersion, toVersion uint64) ([]store.KeyChange, error) {
+	if len(key) == 0 {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if fromVersion > toVersion {
+		return nil, fmt.Errorf("%w: from version %d is greater than to version %d", store.ErrInvalidVersion, fromVersion, toVersion)
+	}
+
+	// versions prior to tsLow may be purged at any time
+	if fromVersion < db.tsLow {
+		fromVersion = db.tsLow
+	}
+	if fromVersion > toVersion {
+		return nil, nil
+	}
+
+	var startTs [TimestampSize]byte
+	binary.LittleEndian.PutUint64(startTs[:], fromVersion)
+
+	readOpts := newTSReadOptions(toVersion)
+	readOpts.SetIterStartTimestamp(startTs[:])
+	defer readOpts.Destroy()
+
+	prefixedKey := prependStoreKey(storeKey, key)
+
+	itr := db.storage.NewIteratorCF(readOpts, db.cfHandle)
+	defer itr.Close()
+
+	// internal keys of the same user key are ordered by timestamp descending
+	var history []store.KeyChange
+	for itr.Seek(prefixedKey); itr.Valid(); itr.Next() {
+		internalKey := readOnlySlice(itr.Key())
+		if len(internalKey) != len(prefixedKey)+TimestampSize+internalKeyFooterSize ||
+			!bytes.HasPrefix(internalKey, prefixedKey) {
+			break
+		}
+
+		version := binary.LittleEndian.Uint64(internalKey[len(prefixedKey):])
+		change := store.KeyChange{Version: version}
+
+		switch internalKey[len(internalKey)-internalKeyFooterSize] {
+		case valueTypeValue:
+			change.Value = slices.Clone(readOnlySlice(itr.Value()))
+
+		default:
+			change.Deleted = true
+		}
+
+		history = append(history, change)
+	}
+
+	if err := itr.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(history)
+	return history, nil
+}
+
 // newTSReadOptions returns ReadOptions used in the RocksDB column family read.
 func newTSReadOptions(version uint64) *grocksdb.ReadOptions {
 	var ts [TimestampSize]byte
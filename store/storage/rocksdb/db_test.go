@@ -89,3 +89,54 @@ func TestDatabase_ReverseIterator(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, iter3)
 }
+
+func TestDatabase_History(t *testing.T) {
+	db, err := New(t.TempDir())
+	require.NoError(t, err)
+	defer db.Close()
+
+	changes := []*store.Changeset{
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value001")}),
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key0"), Value: []byte("value002")}),
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value003")}),
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("ke"), Value: []byte("value004")}),
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value005")}),
+		store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key")}),
+	}
+	for i, cs := range changes {
+		require.NoError(t, db.ApplyChangeset(uint64(i+1), cs))
+	}
+
+	// the versions of keys sharing a prefix with the key are skipped
+	history, err := db.History(storeKey1, []byte("key"), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []store.KeyChange{
+		{Version: 1, Value: []byte("value001")},
+		{Version: 3, Value: []byte("value003")},
+		{Version: 5, Value: []byte("value005")},
+		{Version: 6, Deleted: true},
+	}, history)
+
+	history, err = db.History(storeKey1, []byte("key0"), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []store.KeyChange{{Version: 2, Value: []byte("value002")}}, history)
+
+	history, err = db.History(storeKey1, []byte("ke"), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []store.KeyChange{{Version: 4, Value: []byte("value004")}}, history)
+
+	// the pruned versions are excluded from the history
+	require.NoError(t, db.Prune(2))
+
+	history, err = db.History(storeKey1, []byte("key"), 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, []store.KeyChange{
+		{Version: 3, Value: []byte("value003")},
+		{Version: 5, Value: []byte("value005")},
+		{Version: 6, Deleted: true},
+	}, history)
+
+	history, err = db.History(storeKey1, []byte("key"), 0, 2)
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
@@ -2,10 +2,13 @@ package sqlite
 
 import (
 	"bytes"
+	"cmp"
 	"database/sql"
 	"errors"
 	"fmt"
+	"math"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	_ "modernc.org/sqlite"
@@ -19,6 +22,11 @@ const (
 	reservedStoreKey = "_RESERVED_"
 	keyLatestHeight  = "latest_height"
 
+	// busyTimeout defines the time, in milliseconds, a connection waits on a
+	// locked database, which allows reads and writes, e.g. asynchronous flushes
+	// and pruning, to be performed concurrently.
+	busyTimeout = 5000
+
 	latestVersionStmt = `
 	INSERT INTO state_storage(store_key, key, value, version)
     VALUES(?, ?, ?, ?)
@@ -46,7 +54,9 @@ type Database struct {
 }
 
 func New(dataDir string) (*Database, error) {
-	db, err := sql.Open(driverName, filepath.Join(dataDir, dbName))
+	dsn := fmt.Sprintf("%s?_pragma=busy_timeout(%d)&_pragma=journal_mode(WAL)", filepath.Join(dataDir, dbName), busyTimeout)
+
+	db, err := sql.Open(driverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open sqlite DB: %w", err)
 	}
@@ -174,10 +184,94 @@ func (db *Database) ApplyChangeset(version uint64, cs *store.Changeset) error {
 	return b.Write()
 }
 
+func (db *Database) History(storeKey string, key []byte, fromVersion, toVersion uint64) ([]store.KeyChange, error) {
+	if len(key) == 0 {
+		return nil, store.ErrKeyEmpty
+	}
+
+	if fromVersion > toVersion {
+		return nil, fmt.Errorf("%w: from version %d is greater than to version %d", store.ErrInvalidVersion, fromVersion, toVersion)
+	}
+
+	// versions are stored as signed integers
+	toVersion = min(toVersion, math.MaxInt64)
+
+	// A deletion is reflected by the tombstone of the row it deletes, so rows
+	// written within the range and rows deleted within the range are selected.
+	stmt, err := db.storage.Prepare(`
+	SELECT value, version, tombstone FROM state_storage
+	WHERE store_key = ? AND key = ? AND (
+		(version >= ? AND version <= ?) OR (tombstone >= ? AND tombstone <= ?)
+	)
+	ORDER BY version ASC;
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare SQL statement: %w", err)
+	}
+
+	defer stmt.Close()
+
+	// a tombstone of zero denotes a row that is not deleted
+	rows, err := stmt.Query(storeKey, key, fromVersion, toVersion, max(fromVersion, 1), toVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute SQL query: %w", err)
+	}
+
+	defer rows.Close()
+
+	var history []store.KeyChange
+	for rows.Next() {
+		var (
+			value   []byte
+			version uint64
+			tomb    uint64
+		)
+		if err := rows.Scan(&value, &version, &tomb); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		if version >= fromVersion && version <= toVersion {
+			history = append(history, store.KeyChange{Version: version, Value: value})
+		}
+		if tomb != 0 && tomb >= fromVersion && tomb <= toVersion {
+			history = append(history, store.KeyChange{Version: tomb, Deleted: true})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("received unexpected error: %w", err)
+	}
+
+	// A row is always deleted prior to the next row of the same key being
+	// written, so the changes are ordered by version, except when a key is
+	// written and deleted at the same version, in which case the deletion is
+	// the latest change.
+	slices.SortStableFunc(history, func(a, b store.KeyChange) int {
+		return cmp.Compare(a.Version, b.Version)
+	})
+
+	return history, nil
+}
+
+// Prune prunes all versions up to and including the provided version. For every
+// key, only the latest row written at or below the provided version is kept,
+// unless it is deleted at or below the provided version, such that reads at
+// later versions are unaffected. Reads at pruned versions may return the
+// retained row.
 func (db *Database) Prune(version uint64) error {
-	stmt := "DELETE FROM state_storage WHERE version <= ? AND store_key != ?;"
+	stmt := `
+	DELETE FROM state_storage
+	WHERE store_key != ? AND version <= ? AND (
+		(tombstone != 0 AND tombstone <= ?) OR version < (
+			SELECT MAX(latest.version) FROM state_storage AS latest
+			WHERE latest.store_key = state_storage.store_key AND latest.key = state_storage.key AND latest.version <= ?
+		)
+	);
+	`
+
+	// versions are stored as signed integers
+	version = min(version, math.MaxInt64)
 
-	_, err := db.storage.Exec(stmt, version, reservedStoreKey)
+	_, err := db.storage.Exec(stmt, reservedStoreKey, version, version, version)
 	if err != nil {
 		return fmt.Errorf("failed to exec SQL statement: %w", err)
 	}
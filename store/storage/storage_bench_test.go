@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/storage/memdb"
 	"cosmossdk.io/store/v2/storage/pebbledb"
 	"cosmossdk.io/store/v2/storage/rocksdb"
 	"cosmossdk.io/store/v2/storage/sqlite"
@@ -28,6 +29,9 @@ var (
 		"btree_sqlite": func(dataDir string) (store.VersionedDatabase, error) {
 			return sqlite.New(dataDir)
 		},
+		"btree_memdb": func(dataDir string) (store.VersionedDatabase, error) {
+			return memdb.New(), nil
+		},
 	}
 	rng = rand.New(rand.NewSource(567320))
 )
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"math"
 	"slices"
 
 	"github.com/stretchr/testify/suite"
@@ -420,6 +421,109 @@ func (s *StorageTestSuite) TestDatabase_IteratorNoDomain() {
 	s.Require().NoError(itr.Error())
 }
 
+func (s *StorageTestSuite) TestDatabase_History() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	changes := map[uint64]*store.Changeset{
+		1: store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value001")}),
+		3: store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key000"), Value: []byte("value003")}),
+		5: store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value005")}),
+		7: store.NewChangeset(
+			store.KVPair{StoreKey: storeKey1, Key: []byte("ke"), Value: []byte("value007")},
+			store.KVPair{StoreKey: storeKey1, Key: []byte("key\x00"), Value: []byte("value007")},
+		),
+		10: store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key")}),
+		12: store.NewChangeset(store.KVPair{StoreKey: storeKey1, Key: []byte("key"), Value: []byte("value012")}),
+		14: store.NewChangeset(store.KVPair{StoreKey: "store2", Key: []byte("key"), Value: []byte("value014")}),
+	}
+	for v := uint64(1); v <= 15; v++ {
+		cs, ok := changes[v]
+		if !ok {
+			cs = store.NewChangeset()
+		}
+
+		s.Require().NoError(db.ApplyChangeset(v, cs))
+	}
+
+	testCases := map[string]struct {
+		from, to uint64
+		expected []store.KeyChange
+	}{
+		"entire history": {
+			from: 0,
+			to:   20,
+			expected: []store.KeyChange{
+				{Version: 1, Value: []byte("value001")},
+				{Version: 5, Value: []byte("value005")},
+				{Version: 10, Deleted: true},
+				{Version: 12, Value: []byte("value012")},
+			},
+		},
+		"inclusive range": {
+			from: 5,
+			to:   10,
+			expected: []store.KeyChange{
+				{Version: 5, Value: []byte("value005")},
+				{Version: 10, Deleted: true},
+			},
+		},
+		"no changes": {
+			from: 6,
+			to:   9,
+		},
+		"single version": {
+			from: 12,
+			to:   12,
+			expected: []store.KeyChange{
+				{Version: 12, Value: []byte("value012")},
+			},
+		},
+		"unbounded": {
+			from: 0,
+			to:   math.MaxUint64,
+			expected: []store.KeyChange{
+				{Version: 1, Value: []byte("value001")},
+				{Version: 5, Value: []byte("value005")},
+				{Version: 10, Deleted: true},
+				{Version: 12, Value: []byte("value012")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		s.Run(name, func() {
+			history, err := db.History(storeKey1, []byte("key"), tc.from, tc.to)
+			s.Require().NoError(err)
+			s.Require().Len(history, len(tc.expected))
+
+			for i, change := range history {
+				s.Require().Equal(tc.expected[i].Version, change.Version)
+				s.Require().Equal(tc.expected[i].Deleted, change.Deleted)
+				s.Require().Equal(tc.expected[i].Value, change.Value)
+			}
+		})
+	}
+
+	history, err := db.History("store2", []byte("key"), 0, 20)
+	s.Require().NoError(err)
+	s.Require().Equal([]store.KeyChange{{Version: 14, Value: []byte("value014")}}, history)
+
+	// keys sharing a prefix with another key have their own history
+	for _, key := range []string{"ke", "key\x00"} {
+		history, err = db.History(storeKey1, []byte(key), 0, math.MaxUint64)
+		s.Require().NoError(err)
+		s.Require().Equal([]store.KeyChange{{Version: 7, Value: []byte("value007")}}, history)
+	}
+
+	_, err = db.History(storeKey1, []byte("key"), 10, 5)
+	s.Require().Error(err)
+
+	_, err = db.History(storeKey1, nil, 0, 10)
+	s.Require().Error(err)
+}
+
 func (s *StorageTestSuite) TestDatabase_Prune() {
 	if slices.Contains(s.SkipTests, s.T().Name()) {
 		s.T().SkipNow()
@@ -429,7 +533,8 @@ func (s *StorageTestSuite) TestDatabase_Prune() {
 	s.Require().NoError(err)
 	defer db.Close()
 
-	// for versions 1-50, set 10 keys
+	// for versions 1-50, set 10 keys, along with a key only set at version 1,
+	// a key deleted at version 10 and a key deleted at version 40
 	for v := uint64(1); v <= 50; v++ {
 		cs := new(store.Changeset)
 		for i := 0; i < 10; i++ {
@@ -439,47 +544,73 @@ func (s *StorageTestSuite) TestDatabase_Prune() {
 			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte(key), Value: []byte(val)})
 		}
 
+		switch v {
+		case 1:
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("static"), Value: []byte("static-001")})
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("deleted"), Value: []byte("deleted-001")})
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("deletedLater"), Value: []byte("deletedLater-001")})
+
+		case 10:
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("deleted")})
+
+		case 40:
+			cs.AddKVPair(store.KVPair{StoreKey: storeKey1, Key: []byte("deletedLater")})
+		}
+
 		s.Require().NoError(db.ApplyChangeset(v, cs))
 	}
 
-	// prune the first 25 versions
-	s.Require().NoError(db.Prune(25))
+	// every version after the pruned ones reads the same as prior to pruning
+	requireUnpruned := func(pruned uint64) {
+		for v := pruned + 1; v <= 50; v++ {
+			for i := 0; i < 10; i++ {
+				bz, err := db.Get(storeKey1, v, []byte(fmt.Sprintf("key%03d", i)))
+				s.Require().NoError(err)
+				s.Require().Equal([]byte(fmt.Sprintf("val%03d-%03d", i, v)), bz)
+			}
 
-	latestVersion, err := db.GetLatestVersion()
-	s.Require().NoError(err)
-	s.Require().Equal(uint64(50), latestVersion)
+			bz, err := db.Get(storeKey1, v, []byte("static"))
+			s.Require().NoError(err)
+			s.Require().Equal([]byte("static-001"), bz)
 
-	// Ensure all keys are no longer present up to and including version 25 and
-	// all keys are present after version 25.
-	for v := uint64(1); v <= 50; v++ {
-		for i := 0; i < 10; i++ {
-			key := fmt.Sprintf("key%03d", i)
-			val := fmt.Sprintf("val%03d-%03d", i, v)
+			bz, err = db.Get(storeKey1, v, []byte("deleted"))
+			s.Require().NoError(err)
+			s.Require().Nil(bz)
 
-			bz, err := db.Get(storeKey1, v, []byte(key))
+			bz, err = db.Get(storeKey1, v, []byte("deletedLater"))
 			s.Require().NoError(err)
-			if v <= 25 {
-				s.Require().Nil(bz)
+			if v < 40 {
+				s.Require().Equal([]byte("deletedLater-001"), bz)
 			} else {
-				s.Require().Equal([]byte(val), bz)
+				s.Require().Nil(bz)
 			}
 		}
 	}
 
-	itr, err := db.Iterator(storeKey1, 25, []byte("key000"), nil)
+	// prune the first 25 versions
+	s.Require().NoError(db.Prune(25))
+
+	latestVersion, err := db.GetLatestVersion()
 	s.Require().NoError(err)
-	s.Require().False(itr.Valid())
+	s.Require().Equal(uint64(50), latestVersion)
 
-	// prune the latest version which should prune the entire dataset
-	s.Require().NoError(db.Prune(50))
+	requireUnpruned(25)
 
-	for v := uint64(1); v <= 50; v++ {
-		for i := 0; i < 10; i++ {
-			key := fmt.Sprintf("key%03d", i)
+	// prune every version but the latest one
+	s.Require().NoError(db.Prune(49))
+	requireUnpruned(49)
 
-			bz, err := db.Get(storeKey1, v, []byte(key))
-			s.Require().NoError(err)
-			s.Require().Nil(bz)
-		}
+	itr, err := db.Iterator(storeKey1, 50, nil, nil)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	var keys []string
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, string(itr.Key()))
 	}
+	s.Require().NoError(itr.Error())
+	s.Require().Equal([]string{
+		"key000", "key001", "key002", "key003", "key004",
+		"key005", "key006", "key007", "key008", "key009", "static",
+	}, keys)
 }
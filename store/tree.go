@@ -1,7 +1,11 @@
 package store
 
 import (
+	"io"
+
 	ics23 "github.com/cosmos/ics23/go"
+
+	snapshottypes "cosmossdk.io/store/v2/snapshots/types"
 )
 
 // Tree is an interface for a commitment layer to support multiple backends.
@@ -9,8 +13,49 @@ type Tree interface {
 	WriteBatch(cs *Changeset) error
 	WorkingHash() []byte
 	GetLatestVersion() uint64
+
+	// LoadVersion loads the tree at the given version, discarding any version
+	// after it, or at its latest version if the given version is 0.
 	LoadVersion(targetVersion uint64) error
+
 	Commit() ([]byte, error)
 	GetProof(version uint64, key []byte) (*ics23.CommitmentProof, error)
+
+	// Prune attempts to prune all versions up to and including the provided
+	// version argument. The operation should be idempotent. An error should be
+	// returned upon failure.
+	Prune(version uint64) error
+
+	// Export returns an Exporter for the tree at the given version, which is
+	// used to create state-sync snapshots.
+	Export(version uint64) (Exporter, error)
+
+	// Import returns an Importer which restores the tree at the given version.
+	// The tree must be empty.
+	Import(version uint64) (Importer, error)
+
 	Close() error
 }
+
+// Exporter defines the interface for exporting the nodes of a Tree at a given
+// version. Nodes are exported in depth-first post-order.
+type Exporter interface {
+	// Next returns the next exported node, or ErrExportDone once all nodes have
+	// been exported.
+	Next() (*snapshottypes.SnapshotIAVLItem, error)
+
+	io.Closer
+}
+
+// Importer defines the interface for importing the nodes of a Tree, as exported
+// by an Exporter.
+type Importer interface {
+	// Add adds the next node to the tree. Nodes must be added in the order they
+	// were exported.
+	Add(*snapshottypes.SnapshotIAVLItem) error
+
+	// Commit persists the imported nodes, finalizing the import.
+	Commit() error
+
+	io.Closer
+}
@@ -0,0 +1,234 @@
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/internal/encoding"
+)
+
+const (
+	fileExt    = ".wal"
+	tmpFileExt = ".tmp"
+
+	// checksumSize defines the size of the CRC-32 checksum that prefixes the
+	// payload of every entry.
+	checksumSize = 4
+)
+
+// ErrNotFound is returned when no changeset is logged for a given version.
+var ErrNotFound = errors.New("changeset not found in WAL")
+
+// WAL defines a write-ahead log of changesets, keyed by version. Every changeset
+// is durably written to its own file prior to being committed to the SS and SC
+// backends, such that a changeset committed to only one of the backends, e.g.
+// due to a crash, can be replayed to the other upon restart.
+//
+// Entries are written to a temporary file which is atomically renamed once
+// synced, so an entry is either fully present or absent.
+type WAL struct {
+	dir string
+
+	// mtx guards versions
+	mtx sync.Mutex
+
+	// versions reflects the versions of all logged changesets, in ascending order
+	versions []uint64
+}
+
+// Open opens, or creates, the WAL in the given directory. Temporary files left
+// behind by an interrupted write are removed.
+func Open(dir string) (*WAL, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL directory: %w", err)
+	}
+
+	w := &WAL{dir: dir}
+	for _, entry := range entries {
+		name := entry.Name()
+
+		switch {
+		case strings.HasSuffix(name, tmpFileExt):
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return nil, fmt.Errorf("failed to remove incomplete WAL entry %s: %w", name, err)
+			}
+
+		case strings.HasSuffix(name, fileExt):
+			version, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid WAL entry %s: %w", name, err)
+			}
+
+			w.versions = append(w.versions, version)
+		}
+	}
+
+	slices.Sort(w.versions)
+
+	return w, nil
+}
+
+// Write durably logs the changeset of the given version, replacing any changeset
+// previously logged for that version.
+func (w *WAL) Write(version uint64, cs *store.Changeset) error {
+	path := w.path(version)
+	tmpPath := path + tmpFileExt
+
+	if err := writeFileSync(tmpPath, encodeEntry(version, cs)); err != nil {
+		return fmt.Errorf("failed to write WAL entry for version %d: %w", version, err)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to write WAL entry for version %d: %w", version, err)
+	}
+
+	// persist the rename itself
+	if err := syncDir(w.dir); err != nil {
+		return fmt.Errorf("failed to sync WAL directory: %w", err)
+	}
+
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if i, found := slices.BinarySearch(w.versions, version); !found {
+		w.versions = slices.Insert(w.versions, i, version)
+	}
+
+	return nil
+}
+
+// Read returns the changeset logged for the given version. ErrNotFound is
+// returned if no changeset is logged for the version.
+func (w *WAL) Read(version uint64) (*store.Changeset, error) {
+	bz, err := os.ReadFile(w.path(version))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: version %d", ErrNotFound, version)
+		}
+
+		return nil, fmt.Errorf("failed to read WAL entry for version %d: %w", version, err)
+	}
+
+	cs, err := decodeEntry(version, bz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode WAL entry for version %d: %w", version, err)
+	}
+
+	return cs, nil
+}
+
+// Has returns true if a changeset is logged for the given version.
+func (w *WAL) Has(version uint64) bool {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	_, found := slices.BinarySearch(w.versions, version)
+	return found
+}
+
+// Versions returns the versions of all logged changesets in ascending order.
+func (w *WAL) Versions() []uint64 {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	return slices.Clone(w.versions)
+}
+
+// Truncate removes the changesets of all versions up to and including the given
+// version, which must no longer be needed for recovery.
+func (w *WAL) Truncate(version uint64) error {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	var (
+		i   int
+		err error
+	)
+	for ; i < len(w.versions) && w.versions[i] <= version; i++ {
+		if rErr := os.Remove(w.path(w.versions[i])); rErr != nil && !errors.Is(rErr, os.ErrNotExist) {
+			err = fmt.Errorf("failed to remove WAL entry for version %d: %w", w.versions[i], rErr)
+			break
+		}
+	}
+
+	w.versions = w.versions[i:]
+	return err
+}
+
+func (w *WAL) path(version uint64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%020d%s", version, fileExt))
+}
+
+func writeFileSync(path string, bz []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(bz); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return errors.Join(f.Sync(), f.Close())
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(d.Sync(), d.Close())
+}
+
+// encodeEntry encodes the changeset of the given version prefixed by the CRC-32
+// checksum of the encoding.
+func encodeEntry(version uint64, cs *store.Changeset) []byte {
+	payload := binary.AppendUvarint(nil, version)
+	payload = encoding.AppendChangeset(payload, cs)
+
+	bz := make([]byte, checksumSize, checksumSize+len(payload))
+	binary.BigEndian.PutUint32(bz, crc32.ChecksumIEEE(payload))
+
+	return append(bz, payload...)
+}
+
+func decodeEntry(version uint64, bz []byte) (*store.Changeset, error) {
+	if len(bz) < checksumSize {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	payload := bz[checksumSize:]
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(bz[:checksumSize]) {
+		return nil, errors.New("checksum mismatch")
+	}
+
+	d := encoding.NewDecoder(payload)
+	v := d.Uvarint()
+	cs := d.Changeset()
+	if err := d.Err(); err != nil {
+		return nil, err
+	}
+
+	if v != version {
+		return nil, fmt.Errorf("unexpected version %d", v)
+	}
+
+	return cs, nil
+}
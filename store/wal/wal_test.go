@@ -0,0 +1,79 @@
+package wal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/v2"
+)
+
+func TestWAL(t *testing.T) {
+	dir := t.TempDir()
+
+	w, err := Open(dir)
+	require.NoError(t, err)
+	require.Empty(t, w.Versions())
+
+	cs := store.NewChangeset(
+		store.KVPair{StoreKey: "store1", Key: []byte("key1"), Value: []byte("val1")},
+		store.KVPair{StoreKey: "store1", Key: []byte("key2"), Value: []byte{}},
+		store.KVPair{StoreKey: "store2", Key: []byte("key3")},
+	)
+
+	for _, v := range []uint64{3, 1, 2} {
+		require.NoError(t, w.Write(v, cs))
+	}
+	require.Equal(t, []uint64{1, 2, 3}, w.Versions())
+	require.True(t, w.Has(2))
+	require.False(t, w.Has(4))
+
+	got, err := w.Read(2)
+	require.NoError(t, err)
+	require.Equal(t, cs, got)
+
+	_, err = w.Read(4)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// rewriting a version replaces its changeset
+	cs2 := store.NewChangeset(store.KVPair{StoreKey: "store1", Key: []byte("key1"), Value: []byte("val2")})
+	require.NoError(t, w.Write(3, cs2))
+
+	got, err = w.Read(3)
+	require.NoError(t, err)
+	require.Equal(t, cs2, got)
+
+	require.NoError(t, w.Truncate(2))
+	require.Equal(t, []uint64{3}, w.Versions())
+
+	_, err = w.Read(2)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// an incomplete write is discarded upon reopening
+	require.NoError(t, os.WriteFile(w.path(4)+tmpFileExt, []byte("torn"), 0o644))
+
+	w, err = Open(dir)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, w.Versions())
+
+	got, err = w.Read(3)
+	require.NoError(t, err)
+	require.Equal(t, cs2, got)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	// a corrupted entry fails to be read
+	path := filepath.Join(dir, entries[0].Name())
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	bz[len(bz)-1] ^= 0xFF
+	require.NoError(t, os.WriteFile(path, bz, 0o644))
+
+	_, err = w.Read(3)
+	require.Error(t, err)
+}
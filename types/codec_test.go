@@ -10,3 +10,7 @@ import (
 func TestIntValue(t *testing.T) {
 	colltest.TestValueCodec(t, IntValue, math.NewInt(10005994859))
 }
+
+func TestLegacyDecValue(t *testing.T) {
+	colltest.TestValueCodec(t, LegacyDecValue, math.LegacyNewDecWithPrec(10005994859, 3))
+}
@@ -0,0 +1,207 @@
+package mempool
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Inspector = (*PriorityNonceMempool[int64])(nil)
+	_ Inspector = (*SenderNonceMempool)(nil)
+	_ Inspector = (*LanedMempool)(nil)
+)
+
+// EventType defines the type of a mempool event.
+type EventType int
+
+const (
+	// EventInsert is emitted when a tx is inserted into the mempool, including
+	// when it replaces a tx of the same sender and nonce.
+	EventInsert EventType = iota + 1
+
+	// EventRemove is emitted when a tx is removed from the mempool, e.g. once it
+	// is included in a block or fails to be rechecked.
+	EventRemove
+
+	// EventEvict is emitted when a tx is evicted from the mempool by the mempool
+	// itself, e.g. because it expired or to make room for other txs.
+	EventEvict
+)
+
+// String implements the fmt.Stringer interface.
+func (t EventType) String() string {
+	switch t {
+	case EventInsert:
+		return "insert"
+	case EventRemove:
+		return "remove"
+	case EventEvict:
+		return "evict"
+	default:
+		return "unknown"
+	}
+}
+
+type (
+	// PendingTx defines a tx held by a mempool along with its sender, nonce and
+	// priority, as indexed by the mempool.
+	PendingTx struct {
+		Tx     sdk.Tx
+		Sender string
+		Nonce  uint64
+
+		// Priority defines the string representation of the priority of the tx,
+		// or an empty string if the mempool does not order txs by priority.
+		Priority string
+	}
+
+	// Event defines an insertion or removal of a tx of a mempool.
+	Event struct {
+		Type EventType
+		PendingTx
+	}
+
+	// Inspector defines a Mempool whose content can be inspected by operators,
+	// e.g. to debug stuck transactions.
+	Inspector interface {
+		// PendingTxs returns the txs of the given sender, ordered by nonce, or
+		// every tx of the mempool ordered by sender and nonce if the sender is
+		// empty.
+		PendingTxs(sender string) []PendingTx
+
+		// Subscribe returns a channel receiving the events of the mempool, along
+		// with a function to cancel the subscription, which closes the channel.
+		// The channel buffers up to the given number of events, any further
+		// events being dropped until the subscriber catches up, such that a slow
+		// subscriber never blocks the mempool.
+		Subscribe(bufferSize int) (<-chan Event, func())
+	}
+)
+
+// eventBus dispatches the events of a mempool to its subscribers.
+type eventBus struct {
+	mtx    sync.Mutex
+	subs   map[uint64]chan Event
+	nextID uint64
+}
+
+// subscribe registers a new subscriber, see Inspector.Subscribe.
+func (b *eventBus) subscribe(bufferSize int) (<-chan Event, func()) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.subs == nil {
+		b.subs = make(map[uint64]chan Event)
+	}
+
+	id := b.nextID
+	b.nextID++
+
+	ch := make(chan Event, bufferSize)
+	b.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mtx.Lock()
+			defer b.mtx.Unlock()
+
+			delete(b.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// active returns true if there is at least one subscriber, such that callers
+// may skip building events nobody listens to.
+func (b *eventBus) active() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	return len(b.subs) > 0
+}
+
+// publish sends the event to every subscriber whose buffer is not full.
+func (b *eventBus) publish(e Event) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, ch := range b.subs {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+// PendingTxs implements the Inspector interface.
+func (mp *LanedMempool) PendingTxs(sender string) []PendingTx {
+	var txs []PendingTx
+	for _, lane := range mp.lanes {
+		if inspector, ok := lane.Mempool.(Inspector); ok {
+			txs = append(txs, inspector.PendingTxs(sender)...)
+		}
+	}
+
+	// lanes are ordered by sender and nonce individually, but a sender may have
+	// txs in several lanes
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Sender != txs[j].Sender {
+			return txs[i].Sender < txs[j].Sender
+		}
+
+		return txs[i].Nonce < txs[j].Nonce
+	})
+
+	return txs
+}
+
+// Subscribe implements the Inspector interface, merging the events of every
+// lane whose mempool is an Inspector.
+func (mp *LanedMempool) Subscribe(bufferSize int) (<-chan Event, func()) {
+	var (
+		out     = make(chan Event, bufferSize)
+		done    = make(chan struct{})
+		wg      sync.WaitGroup
+		cancels []func()
+	)
+
+	for _, lane := range mp.lanes {
+		inspector, ok := lane.Mempool.(Inspector)
+		if !ok {
+			continue
+		}
+
+		events, cancel := inspector.Subscribe(bufferSize)
+		cancels = append(cancels, cancel)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for e := range events {
+				select {
+				case out <- e:
+				case <-done:
+					return
+				default:
+				}
+			}
+		}()
+	}
+
+	var once sync.Once
+	return out, func() {
+		once.Do(func() {
+			close(done)
+			for _, cancel := range cancels {
+				cancel()
+			}
+
+			wg.Wait()
+			close(out)
+		})
+	}
+}
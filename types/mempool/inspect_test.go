@@ -0,0 +1,152 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestInspector(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	laned, err := mempool.NewLanedMempool(
+		mempool.Lane{
+			Name:          "a",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(5, 1),
+			Match:         func(tx sdk.Tx) bool { return tx.(testTx).nonce%2 == 0 },
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.NewSenderNonceMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		mempool      mempool.Mempool
+		withPriority bool
+	}{
+		{"priority nonce", mempool.DefaultPriorityMempool(), true},
+		{"sender nonce", mempool.NewSenderNonceMempool(), false},
+		{"laned", laned, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := tc.mempool
+			inspector := mp.(mempool.Inspector)
+
+			events, cancel := inspector.Subscribe(10)
+
+			txs := []testTx{
+				{id: 0, priority: 10, nonce: 1, address: sb},
+				{id: 1, priority: 5, nonce: 0, address: sa},
+				{id: 2, priority: 20, nonce: 0, address: sb},
+				{id: 3, priority: 1, nonce: 1, address: sa},
+			}
+			for _, tx := range txs {
+				require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+			require.NoError(t, mp.Remove(txs[1]))
+
+			// txs are listed by sender and nonce
+			pending := inspector.PendingTxs("")
+			require.Len(t, pending, 3)
+
+			expected := []testTx{txs[3], txs[2], txs[0]}
+			if sb.String() < sa.String() {
+				expected = []testTx{txs[2], txs[0], txs[3]}
+			}
+			for i, tx := range expected {
+				require.Equal(t, sdk.Tx(tx), pending[i].Tx)
+				require.Equal(t, tx.address.String(), pending[i].Sender)
+				require.Equal(t, tx.nonce, pending[i].Nonce)
+			}
+
+			pending = inspector.PendingTxs(sb.String())
+			require.Len(t, pending, 2)
+			require.Equal(t, sdk.Tx(txs[2]), pending[0].Tx)
+			require.Equal(t, sdk.Tx(txs[0]), pending[1].Tx)
+			if tc.withPriority {
+				require.Equal(t, "20", pending[0].Priority)
+				require.Equal(t, "10", pending[1].Priority)
+			}
+
+			require.Empty(t, inspector.PendingTxs(accounts[0].Address.String()+"x"))
+
+			// every insert and remove is streamed
+			var received []mempool.Event
+			for len(received) < 5 {
+				select {
+				case e := <-events:
+					received = append(received, e)
+				case <-time.After(time.Second):
+					t.Fatalf("expected 5 events, got %d", len(received))
+				}
+			}
+
+			var inserts, removes int
+			for _, e := range received {
+				switch e.Type {
+				case mempool.EventInsert:
+					inserts++
+				case mempool.EventRemove:
+					removes++
+					require.Equal(t, sdk.Tx(txs[1]), e.Tx)
+					require.Equal(t, sa.String(), e.Sender)
+				}
+			}
+			require.Equal(t, 4, inserts)
+			require.Equal(t, 1, removes)
+
+			cancel()
+			cancel()
+			for range events {
+			}
+			require.NoError(t, mp.Insert(ctx, txs[1]))
+		})
+	}
+}
+
+func TestPriorityNonceMempool_EvictEvents(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	cfg := mempool.DefaultPriorityNonceMempoolConfig()
+	cfg.TxTTLBlocks = 1
+	mp := mempool.NewPriorityMempool(cfg)
+
+	events, cancel := mp.Subscribe(1)
+	defer cancel()
+
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(1), testTx{id: 0, nonce: 0, address: sa}))
+	require.Equal(t, mempool.EventInsert, (<-events).Type)
+
+	// the buffer of the subscriber is full, thus the second insert is dropped
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(1), testTx{id: 1, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(1), testTx{id: 2, nonce: 2, address: sa}))
+	require.Equal(t, mempool.EventInsert, (<-events).Type)
+	require.Empty(t, events)
+
+	require.Nil(t, mp.Select(ctx.WithBlockHeight(3), nil))
+
+	e := <-events
+	require.Equal(t, mempool.EventEvict, e.Type)
+	require.Equal(t, uint64(0), e.Nonce)
+	require.Equal(t, "0", e.Priority)
+}
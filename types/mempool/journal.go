@@ -0,0 +1,203 @@
+package mempool
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	_ Mempool   = (*JournaledMempool)(nil)
+	_ Inspector = (*JournaledMempool)(nil)
+	_ Journal   = (*JournaledMempool)(nil)
+)
+
+// Journal defines a Mempool persisting its transactions, such that they can be
+// replayed into the mempool once the node restarts.
+type Journal interface {
+	Mempool
+
+	// Replay calls fn with the bytes of every transaction of the journal,
+	// ordered by sender and nonce. fn is expected to validate and re-insert the
+	// transaction, returning an error if it is rejected, in which case the
+	// transaction is removed from the journal.
+	Replay(fn func(txBytes []byte) error) error
+}
+
+// JournaledMempool defines a Mempool which journals the bytes of the
+// transactions of an underlying mempool to a database, keyed by sender and
+// nonce, such that the content of the mempool survives restarts of the node.
+//
+// Transactions are journaled on Insert and removed from the journal on Remove,
+// even if the underlying mempool no longer holds them. If the underlying mempool
+// is an Evictor, transactions it evicts by itself, e.g. expired transactions,
+// are removed from the journal as well. Otherwise they remain in the journal
+// until they are removed or rejected on Replay.
+//
+// NOTE: Writes are not synced to disk, thus the most recent changes may be lost
+// if the node crashes.
+type JournaledMempool struct {
+	Mempool
+	db dbm.DB
+}
+
+// NewJournaledMempool returns a JournaledMempool journaling the transactions
+// of the given mempool to the given database, which is closed on Close.
+func NewJournaledMempool(mp Mempool, db dbm.DB) *JournaledMempool {
+	journal := &JournaledMempool{Mempool: mp, db: db}
+	if evictor, ok := mp.(Evictor); ok {
+		evictor.AddEvictHandler(journal.evict)
+	}
+
+	return journal
+}
+
+// Insert inserts the transaction into the underlying mempool and journals the
+// tx bytes of the context it is inserted with.
+func (mp *JournaledMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	txBytes := sdk.UnwrapSDKContext(ctx).TxBytes()
+	if len(txBytes) == 0 {
+		return errors.New("tx bytes must be set in the context to journal the tx")
+	}
+
+	key, err := journalKey(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.Mempool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	if err := mp.db.Set(key, txBytes); err != nil {
+		_ = mp.Mempool.Remove(tx)
+		return fmt.Errorf("failed to journal tx: %w", err)
+	}
+
+	return nil
+}
+
+// Remove removes the transaction from the underlying mempool and the journal.
+func (mp *JournaledMempool) Remove(tx sdk.Tx) error {
+	key, err := journalKey(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := mp.db.Delete(key); err != nil {
+		return fmt.Errorf("failed to remove tx from journal: %w", err)
+	}
+
+	return mp.Mempool.Remove(tx)
+}
+
+// Replay implements the Journal interface.
+func (mp *JournaledMempool) Replay(fn func(txBytes []byte) error) error {
+	itr, err := mp.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+
+	var keys, txs [][]byte
+	for ; itr.Valid(); itr.Next() {
+		keys = append(keys, itr.Key())
+		txs = append(txs, itr.Value())
+	}
+
+	if err := errors.Join(itr.Error(), itr.Close()); err != nil {
+		return err
+	}
+
+	// txs are only removed from the journal once rejected, such that none is
+	// lost if the node stops while replaying
+	batch := mp.db.NewBatch()
+	defer batch.Close()
+
+	for i, txBytes := range txs {
+		if err := fn(txBytes); err == nil {
+			continue
+		}
+
+		if err := batch.Delete(keys[i]); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// evict removes a tx evicted by the underlying mempool from the journal.
+func (mp *JournaledMempool) evict(tx sdk.Tx) {
+	key, err := journalKey(tx)
+	if err != nil {
+		return
+	}
+
+	_ = mp.db.Delete(key)
+}
+
+// PendingTxs implements the Inspector interface, returning no transactions if
+// the underlying mempool is not an Inspector.
+func (mp *JournaledMempool) PendingTxs(sender string) []PendingTx {
+	if inspector, ok := mp.Mempool.(Inspector); ok {
+		return inspector.PendingTxs(sender)
+	}
+
+	return nil
+}
+
+// Subscribe implements the Inspector interface, returning a closed channel if
+// the underlying mempool is not an Inspector.
+func (mp *JournaledMempool) Subscribe(bufferSize int) (<-chan Event, func()) {
+	if inspector, ok := mp.Mempool.(Inspector); ok {
+		return inspector.Subscribe(bufferSize)
+	}
+
+	ch := make(chan Event)
+	close(ch)
+
+	return ch, func() {}
+}
+
+// Unwrap returns the underlying mempool.
+func (mp *JournaledMempool) Unwrap() Mempool {
+	return mp.Mempool
+}
+
+// Close closes the database of the journal.
+func (mp *JournaledMempool) Close() error {
+	return mp.db.Close()
+}
+
+// journalKey returns the key of the transaction in the journal, i.e. its length
+// prefixed signer followed by its big endian encoded nonce, followed by its ID
+// if the transaction is unordered.
+func journalKey(tx sdk.Tx) ([]byte, error) {
+	sig, _, nonce, err := txSender(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := address.LengthPrefix(sig.PubKey.Address())
+	if err != nil {
+		return nil, err
+	}
+
+	key := binary.BigEndian.AppendUint64(signer, nonce)
+	if unorderedTx, ok := tx.(sdk.TxWithUnordered); ok && unorderedTx.GetUnordered() {
+		id, err := unorderedTxID(sig)
+		if err != nil {
+			return nil, err
+		}
+
+		key = append(key, id...)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,110 @@
+package mempool_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestJournaledMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	db := dbm.NewMemDB()
+	mp := mempool.NewJournaledMempool(mempool.DefaultPriorityMempool(), db)
+
+	txs := []testTx{
+		{id: 0, nonce: 1, address: sa},
+		{id: 1, nonce: 0, address: sb},
+		{id: 2, nonce: 0, address: sa},
+		{id: 3, nonce: 1, address: sb},
+	}
+
+	require.ErrorContains(t, mp.Insert(ctx, txs[0]), "tx bytes must be set")
+	require.Equal(t, 0, mp.CountTx())
+
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithTxBytes([]byte(fmt.Sprint(tx.id))), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Len(t, mp.PendingTxs(""), 4)
+
+	require.NoError(t, mp.Remove(txs[3]))
+	require.ErrorIs(t, mp.Remove(txs[3]), mempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+
+	// the journal is replayed by sender and nonce, and rejected txs are removed
+	// from it
+	var replayed []string
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		if string(txBytes) == "1" {
+			return errors.New("rejected")
+		}
+
+		return nil
+	}))
+
+	expected := []string{"2", "0", "1"}
+	if bytes.Compare(sb, sa) < 0 {
+		expected = []string{"1", "2", "0"}
+	}
+	require.Equal(t, expected, replayed)
+
+	replayed = nil
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		return nil
+	}))
+	require.ElementsMatch(t, []string{"2", "0"}, replayed)
+
+	require.NoError(t, mp.Close())
+}
+
+func TestJournaledMempool_Evict(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	var evicted []sdk.Tx
+	mp := mempool.NewJournaledMempool(mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:  mempool.NewDefaultTxPriority(),
+			TxTTLBlocks: 2,
+			OnEvict:     func(tx sdk.Tx) { evicted = append(evicted, tx) },
+		},
+	), dbm.NewMemDB())
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 20, nonce: 0, address: sb},
+		{id: 2, priority: 30, nonce: 1, address: sa},
+	}
+	for i, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithBlockHeight(int64(i+1)).WithPriority(tx.priority).WithTxBytes([]byte(fmt.Sprint(tx.id))), tx))
+	}
+
+	// the expired txs are removed from the journal, while the callback of the
+	// config is still called
+	require.Equal(t, []sdk.Tx{txs[1]}, fetchTxs(mp.Select(ctx.WithBlockHeight(4), nil), 1000))
+	require.Equal(t, []sdk.Tx{txs[0], txs[2]}, evicted)
+
+	var replayed []string
+	require.NoError(t, mp.Replay(func(txBytes []byte) error {
+		replayed = append(replayed, string(txBytes))
+		return nil
+	}))
+	require.Equal(t, []string{"1"}, replayed)
+}
@@ -0,0 +1,206 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ Mempool  = (*LanedMempool)(nil)
+	_ Evictor  = (*LanedMempool)(nil)
+	_ Iterator = (*lanesIterator)(nil)
+)
+
+// ErrNoMatchingLane is returned when inserting a tx which does not match any lane.
+var ErrNoMatchingLane = errors.New("tx does not match any lane")
+
+type (
+	// Lane defines a mempool dedicated to a class of transactions, e.g. oracle or
+	// IBC transactions, which is allotted a share of the space of a block.
+	Lane struct {
+		// Name defines the unique name of the lane.
+		Name string
+
+		// Mempool defines the mempool storing the transactions of the lane.
+		Mempool Mempool
+
+		// MaxBlockSpace defines the share of the maximum bytes and gas of a block
+		// which may be used by the transactions of the lane, within (0, 1].
+		MaxBlockSpace math.LegacyDec
+
+		// Match returns true if the transaction belongs to the lane. It must be
+		// deterministic, as it is used to verify the lanes of proposals. If nil,
+		// the lane matches every transaction, and it must be the last lane.
+		Match func(tx sdk.Tx) bool
+	}
+
+	// LanedMempool defines a Mempool composed of an ordered list of lanes, where
+	// every transaction belongs to the first lane matching it. Transactions are
+	// selected lane by lane, in the order of the lanes, such that proposals are
+	// composed of the transactions of every lane in order, each within the block
+	// space of its lane.
+	LanedMempool struct {
+		lanes []Lane
+	}
+
+	// lanesIterator defines an iterator over the transactions of every lane, in
+	// the order of the lanes.
+	lanesIterator struct {
+		ctx     context.Context
+		txs     [][]byte
+		lanes   []Lane
+		current Iterator
+	}
+)
+
+// NewLanedMempool returns a LanedMempool of the given lanes, in order of
+// priority, returning an error if the lanes are invalid.
+func NewLanedMempool(lanes ...Lane) (*LanedMempool, error) {
+	if len(lanes) == 0 {
+		return nil, errors.New("no lanes provided")
+	}
+
+	names := make(map[string]struct{}, len(lanes))
+	for i, lane := range lanes {
+		if lane.Name == "" {
+			return nil, fmt.Errorf("lane %d has no name", i)
+		}
+
+		if _, ok := names[lane.Name]; ok {
+			return nil, fmt.Errorf("duplicate lane %s", lane.Name)
+		}
+		names[lane.Name] = struct{}{}
+
+		if lane.Mempool == nil {
+			return nil, fmt.Errorf("lane %s has no mempool", lane.Name)
+		}
+
+		if lane.MaxBlockSpace.IsNil() || !lane.MaxBlockSpace.IsPositive() || lane.MaxBlockSpace.GT(math.LegacyOneDec()) {
+			return nil, fmt.Errorf("lane %s max block space must be within (0, 1], got %s", lane.Name, lane.MaxBlockSpace)
+		}
+
+		if lane.Match == nil && i != len(lanes)-1 {
+			return nil, fmt.Errorf("lane %s matches every tx but is not the last lane", lane.Name)
+		}
+	}
+
+	return &LanedMempool{lanes: lanes}, nil
+}
+
+// Lanes returns the lanes of the mempool, in order.
+func (mp *LanedMempool) Lanes() []Lane {
+	return mp.lanes
+}
+
+// LaneIndex returns the index of the lane the transaction belongs to, or -1 if
+// it does not match any lane.
+func (mp *LanedMempool) LaneIndex(tx sdk.Tx) int {
+	for i, lane := range mp.lanes {
+		if lane.Match == nil || lane.Match(tx) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Insert inserts the transaction into the mempool of its lane.
+func (mp *LanedMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrNoMatchingLane
+	}
+
+	return mp.lanes[i].Mempool.Insert(ctx, tx)
+}
+
+// Select returns an iterator over the transactions of every lane, in the order
+// of the lanes.
+func (mp *LanedMempool) Select(ctx context.Context, txs [][]byte) Iterator {
+	itr := &lanesIterator{ctx: ctx, txs: txs, lanes: mp.lanes}
+	return itr.nextLane()
+}
+
+// CountTx returns the number of transactions of every lane.
+func (mp *LanedMempool) CountTx() int {
+	var count int
+	for _, lane := range mp.lanes {
+		count += lane.Mempool.CountTx()
+	}
+
+	return count
+}
+
+// Remove removes the transaction from the mempool of its lane.
+func (mp *LanedMempool) Remove(tx sdk.Tx) error {
+	i := mp.LaneIndex(tx)
+	if i < 0 {
+		return ErrTxNotFound
+	}
+
+	return mp.lanes[i].Mempool.Remove(tx)
+}
+
+// AddEvictHandler implements the Evictor interface, registering the handler
+// with the mempool of every lane which is an Evictor.
+func (mp *LanedMempool) AddEvictHandler(fn func(tx sdk.Tx)) {
+	for _, lane := range mp.lanes {
+		if evictor, ok := lane.Mempool.(Evictor); ok {
+			evictor.AddEvictHandler(fn)
+		}
+	}
+}
+
+// BlockSpace returns the maximum number of transaction bytes and gas of a block
+// which may be used by the transactions of the lane, given the maximum bytes
+// and gas of the block as defined by the consensus params. A non-positive
+// maximum gas denotes no gas limit, in which case the lane has no gas limit
+// either, i.e. 0 is returned.
+func (l Lane) BlockSpace(maxBytes, maxGas int64) (uint64, uint64) {
+	if maxBytes <= 0 {
+		maxBytes = cmttypes.MaxBlockSizeBytes
+	}
+
+	laneBytes := l.MaxBlockSpace.MulInt64(maxBytes).TruncateInt64()
+
+	var laneGas int64
+	if maxGas > 0 {
+		laneGas = l.MaxBlockSpace.MulInt64(maxGas).TruncateInt64()
+	}
+
+	return uint64(laneBytes), uint64(laneGas)
+}
+
+// nextLane returns an iterator over the transactions of the next non-empty lane,
+// or nil if every lane has been iterated.
+func (i *lanesIterator) nextLane() Iterator {
+	for len(i.lanes) > 0 {
+		lane := i.lanes[0]
+		i.lanes = i.lanes[1:]
+
+		if i.current = lane.Mempool.Select(i.ctx, i.txs); i.current != nil {
+			return i
+		}
+	}
+
+	return nil
+}
+
+func (i *lanesIterator) Next() Iterator {
+	if i.current = i.current.Next(); i.current != nil {
+		return i
+	}
+
+	return i.nextLane()
+}
+
+func (i *lanesIterator) Tx() sdk.Tx {
+	return i.current.Tx()
+}
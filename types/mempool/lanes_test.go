@@ -0,0 +1,107 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestNewLanedMempool(t *testing.T) {
+	lane := func(name string, share math.LegacyDec, match func(sdk.Tx) bool) mempool.Lane {
+		return mempool.Lane{
+			Name:          name,
+			Mempool:       mempool.DefaultPriorityMempool(),
+			MaxBlockSpace: share,
+			Match:         match,
+		}
+	}
+	matchAll := func(sdk.Tx) bool { return true }
+
+	testCases := []struct {
+		name  string
+		lanes []mempool.Lane
+		err   string
+	}{
+		{"no lanes", nil, "no lanes provided"},
+		{"no name", []mempool.Lane{lane("", math.LegacyOneDec(), nil)}, "has no name"},
+		{"duplicate name", []mempool.Lane{lane("a", math.LegacyOneDec(), matchAll), lane("a", math.LegacyOneDec(), nil)}, "duplicate lane a"},
+		{"no mempool", []mempool.Lane{{Name: "a", MaxBlockSpace: math.LegacyOneDec()}}, "has no mempool"},
+		{"nil block space", []mempool.Lane{lane("a", math.LegacyDec{}, nil)}, "max block space"},
+		{"zero block space", []mempool.Lane{lane("a", math.LegacyZeroDec(), nil)}, "max block space"},
+		{"block space above one", []mempool.Lane{lane("a", math.LegacyNewDec(2), nil)}, "max block space"},
+		{"catch-all lane not last", []mempool.Lane{lane("a", math.LegacyOneDec(), nil), lane("b", math.LegacyOneDec(), nil)}, "is not the last lane"},
+		{"valid", []mempool.Lane{lane("a", math.LegacyNewDecWithPrec(1, 1), matchAll), lane("b", math.LegacyOneDec(), nil)}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := mempool.NewLanedMempool(tc.lanes...)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLanedMempool(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	// txs of sender a go to the first lane, every other tx to the second
+	mp, err := mempool.NewLanedMempool(
+		mempool.Lane{
+			Name:          "a",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			MaxBlockSpace: math.LegacyNewDecWithPrec(25, 2),
+			Match:         func(tx sdk.Tx) bool { return tx.(testTx).address.Equals(sa) },
+		},
+		mempool.Lane{
+			Name:          "default",
+			Mempool:       mempool.DefaultPriorityMempool(),
+			MaxBlockSpace: math.LegacyOneDec(),
+		},
+	)
+	require.NoError(t, err)
+	require.Nil(t, mp.Select(ctx, nil))
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sb},
+		{id: 1, priority: 5, nonce: 0, address: sa},
+		{id: 2, priority: 20, nonce: 1, address: sb},
+		{id: 3, priority: 1, nonce: 1, address: sa},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.Equal(t, 4, mp.CountTx())
+	require.Equal(t, 2, mp.Lanes()[0].Mempool.CountTx())
+	require.Equal(t, 0, mp.LaneIndex(txs[1]))
+	require.Equal(t, 1, mp.LaneIndex(txs[0]))
+
+	// the txs of the first lane are selected first, regardless of priority
+	require.Equal(t, []sdk.Tx{txs[1], txs[3], txs[0], txs[2]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[3]))
+	require.ErrorIs(t, mp.Remove(txs[3]), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{txs[0], txs[2]}, fetchTxs(mp.Select(ctx, nil), 1000))
+
+	bytes, gas := mp.Lanes()[0].BlockSpace(1000, 0)
+	require.Equal(t, uint64(250), bytes)
+	require.Equal(t, uint64(0), gas)
+
+	bytes, gas = mp.Lanes()[0].BlockSpace(-1, 1001)
+	require.Equal(t, uint64(26214400), bytes)
+	require.Equal(t, uint64(250), gas)
+}
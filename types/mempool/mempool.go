@@ -2,9 +2,13 @@ package mempool
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 type Mempool interface {
@@ -25,6 +29,16 @@ type Mempool interface {
 	Remove(sdk.Tx) error
 }
 
+// Evictor defines a Mempool evicting transactions by itself, e.g. once they
+// expire or to make room for other transactions.
+type Evictor interface {
+	Mempool
+
+	// AddEvictHandler registers a callback to be called with every transaction
+	// evicted by the mempool.
+	AddEvictHandler(fn func(tx sdk.Tx))
+}
+
 // Iterator defines an app-side mempool iterator interface that is as minimal as
 // possible. The order of iteration is determined by the app-side mempool
 // implementation.
@@ -41,3 +55,56 @@ var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
 )
+
+// txSender returns the first signature of the tx along with the sender and
+// nonce the tx is indexed by in the mempool.
+//
+// The sender and nonce of an ordered tx are the address and sequence of its
+// first signer. An unordered tx is not bound to the sequence of its signer, thus
+// it is indexed as a sender of its own, identified by the address of its first
+// signer followed by the ID of the tx, with its timeout height as nonce, such
+// that it neither replaces nor depends on any other tx.
+func txSender(tx sdk.Tx) (sig signingtypes.SignatureV2, sender string, nonce uint64, err error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return sig, "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return sig, "", 0, err
+	}
+	if len(sigs) == 0 {
+		return sig, "", 0, fmt.Errorf("tx must have at least one signer")
+	}
+
+	sig = sigs[0]
+	sender = sdk.AccAddress(sig.PubKey.Address()).String()
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	if !ok || !unorderedTx.GetUnordered() {
+		return sig, sender, sig.Sequence, nil
+	}
+
+	id, err := unorderedTxID(sig)
+	if err != nil {
+		return sig, "", 0, err
+	}
+
+	return sig, fmt.Sprintf("%s/%X", sender, id), unorderedTx.GetTimeoutHeight(), nil
+}
+
+// unorderedTxID returns the ID of an unordered tx, i.e. the hash of its first
+// signature, which is distinct for every tx signed by the same signer.
+func unorderedTxID(sig signingtypes.SignatureV2) ([]byte, error) {
+	if sig.Data == nil {
+		return nil, fmt.Errorf("unordered tx must be signed")
+	}
+
+	bz, err := signingtypes.SignatureDataToProto(sig.Data).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	id := sha256.Sum256(bz)
+	return id[:], nil
+}
@@ -83,6 +83,31 @@ func (tx testTx) String() string {
 	return fmt.Sprintf("tx a: %s, p: %d, n: %d", tx.address, tx.priority, tx.nonce)
 }
 
+// unorderedTx is a dummy implementation of an unordered Tx used for testing.
+type unorderedTx struct {
+	testTx
+	timeoutHeight uint64
+	signature     []byte
+}
+
+func (tx unorderedTx) GetSignaturesV2() (res []txsigning.SignatureV2, err error) {
+	res = append(res, txsigning.SignatureV2{
+		PubKey: testPubKey{address: tx.address},
+		Data: &txsigning.SingleSignatureData{
+			SignMode:  txsigning.SignMode_SIGN_MODE_DIRECT,
+			Signature: tx.signature,
+		},
+	})
+
+	return res, nil
+}
+
+func (tx unorderedTx) GetTimeoutHeight() uint64 { return tx.timeoutHeight }
+
+func (tx unorderedTx) GetUnordered() bool { return true }
+
+var _ sdk.TxWithUnordered = (*unorderedTx)(nil)
+
 type sigErrTx struct {
 	getSigs func() ([]txsigning.SignatureV2, error)
 }
@@ -2,18 +2,21 @@ package mempool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
+	"time"
 
 	"github.com/huandu/skiplist"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 var (
 	_ Mempool  = (*PriorityNonceMempool[int64])(nil)
+	_ Evictor  = (*PriorityNonceMempool[int64])(nil)
 	_ Iterator = (*PriorityNonceIterator[int64])(nil)
 )
 
@@ -40,6 +43,41 @@ type (
 		//   (sequence number) when evicting transactions.
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
+
+		// MaxBytes sets the maximum total size in bytes of the transactions in the
+		// mempool, where the size of a transaction is the length of the tx bytes of
+		// the context it is inserted with, or of the transaction encoded with
+		// TxEncoder if the context has none. Transactions which cannot be sized are
+		// rejected. If 0, there is no cap on the size of the mempool. Once reached, the lowest priority transactions are evicted along
+		// with the subsequent transactions of their senders to make room for a
+		// transaction of a higher priority, otherwise ErrMempoolTxMaxCapacity is
+		// returned.
+		MaxBytes int64
+
+		// TxEncoder encodes transactions to compute their size when the context
+		// they are inserted with has no tx bytes. It is only used if MaxBytes is
+		// set.
+		TxEncoder sdk.TxEncoder
+
+		// TxTTLBlocks sets the number of blocks a transaction may remain in the
+		// mempool, relative to the height of the context it is inserted with. If 0,
+		// transactions do not expire by height.
+		TxTTLBlocks int64
+
+		// TxTTL sets the wall clock duration a transaction may remain in the
+		// mempool. If 0, transactions do not expire by time.
+		//
+		// NOTE: Replacing a transaction keeps its original insertion height and
+		// time, such that a transaction cannot be kept in the mempool forever by
+		// replacing it.
+		TxTTL time.Duration
+
+		// OnEvict is a callback to be called when a tx is evicted from the mempool,
+		// either because it expired, or to make room for a tx of a higher priority.
+		//
+		// NOTE: Expired transactions are evicted upon Insert and Select, along with
+		// the subsequent transactions of their senders.
+		OnEvict func(tx sdk.Tx)
 	}
 
 	// PriorityNonceMempool is a mempool implementation that stores txs
@@ -56,6 +94,17 @@ type (
 		senderIndices  map[string]*skiplist.SkipList
 		scores         map[txMeta[C]]txMeta[C]
 		cfg            PriorityNonceMempoolConfig[C]
+
+		// ttlIndex orders txs by insertion, if a TTL is configured
+		ttlIndex *skiplist.SkipList
+		// nextSeq is the insertion sequence number of the next tx
+		nextSeq uint64
+		// numBytes is the total size of the txs in the mempool
+		numBytes int64
+		// events dispatches the mempool events to subscribers
+		events eventBus
+		// evictHandlers are called with every evicted tx, after cfg.OnEvict
+		evictHandlers []func(tx sdk.Tx)
 	}
 
 	// PriorityNonceIterator defines an iterator that is used for mempool iteration
@@ -97,6 +146,19 @@ type (
 		weight C
 		// senderElement is a pointer to the transaction's element in the sender index
 		senderElement *skiplist.Element
+		// size is the transaction's size in bytes
+		size int64
+		// seq is the transaction's insertion sequence number
+		seq uint64
+	}
+
+	// ttlEntry stores the insertion height and time of a transaction, indexed by
+	// its insertion sequence number in the ttl index.
+	ttlEntry struct {
+		sender string
+		nonce  uint64
+		height int64
+		time   time.Time
 	}
 )
 
@@ -165,6 +227,10 @@ func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *Priori
 		cfg:            cfg,
 	}
 
+	if cfg.TxTTLBlocks > 0 || cfg.TxTTL > 0 {
+		mp.ttlIndex = skiplist.New(skiplist.Uint64)
+	}
+
 	return mp
 }
 
@@ -195,27 +261,29 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 //
 // Inserting a duplicate tx with a different priority overwrites the existing tx,
 // changing the total order of the mempool.
+//
+// If a byte cap is configured and reached, the lowest priority transactions are
+// evicted, along with the subsequent transactions of their senders, to make room
+// for the transaction if it has a higher priority.
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+	if mp.cfg.MaxTx < 0 {
+		return nil
+	}
+
+	mp.evictExpired(ctx)
+
 	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
 		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
-		return nil
 	}
 
-	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	_, sender, nonce, err := txSender(tx)
 	if err != nil {
 		return err
 	}
-	if len(sigs) == 0 {
-		return fmt.Errorf("tx must have at least one signer")
-	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
 	priority := mp.cfg.TxPriority.GetTxPriority(ctx, tx)
-	nonce := sig.Sequence
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
 	senderIndex, ok := mp.senderIndices[sender]
@@ -236,17 +304,29 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	// This O(log n) remove operation is rare and only happens when a tx's priority
 	// changes.
 	sk := txMeta[C]{nonce: nonce, sender: sender}
-	if oldScore, txExists := mp.scores[sk]; txExists {
-		if mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
-			return fmt.Errorf(
-				"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
-				oldScore.priority,
-				priority,
-				senderIndex.Get(key).Value.(sdk.Tx),
-				tx,
-			)
+	oldScore, txExists := mp.scores[sk]
+	if txExists && mp.cfg.TxReplacement != nil && !mp.cfg.TxReplacement(oldScore.priority, priority, senderIndex.Get(key).Value.(sdk.Tx), tx) {
+		return fmt.Errorf(
+			"tx doesn't fit the replacement rule, oldPriority: %v, newPriority: %v, oldTx: %v, newTx: %v",
+			oldScore.priority,
+			priority,
+			senderIndex.Get(key).Value.(sdk.Tx),
+			tx,
+		)
+	}
+
+	if mp.cfg.MaxBytes > 0 {
+		key.size, err = mp.txSize(ctx, tx)
+		if err != nil {
+			return err
 		}
 
+		if !mp.makeRoom(key.size-oldScore.size, priority, sender) {
+			return ErrMempoolTxMaxCapacity
+		}
+	}
+
+	if txExists {
 		mp.priorityIndex.Remove(txMeta[C]{
 			nonce:    nonce,
 			sender:   sender,
@@ -254,20 +334,171 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 			weight:   oldScore.weight,
 		})
 		mp.priorityCounts[oldScore.priority]--
+		mp.numBytes -= oldScore.size
 	}
 
 	mp.priorityCounts[priority]++
+	mp.numBytes += key.size
+
+	// a replaced tx keeps its ttl entry, i.e. its original insertion height and
+	// time
+	if txExists {
+		key.seq = oldScore.seq
+	} else if mp.ttlIndex != nil {
+		key.seq = mp.nextSeq
+		mp.nextSeq++
+
+		mp.ttlIndex.Set(key.seq, ttlEntry{
+			sender: sender,
+			nonce:  nonce,
+			height: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+			time:   time.Now(),
+		})
+	}
 
 	// Since senderIndex is scored by nonce, a changed priority will overwrite the
 	// existing key.
 	key.senderElement = senderIndex.Set(key, tx)
 
-	mp.scores[sk] = txMeta[C]{priority: priority}
+	mp.scores[sk] = txMeta[C]{priority: priority, size: key.size, seq: key.seq}
 	mp.priorityIndex.Set(key, tx)
 
+	mp.emit(EventInsert, tx, sender, nonce, priority)
+
 	return nil
 }
 
+// txSize returns the size in bytes of the given tx, i.e. the length of the tx
+// bytes of ctx, or of the tx encoded with the configured TxEncoder if ctx has
+// none. An error is returned if the tx cannot be sized.
+func (mp *PriorityNonceMempool[C]) txSize(ctx context.Context, tx sdk.Tx) (int64, error) {
+	if txBytes := sdk.UnwrapSDKContext(ctx).TxBytes(); len(txBytes) > 0 {
+		return int64(len(txBytes)), nil
+	}
+
+	if mp.cfg.TxEncoder == nil {
+		return 0, errors.New("cannot compute the tx size: no tx bytes in context and no tx encoder configured")
+	}
+
+	txBytes, err := mp.cfg.TxEncoder(tx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to encode tx: %w", err)
+	}
+	if len(txBytes) == 0 {
+		return 0, errors.New("cannot compute the tx size: empty encoded tx")
+	}
+
+	return int64(len(txBytes)), nil
+}
+
+// makeRoom evicts the lowest priority txs, along with the subsequent txs of
+// their senders, until the given number of bytes fits within the byte cap. Only
+// txs of a lower priority than the given one, and of other senders than the
+// given one, are evicted. It returns false, without evicting any tx, if not
+// enough room can be made.
+func (mp *PriorityNonceMempool[C]) makeRoom(size int64, priority C, sender string) bool {
+	excess := mp.numBytes + size - mp.cfg.MaxBytes
+	if excess <= 0 {
+		return true
+	}
+
+	var (
+		// evictFrom maps every sender to evict from to the lowest nonce evicted
+		evictFrom = make(map[string]uint64)
+		freed     = make(map[string]int64)
+		numFreed  int64
+	)
+
+	for node := mp.priorityIndex.Back(); node != nil && numFreed < excess; node = node.Prev() {
+		key := node.Key().(txMeta[C])
+		if mp.cfg.TxPriority.Compare(key.priority, priority) >= 0 {
+			break
+		}
+
+		if key.sender == sender {
+			continue
+		}
+
+		if nonce, ok := evictFrom[key.sender]; ok && nonce <= key.nonce {
+			continue
+		}
+
+		evictFrom[key.sender] = key.nonce
+		numFreed -= freed[key.sender]
+		freed[key.sender] = 0
+
+		for cursor := mp.senderIndices[key.sender].Front(); cursor != nil; cursor = cursor.Next() {
+			if nonce := cursor.Key().(txMeta[C]).nonce; nonce >= key.nonce {
+				freed[key.sender] += mp.scores[txMeta[C]{nonce: nonce, sender: key.sender}].size
+			}
+		}
+		numFreed += freed[key.sender]
+	}
+
+	if numFreed < excess {
+		return false
+	}
+
+	for sender, nonce := range evictFrom {
+		mp.evictSenderTxs(sender, nonce)
+	}
+
+	return true
+}
+
+// evictExpired evicts every tx which exceeded its TTL, along with the subsequent
+// txs of its sender.
+func (mp *PriorityNonceMempool[C]) evictExpired(ctx context.Context) {
+	if mp.ttlIndex == nil || mp.ttlIndex.Len() == 0 {
+		return
+	}
+
+	var (
+		height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+		now    = time.Now()
+	)
+
+	// txs are indexed in insertion order, thus by ascending height and time
+	for node := mp.ttlIndex.Front(); node != nil; node = mp.ttlIndex.Front() {
+		entry := node.Value.(ttlEntry)
+
+		expiredHeight := mp.cfg.TxTTLBlocks > 0 && height > entry.height+mp.cfg.TxTTLBlocks
+		expiredTime := mp.cfg.TxTTL > 0 && now.Sub(entry.time) > mp.cfg.TxTTL
+		if !expiredHeight && !expiredTime {
+			return
+		}
+
+		mp.evictSenderTxs(entry.sender, entry.nonce)
+	}
+}
+
+// evictSenderTxs evicts every tx of the sender with a nonce greater than or
+// equal to the given one.
+func (mp *PriorityNonceMempool[C]) evictSenderTxs(sender string, nonce uint64) {
+	var keys []txMeta[C]
+	for cursor := mp.senderIndices[sender].Front(); cursor != nil; cursor = cursor.Next() {
+		if key := cursor.Key().(txMeta[C]); key.nonce >= nonce {
+			keys = append(keys, key)
+		}
+	}
+
+	for _, key := range keys {
+		tx, err := mp.remove(sender, key.nonce)
+		if err != nil {
+			continue
+		}
+
+		if mp.cfg.OnEvict != nil {
+			mp.cfg.OnEvict(tx)
+		}
+		for _, fn := range mp.evictHandlers {
+			fn(tx)
+		}
+
+		mp.emit(EventEvict, tx, sender, key.nonce, key.priority)
+	}
+}
+
 func (i *PriorityNonceIterator[C]) iteratePriority() Iterator {
 	// beginning of priority iteration
 	if i.priorityNode == nil {
@@ -342,11 +573,16 @@ func (i *PriorityNonceIterator[C]) Tx() sdk.Tx {
 // The maxBytes parameter defines the maximum number of bytes of transactions to
 // return.
 //
+// Expired transactions are evicted before creating the iterator.
+//
 // NOTE: It is not safe to use this iterator while removing transactions from
 // the underlying mempool.
-func (mp *PriorityNonceMempool[C]) Select(_ context.Context, _ [][]byte) Iterator {
+func (mp *PriorityNonceMempool[C]) Select(ctx context.Context, _ [][]byte) Iterator {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
+
+	mp.evictExpired(ctx)
+
 	if mp.priorityIndex.Len() == 0 {
 		return nil
 	}
@@ -425,36 +661,116 @@ func (mp *PriorityNonceMempool[C]) CountTx() int {
 func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	_, sender, nonce, err := txSender(tx)
 	if err != nil {
 		return err
 	}
-	if len(sigs) == 0 {
-		return fmt.Errorf("attempted to remove a tx with no signatures")
+
+	priority := mp.scores[txMeta[C]{nonce: nonce, sender: sender}].priority
+	removed, err := mp.remove(sender, nonce)
+	if err != nil {
+		return err
 	}
 
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
+	mp.emit(EventRemove, removed, sender, nonce, priority)
 
+	return nil
+}
+
+// remove removes the tx of the given sender and nonce from every index of the
+// mempool, returning the removed tx.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) (sdk.Tx, error) {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
-		return ErrTxNotFound
+		return nil, ErrTxNotFound
 	}
 	tk := txMeta[C]{nonce: nonce, priority: score.priority, sender: sender, weight: score.weight}
 
 	senderTxs, ok := mp.senderIndices[sender]
 	if !ok {
-		return fmt.Errorf("sender %s not found", sender)
+		return nil, fmt.Errorf("sender %s not found", sender)
 	}
 
 	mp.priorityIndex.Remove(tk)
-	senderTxs.Remove(tk)
+	elem := senderTxs.Remove(tk)
 	delete(mp.scores, scoreKey)
 	mp.priorityCounts[score.priority]--
+	mp.numBytes -= score.size
 
-	return nil
+	if mp.ttlIndex != nil {
+		mp.ttlIndex.Remove(score.seq)
+	}
+
+	return elem.Value.(sdk.Tx), nil
+}
+
+// PendingTxs returns the txs of the given sender ordered by nonce, or every tx
+// of the mempool ordered by sender and nonce if the sender is empty.
+func (mp *PriorityNonceMempool[C]) PendingTxs(sender string) []PendingTx {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	senders := []string{sender}
+	if sender == "" {
+		senders = make([]string, 0, len(mp.senderIndices))
+		for s := range mp.senderIndices {
+			senders = append(senders, s)
+		}
+		sort.Strings(senders)
+	}
+
+	var txs []PendingTx
+	for _, s := range senders {
+		senderIndex, ok := mp.senderIndices[s]
+		if !ok {
+			continue
+		}
+
+		for cursor := senderIndex.Front(); cursor != nil; cursor = cursor.Next() {
+			key := cursor.Key().(txMeta[C])
+			txs = append(txs, PendingTx{
+				Tx:       cursor.Value.(sdk.Tx),
+				Sender:   s,
+				Nonce:    key.nonce,
+				Priority: fmt.Sprint(key.priority),
+			})
+		}
+	}
+
+	return txs
+}
+
+// AddEvictHandler implements the Evictor interface. Handlers are called after
+// the OnEvict callback of the config, while the mempool is locked.
+func (mp *PriorityNonceMempool[C]) AddEvictHandler(fn func(tx sdk.Tx)) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictHandlers = append(mp.evictHandlers, fn)
+}
+
+// Subscribe returns a channel receiving the insert, remove and evict events of
+// the mempool, see Inspector.Subscribe.
+func (mp *PriorityNonceMempool[C]) Subscribe(bufferSize int) (<-chan Event, func()) {
+	return mp.events.subscribe(bufferSize)
+}
+
+// emit publishes an event of the given tx to the subscribers of the mempool.
+func (mp *PriorityNonceMempool[C]) emit(eventType EventType, tx sdk.Tx, sender string, nonce uint64, priority C) {
+	if !mp.events.active() {
+		return
+	}
+
+	mp.events.publish(Event{
+		Type: eventType,
+		PendingTx: PendingTx{
+			Tx:       tx,
+			Sender:   sender,
+			Nonce:    nonce,
+			Priority: fmt.Sprint(priority),
+		},
+	})
 }
 
 func IsEmpty[C comparable](mempool Mempool) error {
@@ -0,0 +1,105 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriorityNonceOrderVerifier verifies that a sequence of txs, e.g. the txs of a
+// block proposal, is ordered as a PriorityNonceMempool with the same TxPriority
+// selects txs, as per the rules of priority_nonce_spec.md:
+//
+//  1. The txs of a given sender are ordered by nonce.
+//  2. A tx is never preceded by a tx of a lower priority, unless the tx could
+//     not be selected before it without violating sender-nonce order, i.e. if
+//     the tx of lower priority precedes the previous tx of the same sender.
+//
+// The txs are verified one after the other, such that it must be cleared before
+// verifying another sequence.
+//
+// NOTE: Priorities are derived when verifying the txs, rather than when they
+// were inserted into the mempool of the proposer, thus the TxPriority must not
+// depend on state changing in between in a way that alters the relative order
+// of txs.
+type PriorityNonceOrderVerifier[C comparable] struct {
+	txPriority TxPriority[C]
+
+	// count is the number of txs verified so far
+	count int
+	// lowest holds the txs verified so far whose priority is lower than the
+	// priority of every subsequent tx, ordered by position and thus strictly
+	// increasing in priority, such that the lowest priority of the txs from a
+	// given position onwards is the first one from that position.
+	lowest []orderedTx[C]
+	// senders holds the position and nonce of the last tx of every sender
+	senders map[string]orderedTx[C]
+}
+
+// orderedTx defines the position of a tx within the verified sequence, along
+// with its priority and nonce.
+type orderedTx[C comparable] struct {
+	pos      int
+	priority C
+	nonce    uint64
+}
+
+// NewPriorityNonceOrderVerifier returns a PriorityNonceOrderVerifier deriving the
+// priority of txs from the given TxPriority.
+func NewPriorityNonceOrderVerifier[C comparable](txPriority TxPriority[C]) *PriorityNonceOrderVerifier[C] {
+	return &PriorityNonceOrderVerifier[C]{
+		txPriority: txPriority,
+		senders:    make(map[string]orderedTx[C]),
+	}
+}
+
+// VerifyTx verifies that the tx may follow the txs verified so far. The priority
+// of the tx is derived from the given context as in Insert, thus the context
+// must be the one the tx would be inserted into the mempool with, i.e. once it
+// passed the AnteHandler.
+func (v *PriorityNonceOrderVerifier[C]) VerifyTx(ctx context.Context, tx sdk.Tx) error {
+	_, sender, nonce, err := txSender(tx)
+	if err != nil {
+		return err
+	}
+
+	priority := v.txPriority.GetTxPriority(ctx, tx)
+
+	// the position from which the tx could have been selected
+	selectable := 0
+	if last, ok := v.senders[sender]; ok {
+		if nonce <= last.nonce {
+			return fmt.Errorf("tx of sender %s with nonce %d follows nonce %d", sender, nonce, last.nonce)
+		}
+
+		selectable = last.pos + 1
+	}
+
+	i := sort.Search(len(v.lowest), func(i int) bool { return v.lowest[i].pos >= selectable })
+	if i < len(v.lowest) && v.txPriority.Compare(v.lowest[i].priority, priority) < 0 {
+		return fmt.Errorf(
+			"tx of sender %s with priority %v follows tx at position %d with lower priority %v",
+			sender, priority, v.lowest[i].pos, v.lowest[i].priority,
+		)
+	}
+
+	for len(v.lowest) > 0 && v.txPriority.Compare(v.lowest[len(v.lowest)-1].priority, priority) >= 0 {
+		v.lowest = v.lowest[:len(v.lowest)-1]
+	}
+
+	ordered := orderedTx[C]{pos: v.count, priority: priority, nonce: nonce}
+	v.lowest = append(v.lowest, ordered)
+	v.senders[sender] = ordered
+	v.count++
+
+	return nil
+}
+
+// Clear clears the txs verified so far.
+func (v *PriorityNonceOrderVerifier[C]) Clear() {
+	v.count = 0
+	v.lowest = nil
+	v.senders = make(map[string]orderedTx[C])
+}
@@ -0,0 +1,109 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func TestPriorityNonceOrderVerifier(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	verify := func(txs []testTx) error {
+		v := mempool.NewPriorityNonceOrderVerifier(mempool.NewDefaultTxPriority())
+		for _, tx := range txs {
+			if err := v.VerifyTx(ctx.WithPriority(tx.priority), tx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	testCases := []struct {
+		name  string
+		txs   []testTx
+		valid bool
+	}{
+		{
+			"ordered by priority",
+			[]testTx{{priority: 20, nonce: 0, address: sa}, {priority: 15, nonce: 0, address: sb}, {priority: 15, nonce: 1, address: sa}},
+			true,
+		},
+		{
+			"lower priority first",
+			[]testTx{{priority: 15, nonce: 0, address: sb}, {priority: 20, nonce: 0, address: sa}},
+			false,
+		},
+		{
+			// case 1 of priority_nonce_spec.md
+			"gated by sender nonce",
+			[]testTx{
+				{priority: 20, nonce: 0, address: sa},
+				{priority: 15, nonce: 0, address: sb},
+				{priority: 6, nonce: 1, address: sa},
+				{priority: 8, nonce: 2, address: sa},
+				{priority: 21, nonce: 3, address: sa},
+			},
+			true,
+		},
+		{
+			"higher priority of sender not selected once selectable",
+			[]testTx{
+				{priority: 1, nonce: 0, address: sb},
+				{priority: 5, nonce: 0, address: sa},
+				{priority: 9, nonce: 1, address: sb},
+			},
+			false,
+		},
+		{
+			"out of nonce order",
+			[]testTx{{priority: 20, nonce: 1, address: sa}, {priority: 15, nonce: 0, address: sa}},
+			false,
+		},
+		{
+			"duplicate nonce",
+			[]testTx{{priority: 20, nonce: 0, address: sa}, {priority: 20, nonce: 0, address: sa}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := verify(tc.txs)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPriorityNonceOrderVerifier_MempoolOrder(t *testing.T) {
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	v := mempool.NewPriorityNonceOrderVerifier(mempool.NewDefaultTxPriority())
+
+	// every order selected by the mempool is valid
+	for seed := int64(0); seed < 20; seed++ {
+		mp := mempool.DefaultPriorityMempool()
+		for _, tx := range genRandomTxs(seed, 200, 10) {
+			require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+		}
+
+		v.Clear()
+		for itr := mp.Select(ctx, nil); itr != nil; itr = itr.Next() {
+			tx := itr.Tx().(testTx)
+			require.NoError(t, v.VerifyTx(ctx.WithPriority(tx.priority), tx), "seed %d", seed)
+		}
+	}
+}
@@ -675,6 +675,35 @@ func TestPriorityNonceMempool_NextSenderTx(t *testing.T) {
 	require.Equal(t, txs[0], tx)
 }
 
+func TestPriorityNonceMempool_UnorderedTxs(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	acc := accounts[0].Address
+
+	mp := mempool.DefaultPriorityMempool()
+
+	ordered := testTx{id: 0, priority: 10, nonce: 0, address: acc}
+	unordered := []unorderedTx{
+		{testTx: testTx{id: 1, priority: 30, address: acc}, timeoutHeight: 10, signature: []byte("a")},
+		{testTx: testTx{id: 2, priority: 20, address: acc}, timeoutHeight: 10, signature: []byte("b")},
+	}
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(ordered.priority), ordered))
+	for _, tx := range unordered {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// unordered txs of the same signer neither replace each other nor follow
+	// the ordered txs of the signer
+	require.Equal(t, 3, mp.CountTx())
+	require.Equal(t, []sdk.Tx{unordered[0], unordered[1], ordered}, fetchTxs(mp.Select(ctx, nil), 1000))
+	require.Equal(t, ordered, mp.NextSenderTx(acc.String()))
+
+	require.NoError(t, mp.Remove(unordered[0]))
+	require.ErrorIs(t, mp.Remove(unordered[0]), mempool.ErrTxNotFound)
+	require.Equal(t, []sdk.Tx{unordered[1], ordered}, fetchTxs(mp.Select(ctx, nil), 1000))
+}
+
 func TestNextSenderTx_TxLimit(t *testing.T) {
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
 	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
@@ -804,3 +833,166 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestPriorityNonceMempool_MaxBytes(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb, sc := accounts[0].Address, accounts[1].Address, accounts[2].Address
+
+	var evicted []sdk.Tx
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxBytes:   100,
+			OnEvict:    func(tx sdk.Tx) { evicted = append(evicted, tx) },
+		},
+	)
+
+	insert := func(tx testTx, size int) error {
+		c := ctx.WithPriority(tx.priority).WithTxBytes(make([]byte, size))
+		return mp.Insert(c, tx)
+	}
+
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 50, nonce: 1, address: sa},
+		{id: 2, priority: 20, nonce: 0, address: sb},
+		{id: 3, priority: 30, nonce: 0, address: sc},
+	}
+	for _, tx := range txs {
+		require.NoError(t, insert(tx, 25))
+	}
+	require.Equal(t, 4, mp.CountTx())
+
+	// a tx larger than the cap never fits
+	require.ErrorIs(t, insert(testTx{id: 4, priority: 100, nonce: 1, address: sb}, 101), mempool.ErrMempoolTxMaxCapacity)
+
+	// a tx of a lower priority than every tx does not evict any tx
+	require.ErrorIs(t, insert(testTx{id: 5, priority: 5, nonce: 1, address: sc}, 25), mempool.ErrMempoolTxMaxCapacity)
+	require.Empty(t, evicted)
+	require.Equal(t, 4, mp.CountTx())
+
+	// the lowest priority tx is evicted along with the subsequent tx of its
+	// sender, even though the latter has a higher priority
+	require.NoError(t, insert(testTx{id: 6, priority: 40, nonce: 1, address: sc}, 25))
+	require.Equal(t, []sdk.Tx{txs[0], txs[1]}, evicted)
+	require.Equal(t, 3, mp.CountTx())
+
+	// txs of the same sender are never evicted to make room
+	evicted = nil
+	require.NoError(t, insert(testTx{id: 7, priority: 60, nonce: 2, address: sc}, 40))
+	require.Equal(t, []sdk.Tx{txs[2]}, evicted)
+	require.Equal(t, 3, mp.CountTx())
+
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 1000) {
+		require.Equal(t, sc, tx.(testTx).address)
+	}
+
+	// a tx cannot be sized without tx bytes nor tx encoder
+	require.Error(t, mp.Insert(ctx.WithPriority(100), testTx{id: 8, priority: 100, nonce: 3, address: sc}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_MaxBytesTxEncoder(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 1)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority: mempool.NewDefaultTxPriority(),
+			MaxBytes:   100,
+			TxEncoder: func(tx sdk.Tx) ([]byte, error) {
+				if tx.(testTx).id < 0 {
+					return nil, nil
+				}
+				return make([]byte, 40), nil
+			},
+		},
+	)
+
+	// txs are sized with the tx encoder when the context has no tx bytes
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, nonce: 0, address: sa}))
+	require.NoError(t, mp.Insert(ctx, testTx{id: 1, nonce: 1, address: sa}))
+	require.ErrorIs(t, mp.Insert(ctx, testTx{id: 2, nonce: 2, address: sa}), mempool.ErrMempoolTxMaxCapacity)
+
+	// the tx bytes of the context take precedence
+	require.NoError(t, mp.Insert(ctx.WithTxBytes(make([]byte, 20)), testTx{id: 3, nonce: 2, address: sa}))
+
+	// an empty encoded tx is rejected
+	require.Error(t, mp.Insert(ctx, testTx{id: -1, nonce: 3, address: sa}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityNonceMempool_TTL(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa, sb := accounts[0].Address, accounts[1].Address
+
+	t.Run("blocks", func(t *testing.T) {
+		var evicted []sdk.Tx
+		mp := mempool.NewPriorityMempool(
+			mempool.PriorityNonceMempoolConfig[int64]{
+				TxPriority:  mempool.NewDefaultTxPriority(),
+				TxTTLBlocks: 2,
+				OnEvict:     func(tx sdk.Tx) { evicted = append(evicted, tx) },
+			},
+		)
+
+		txs := []testTx{
+			{id: 0, priority: 10, nonce: 0, address: sa},
+			{id: 1, priority: 20, nonce: 0, address: sb},
+			{id: 2, priority: 30, nonce: 1, address: sa},
+		}
+		for i, tx := range txs {
+			require.NoError(t, mp.Insert(ctx.WithBlockHeight(int64(i+1)).WithPriority(tx.priority), tx))
+		}
+
+		// the first tx is valid up to height 3
+		require.Equal(t, 3, len(fetchTxs(mp.Select(ctx.WithBlockHeight(3), nil), 1000)))
+		require.Empty(t, evicted)
+
+		// the first tx expires, along with the subsequent tx of its sender
+		require.Equal(t, []sdk.Tx{txs[1]}, fetchTxs(mp.Select(ctx.WithBlockHeight(4), nil), 1000))
+		require.Equal(t, []sdk.Tx{txs[0], txs[2]}, evicted)
+
+		require.Nil(t, mp.Select(ctx.WithBlockHeight(5), nil))
+		require.NoError(t, mempool.IsEmpty[int64](mp))
+	})
+
+	t.Run("replacement", func(t *testing.T) {
+		mp := mempool.NewPriorityMempool(
+			mempool.PriorityNonceMempoolConfig[int64]{
+				TxPriority:  mempool.NewDefaultTxPriority(),
+				TxTTLBlocks: 2,
+			},
+		)
+
+		require.NoError(t, mp.Insert(ctx.WithBlockHeight(1).WithPriority(10), testTx{id: 0, priority: 10, nonce: 0, address: sa}))
+
+		// replacing the tx keeps its original insertion height
+		replacement := testTx{id: 1, priority: 20, nonce: 0, address: sa}
+		require.NoError(t, mp.Insert(ctx.WithBlockHeight(3).WithPriority(20), replacement))
+		require.Equal(t, []sdk.Tx{replacement}, fetchTxs(mp.Select(ctx.WithBlockHeight(3), nil), 1000))
+
+		require.Nil(t, mp.Select(ctx.WithBlockHeight(4), nil))
+		require.NoError(t, mempool.IsEmpty[int64](mp))
+	})
+
+	t.Run("time", func(t *testing.T) {
+		mp := mempool.NewPriorityMempool(
+			mempool.PriorityNonceMempoolConfig[int64]{
+				TxPriority: mempool.NewDefaultTxPriority(),
+				TxTTL:      50 * time.Millisecond,
+			},
+		)
+
+		require.NoError(t, mp.Insert(ctx, testTx{id: 0, priority: 10, nonce: 0, address: sa}))
+		time.Sleep(100 * time.Millisecond)
+
+		// inserting a tx evicts the expired one
+		require.NoError(t, mp.Insert(ctx, testTx{id: 1, priority: 10, nonce: 0, address: sb}))
+		require.Equal(t, 1, mp.CountTx())
+		require.Equal(t, sb, mp.Select(ctx, nil).Tx().(testTx).address)
+	})
+}
@@ -4,14 +4,13 @@ import (
 	"context"
 	crand "crypto/rand" // #nosec // crypto/rand is used for seed generation
 	"encoding/binary"
-	"fmt"
 	"math/rand" // #nosec // math/rand is used for random selection and seeded from crypto/rand
+	"sort"
 	"sync"
 
 	"github.com/huandu/skiplist"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 )
 
 var (
@@ -37,6 +36,7 @@ type SenderNonceMempool struct {
 	rnd        *rand.Rand
 	maxTx      int
 	existingTx map[txKey]bool
+	events     eventBus
 }
 
 type SenderNonceOptions func(*SenderNonceMempool)
@@ -127,17 +127,10 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 		return nil
 	}
 
-	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	_, sender, nonce, err := txSender(tx)
 	if err != nil {
 		return err
 	}
-	if len(sigs) == 0 {
-		return fmt.Errorf("tx must have at least one signer")
-	}
-
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
@@ -150,6 +143,8 @@ func (snm *SenderNonceMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	key := txKey{nonce: nonce, address: sender}
 	snm.existingTx[key] = true
 
+	snm.emit(EventInsert, tx, sender, nonce)
+
 	return nil
 }
 
@@ -200,17 +195,10 @@ func (snm *SenderNonceMempool) CountTx() int {
 func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 	snm.mtx.Lock()
 	defer snm.mtx.Unlock()
-	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
+	_, sender, nonce, err := txSender(tx)
 	if err != nil {
 		return err
 	}
-	if len(sigs) == 0 {
-		return fmt.Errorf("tx must have at least one signer")
-	}
-
-	sig := sigs[0]
-	sender := sdk.AccAddress(sig.PubKey.Address()).String()
-	nonce := sig.Sequence
 
 	senderTxs, found := snm.senders[sender]
 	if !found {
@@ -229,9 +217,63 @@ func (snm *SenderNonceMempool) Remove(tx sdk.Tx) error {
 	key := txKey{nonce: nonce, address: sender}
 	delete(snm.existingTx, key)
 
+	snm.emit(EventRemove, res.Value.(sdk.Tx), sender, nonce)
+
 	return nil
 }
 
+// PendingTxs returns the txs of the given sender ordered by nonce, or every tx
+// of the mempool ordered by sender and nonce if the sender is empty.
+func (snm *SenderNonceMempool) PendingTxs(sender string) []PendingTx {
+	snm.mtx.Lock()
+	defer snm.mtx.Unlock()
+
+	senders := []string{sender}
+	if sender == "" {
+		senders = make([]string, 0, len(snm.senders))
+		for s := range snm.senders {
+			senders = append(senders, s)
+		}
+		sort.Strings(senders)
+	}
+
+	var txs []PendingTx
+	for _, s := range senders {
+		senderTxs, ok := snm.senders[s]
+		if !ok {
+			continue
+		}
+
+		for cursor := senderTxs.Front(); cursor != nil; cursor = cursor.Next() {
+			txs = append(txs, PendingTx{
+				Tx:     cursor.Value.(sdk.Tx),
+				Sender: s,
+				Nonce:  cursor.Key().(uint64),
+			})
+		}
+	}
+
+	return txs
+}
+
+// Subscribe returns a channel receiving the insert and remove events of the
+// mempool, see Inspector.Subscribe.
+func (snm *SenderNonceMempool) Subscribe(bufferSize int) (<-chan Event, func()) {
+	return snm.events.subscribe(bufferSize)
+}
+
+// emit publishes an event of the given tx to the subscribers of the mempool.
+func (snm *SenderNonceMempool) emit(eventType EventType, tx sdk.Tx, sender string, nonce uint64) {
+	if !snm.events.active() {
+		return
+	}
+
+	snm.events.publish(Event{
+		Type:      eventType,
+		PendingTx: PendingTx{Tx: tx, Sender: sender, Nonce: nonce},
+	})
+}
+
 type senderNonceMempoolIterator struct {
 	rnd           *rand.Rand
 	currentTx     *skiplist.Element
@@ -31,6 +31,14 @@ func (r Result) GetEvents() Events {
 	return events
 }
 
+// SimulationResult defines the outcome of simulating a tx, i.e. the gas it
+// wanted and used along with either its result or the error it failed with.
+type SimulationResult struct {
+	GasInfo GasInfo
+	Result  *Result
+	Err     error
+}
+
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
@@ -499,6 +499,194 @@ func (m *SimulateResponse) GetResult() *types.Result {
 	return nil
 }
 
+// SimulateBundleRequest is the request type for the Service.SimulateBundle
+// RPC method.
+type SimulateBundleRequest struct {
+	// txs_bytes are the raw transactions of the bundle, in execution order.
+	TxsBytes [][]byte `protobuf:"bytes,1,rep,name=txs_bytes,json=txsBytes,proto3" json:"txs_bytes,omitempty"`
+}
+
+func (m *SimulateBundleRequest) Reset()         { *m = SimulateBundleRequest{} }
+func (m *SimulateBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleRequest) ProtoMessage()    {}
+func (*SimulateBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{6}
+}
+func (m *SimulateBundleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleRequest.Merge(m, src)
+}
+func (m *SimulateBundleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleRequest proto.InternalMessageInfo
+
+func (m *SimulateBundleRequest) GetTxsBytes() [][]byte {
+	if m != nil {
+		return m.TxsBytes
+	}
+	return nil
+}
+
+// SimulateBundleResponse is the response type for the Service.SimulateBundle
+// RPC method.
+type SimulateBundleResponse struct {
+	// results are the results of the simulation of every transaction of the
+	// bundle, in execution order.
+	Results []*SimulateBundleTxResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// gas_info is the total gas wanted and used by the transactions of the
+	// bundle.
+	GasInfo *types.GasInfo `protobuf:"bytes,2,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+}
+
+func (m *SimulateBundleResponse) Reset()         { *m = SimulateBundleResponse{} }
+func (m *SimulateBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleResponse) ProtoMessage()    {}
+func (*SimulateBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{7}
+}
+func (m *SimulateBundleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleResponse.Merge(m, src)
+}
+func (m *SimulateBundleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleResponse proto.InternalMessageInfo
+
+func (m *SimulateBundleResponse) GetResults() []*SimulateBundleTxResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SimulateBundleResponse) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+// SimulateBundleTxResult is the result of the simulation of a transaction of a
+// bundle. The state changes of a failed transaction are discarded, as in a block.
+type SimulateBundleTxResult struct {
+	// gas_info is the information about gas used in the simulation.
+	GasInfo *types.GasInfo `protobuf:"bytes,1,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// result is the result of the simulation, unset if the transaction failed.
+	Result *types.Result `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// codespace is the namespace of the error code, if the transaction failed.
+	Codespace string `protobuf:"bytes,3,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	// code is the error code, if the transaction failed.
+	Code uint32 `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	// error is the error message, if the transaction failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SimulateBundleTxResult) Reset()         { *m = SimulateBundleTxResult{} }
+func (m *SimulateBundleTxResult) String() string { return proto.CompactTextString(m) }
+func (*SimulateBundleTxResult) ProtoMessage()    {}
+func (*SimulateBundleTxResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0b00a618705eca7, []int{8}
+}
+func (m *SimulateBundleTxResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateBundleTxResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulateBundleTxResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SimulateBundleTxResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateBundleTxResult.Merge(m, src)
+}
+func (m *SimulateBundleTxResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateBundleTxResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateBundleTxResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateBundleTxResult proto.InternalMessageInfo
+
+func (m *SimulateBundleTxResult) GetGasInfo() *types.GasInfo {
+	if m != nil {
+		return m.GasInfo
+	}
+	return nil
+}
+
+func (m *SimulateBundleTxResult) GetResult() *types.Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SimulateBundleTxResult) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *SimulateBundleTxResult) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SimulateBundleTxResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // GetTxRequest is the request type for the Service.GetTx
 // RPC method.
 type GetTxRequest struct {
@@ -510,7 +698,7 @@ func (m *GetTxRequest) Reset()         { *m = GetTxRequest{} }
 func (m *GetTxRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTxRequest) ProtoMessage()    {}
 func (*GetTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{6}
+	return fileDescriptor_e0b00a618705eca7, []int{9}
 }
 func (m *GetTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -558,7 +746,7 @@ func (m *GetTxResponse) Reset()         { *m = GetTxResponse{} }
 func (m *GetTxResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTxResponse) ProtoMessage()    {}
 func (*GetTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{7}
+	return fileDescriptor_e0b00a618705eca7, []int{10}
 }
 func (m *GetTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -616,7 +804,7 @@ func (m *GetBlockWithTxsRequest) Reset()         { *m = GetBlockWithTxsRequest{}
 func (m *GetBlockWithTxsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsRequest) ProtoMessage()    {}
 func (*GetBlockWithTxsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{8}
+	return fileDescriptor_e0b00a618705eca7, []int{11}
 }
 func (m *GetBlockWithTxsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,7 +864,7 @@ func (m *GetBlockWithTxsResponse) Reset()         { *m = GetBlockWithTxsResponse
 func (m *GetBlockWithTxsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBlockWithTxsResponse) ProtoMessage()    {}
 func (*GetBlockWithTxsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{9}
+	return fileDescriptor_e0b00a618705eca7, []int{12}
 }
 func (m *GetBlockWithTxsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,7 +934,7 @@ func (m *TxDecodeRequest) Reset()         { *m = TxDecodeRequest{} }
 func (m *TxDecodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeRequest) ProtoMessage()    {}
 func (*TxDecodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{10}
+	return fileDescriptor_e0b00a618705eca7, []int{13}
 }
 func (m *TxDecodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +983,7 @@ func (m *TxDecodeResponse) Reset()         { *m = TxDecodeResponse{} }
 func (m *TxDecodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeResponse) ProtoMessage()    {}
 func (*TxDecodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{11}
+	return fileDescriptor_e0b00a618705eca7, []int{14}
 }
 func (m *TxDecodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -844,7 +1032,7 @@ func (m *TxEncodeRequest) Reset()         { *m = TxEncodeRequest{} }
 func (m *TxEncodeRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeRequest) ProtoMessage()    {}
 func (*TxEncodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{12}
+	return fileDescriptor_e0b00a618705eca7, []int{15}
 }
 func (m *TxEncodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -893,7 +1081,7 @@ func (m *TxEncodeResponse) Reset()         { *m = TxEncodeResponse{} }
 func (m *TxEncodeResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeResponse) ProtoMessage()    {}
 func (*TxEncodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{13}
+	return fileDescriptor_e0b00a618705eca7, []int{16}
 }
 func (m *TxEncodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1129,7 @@ func (m *TxEncodeAminoRequest) Reset()         { *m = TxEncodeAminoRequest{} }
 func (m *TxEncodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoRequest) ProtoMessage()    {}
 func (*TxEncodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{14}
+	return fileDescriptor_e0b00a618705eca7, []int{17}
 }
 func (m *TxEncodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1177,7 @@ func (m *TxEncodeAminoResponse) Reset()         { *m = TxEncodeAminoResponse{} }
 func (m *TxEncodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxEncodeAminoResponse) ProtoMessage()    {}
 func (*TxEncodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{15}
+	return fileDescriptor_e0b00a618705eca7, []int{18}
 }
 func (m *TxEncodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1037,7 +1225,7 @@ func (m *TxDecodeAminoRequest) Reset()         { *m = TxDecodeAminoRequest{} }
 func (m *TxDecodeAminoRequest) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoRequest) ProtoMessage()    {}
 func (*TxDecodeAminoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{16}
+	return fileDescriptor_e0b00a618705eca7, []int{19}
 }
 func (m *TxDecodeAminoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1273,7 @@ func (m *TxDecodeAminoResponse) Reset()         { *m = TxDecodeAminoResponse{} }
 func (m *TxDecodeAminoResponse) String() string { return proto.CompactTextString(m) }
 func (*TxDecodeAminoResponse) ProtoMessage()    {}
 func (*TxDecodeAminoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0b00a618705eca7, []int{17}
+	return fileDescriptor_e0b00a618705eca7, []int{20}
 }
 func (m *TxDecodeAminoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1130,6 +1318,9 @@ func init() {
 	proto.RegisterType((*BroadcastTxResponse)(nil), "cosmos.tx.v1beta1.BroadcastTxResponse")
 	proto.RegisterType((*SimulateRequest)(nil), "cosmos.tx.v1beta1.SimulateRequest")
 	proto.RegisterType((*SimulateResponse)(nil), "cosmos.tx.v1beta1.SimulateResponse")
+	proto.RegisterType((*SimulateBundleRequest)(nil), "cosmos.tx.v1beta1.SimulateBundleRequest")
+	proto.RegisterType((*SimulateBundleResponse)(nil), "cosmos.tx.v1beta1.SimulateBundleResponse")
+	proto.RegisterType((*SimulateBundleTxResult)(nil), "cosmos.tx.v1beta1.SimulateBundleTxResult")
 	proto.RegisterType((*GetTxRequest)(nil), "cosmos.tx.v1beta1.GetTxRequest")
 	proto.RegisterType((*GetTxResponse)(nil), "cosmos.tx.v1beta1.GetTxResponse")
 	proto.RegisterType((*GetBlockWithTxsRequest)(nil), "cosmos.tx.v1beta1.GetBlockWithTxsRequest")
@@ -1147,85 +1338,93 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/service.proto", fileDescriptor_e0b00a618705eca7) }
 
 var fileDescriptor_e0b00a618705eca7 = []byte{
-	// 1237 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4d, 0x4f, 0x1b, 0x47,
-	0x18, 0x66, 0x6d, 0x83, 0xcd, 0x6b, 0x08, 0xce, 0x40, 0xc0, 0x38, 0xc4, 0x98, 0x0d, 0x1f, 0x8e,
-	0x55, 0xbc, 0x0a, 0x4d, 0xaa, 0x24, 0xaa, 0x54, 0xe1, 0x8f, 0x50, 0x92, 0x26, 0x44, 0x6b, 0xaa,
-	0x28, 0x55, 0x25, 0x6b, 0x6d, 0x4f, 0xec, 0x6d, 0xec, 0x5d, 0xe3, 0x19, 0xd0, 0x5a, 0x14, 0xb5,
-	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x0f, 0xfd, 0x0f, 0xfd, 0x25, 0x3d, 0x46, 0xea, 0xa5, 0xc7, 0x0a,
-	0x7a, 0xea, 0xa9, 0x52, 0xff, 0x40, 0xb5, 0x33, 0xb3, 0xb6, 0xd7, 0xde, 0xc5, 0x90, 0x0b, 0xcc,
-	0xc7, 0xf3, 0xbe, 0xcf, 0x33, 0xef, 0xcc, 0x3c, 0x3b, 0x86, 0xd5, 0xaa, 0x49, 0x5a, 0x26, 0x51,
-	0xa8, 0xa5, 0x9c, 0xdc, 0xaf, 0x60, 0xaa, 0xdd, 0x57, 0x08, 0xee, 0x9c, 0xe8, 0x55, 0x9c, 0x6d,
-	0x77, 0x4c, 0x6a, 0xa2, 0x9b, 0x1c, 0x90, 0xa5, 0x56, 0x56, 0x00, 0x12, 0x2b, 0x75, 0xd3, 0xac,
-	0x37, 0xb1, 0xa2, 0xb5, 0x75, 0x45, 0x33, 0x0c, 0x93, 0x6a, 0x54, 0x37, 0x0d, 0xc2, 0x03, 0x12,
-	0x77, 0x45, 0xc6, 0x8a, 0x46, 0xb0, 0xa2, 0x55, 0xaa, 0x7a, 0x2f, 0xb1, 0xdd, 0x11, 0xa0, 0xc4,
-	0x28, 0x2d, 0xb5, 0xc4, 0x5c, 0x66, 0x30, 0xc1, 0xd1, 0x31, 0xee, 0x74, 0x7b, 0x98, 0xb6, 0x56,
-	0xd7, 0x0d, 0xc6, 0x26, 0xb0, 0x2b, 0x14, 0x1b, 0x35, 0xdc, 0x69, 0xe9, 0x06, 0x55, 0x68, 0xb7,
-	0x8d, 0x89, 0x52, 0x69, 0x9a, 0xd5, 0x77, 0xbe, 0xb3, 0xec, 0x2f, 0x9f, 0x95, 0xff, 0x93, 0x00,
-	0xed, 0x61, 0x7a, 0x68, 0x91, 0xe2, 0x09, 0x36, 0xa8, 0x8a, 0x8f, 0x8e, 0x31, 0xa1, 0x28, 0x01,
-	0x53, 0xd8, 0xee, 0x93, 0xb8, 0x94, 0x0a, 0xa6, 0xa7, 0x73, 0x81, 0xb8, 0xa4, 0x8a, 0x11, 0xf4,
-	0x0c, 0xa0, 0x2f, 0x21, 0x1e, 0x48, 0x49, 0xe9, 0xe8, 0xce, 0x66, 0x56, 0x54, 0xc8, 0xd6, 0x9b,
-	0x65, 0x7a, 0x9d, 0x4a, 0x65, 0x5f, 0x69, 0x75, 0x2c, 0xf2, 0xb2, 0x3c, 0x03, 0xd1, 0xe8, 0x21,
-	0x44, 0xcc, 0x4e, 0x0d, 0x77, 0xca, 0x95, 0x6e, 0x3c, 0x98, 0x92, 0xd2, 0x37, 0x76, 0x12, 0xd9,
-	0x91, 0x5a, 0x67, 0x0f, 0x6c, 0x48, 0xae, 0xab, 0x86, 0x4d, 0xde, 0x40, 0x08, 0x42, 0x6d, 0xad,
-	0x8e, 0xe3, 0xa1, 0x94, 0x94, 0x0e, 0xa9, 0xac, 0x8d, 0x16, 0x60, 0xb2, 0xa9, 0xb7, 0x74, 0x1a,
-	0x9f, 0x64, 0x83, 0xbc, 0x63, 0x8f, 0x32, 0x35, 0xf1, 0xa9, 0x94, 0x94, 0x9e, 0x56, 0x79, 0x47,
-	0xfe, 0x47, 0x82, 0x79, 0xd7, 0xaa, 0x49, 0xdb, 0x34, 0x08, 0x46, 0x5b, 0x10, 0xa4, 0x16, 0x5f,
-	0x73, 0x74, 0xe7, 0x96, 0x87, 0x92, 0x43, 0x4b, 0xb5, 0x11, 0x68, 0x0f, 0x66, 0xa8, 0x55, 0xee,
-	0x88, 0x38, 0x12, 0x0f, 0xb0, 0x88, 0x75, 0x57, 0x15, 0xd8, 0x4e, 0x0f, 0x04, 0x0a, 0xb0, 0x1a,
-	0xa5, 0xbd, 0x36, 0x41, 0xcf, 0x5d, 0xc5, 0x0c, 0xb2, 0x62, 0x6e, 0x8d, 0x2d, 0x26, 0x8f, 0x1e,
-	0xa9, 0xe6, 0x02, 0x4c, 0x52, 0x93, 0x6a, 0x4d, 0x51, 0x17, 0xde, 0x91, 0x31, 0xa0, 0x5c, 0xc7,
-	0xd4, 0x6a, 0x55, 0x8d, 0x50, 0x5b, 0x06, 0xdf, 0xe1, 0x65, 0x88, 0x50, 0xab, 0x5c, 0xe9, 0x52,
-	0x6c, 0xaf, 0x57, 0x4a, 0xcf, 0xa8, 0x61, 0x6a, 0xe5, 0xec, 0x2e, 0x7a, 0x00, 0xa1, 0x96, 0x59,
-	0xc3, 0x6c, 0x6b, 0x6f, 0xec, 0xa4, 0x3c, 0xca, 0xd0, 0xcb, 0xf7, 0xc2, 0xac, 0x61, 0x95, 0xa1,
-	0xe5, 0xaf, 0x61, 0xde, 0x45, 0x23, 0x4a, 0x5a, 0x84, 0xe8, 0x40, 0xa5, 0x18, 0xd5, 0x55, 0x0b,
-	0x05, 0xfd, 0x42, 0xc9, 0xaf, 0x61, 0xae, 0xa4, 0xb7, 0x8e, 0x9b, 0x1a, 0x75, 0xce, 0x12, 0xba,
-	0x07, 0x01, 0x6a, 0x89, 0x84, 0xde, 0x7b, 0xc5, 0x0a, 0x14, 0xa0, 0x96, 0x6b, 0xb1, 0x01, 0xd7,
-	0x62, 0xe5, 0x1f, 0x25, 0x88, 0xf5, 0x33, 0x0b, 0xd1, 0x9f, 0x42, 0xa4, 0xae, 0x91, 0xb2, 0x6e,
-	0xbc, 0x35, 0x05, 0xc1, 0x9a, 0xbf, 0xe2, 0x3d, 0x8d, 0xec, 0x1b, 0x6f, 0x4d, 0x35, 0x5c, 0xe7,
-	0x0d, 0xf4, 0x08, 0xa6, 0x3a, 0x98, 0x1c, 0x37, 0xa9, 0xb8, 0x1c, 0x29, 0xff, 0x58, 0x95, 0xe1,
-	0x54, 0x81, 0x97, 0x65, 0x98, 0x61, 0xc7, 0xd2, 0x59, 0x22, 0x82, 0x50, 0x43, 0x23, 0x0d, 0xa6,
-	0x61, 0x5a, 0x65, 0x6d, 0xf9, 0x0c, 0x66, 0x05, 0x46, 0x88, 0xdd, 0x18, 0x5b, 0x07, 0x56, 0x83,
-	0xa1, 0x8d, 0x08, 0x7c, 0xe0, 0x46, 0x58, 0xb0, 0xb8, 0x87, 0x69, 0xce, 0x36, 0x98, 0xd7, 0x3a,
-	0x6d, 0x1c, 0x5a, 0xc4, 0x11, 0xbb, 0x08, 0x53, 0x0d, 0xac, 0xd7, 0x1b, 0x94, 0x69, 0x09, 0xaa,
-	0xa2, 0x87, 0x9e, 0x7e, 0xb8, 0x5f, 0x0c, 0x9e, 0x6e, 0xf9, 0x5f, 0x09, 0x96, 0x46, 0xa8, 0xaf,
-	0x7b, 0x71, 0x1f, 0x40, 0x84, 0x99, 0x63, 0x59, 0xaf, 0x09, 0x29, 0xcb, 0xd9, 0xbe, 0x41, 0x66,
-	0xb9, 0x35, 0x32, 0x8a, 0xfd, 0x82, 0x1a, 0x66, 0xd0, 0xfd, 0x1a, 0xda, 0x86, 0x49, 0xd6, 0x14,
-	0x17, 0x74, 0xc9, 0x27, 0x44, 0xe5, 0x28, 0xb4, 0xe7, 0x5a, 0x71, 0xe8, 0x5a, 0x97, 0xda, 0xb5,
-	0xe4, 0x8f, 0x60, 0xee, 0xd0, 0x2a, 0xe0, 0xaa, 0x7d, 0xcb, 0xc6, 0xde, 0x5b, 0xf9, 0x31, 0xc4,
-	0xfa, 0xe8, 0x6b, 0x1d, 0x0e, 0xf9, 0x91, 0x4d, 0x54, 0x34, 0x06, 0x89, 0xae, 0x18, 0xb9, 0x6d,
-	0x93, 0x3a, 0x91, 0x82, 0xf4, 0x12, 0x8d, 0x0f, 0x61, 0xc1, 0x81, 0xef, 0xb6, 0x74, 0xc3, 0x74,
-	0xd8, 0xee, 0x00, 0x68, 0x76, 0xbf, 0xfc, 0x0d, 0x31, 0x0d, 0x71, 0xde, 0xa7, 0xd9, 0xc8, 0x33,
-	0x62, 0x1a, 0xf2, 0x13, 0xb8, 0x35, 0x14, 0x26, 0xa8, 0xd6, 0x60, 0x86, 0xc7, 0x55, 0x74, 0x43,
-	0xeb, 0x74, 0x05, 0x5d, 0x94, 0x8d, 0xe5, 0xd8, 0x90, 0xfc, 0xd8, 0xa6, 0xe4, 0x65, 0x71, 0x51,
-	0x5e, 0x21, 0xf4, 0x13, 0x9b, 0xd6, 0x15, 0x2a, 0x68, 0x2f, 0x97, 0x9b, 0xf9, 0x1c, 0xc2, 0xe2,
-	0x9b, 0x85, 0xe2, 0xb0, 0x70, 0xa0, 0x16, 0x8a, 0x6a, 0x39, 0xf7, 0xa6, 0xfc, 0xe5, 0xcb, 0xd2,
-	0xab, 0x62, 0x7e, 0xff, 0xe9, 0x7e, 0xb1, 0x10, 0x9b, 0x40, 0x31, 0x98, 0xe9, 0xcd, 0xec, 0x96,
-	0xf2, 0x31, 0x09, 0xdd, 0x84, 0xd9, 0xde, 0x48, 0xa1, 0x58, 0xca, 0xc7, 0x02, 0x99, 0xef, 0x25,
-	0x98, 0x75, 0xb9, 0x2d, 0x4a, 0x42, 0x22, 0xa7, 0x1e, 0xec, 0x16, 0xf2, 0xbb, 0xa5, 0xc3, 0xf2,
-	0x8b, 0x83, 0x42, 0x71, 0x28, 0xed, 0x0a, 0x2c, 0x0c, 0xcd, 0xe7, 0xbe, 0x38, 0xc8, 0x3f, 0x8f,
-	0x49, 0x89, 0x40, 0x44, 0x42, 0x4b, 0x30, 0x3f, 0x34, 0x5b, 0x7a, 0xf3, 0x32, 0x1f, 0x0b, 0xd8,
-	0x3a, 0x87, 0x26, 0x76, 0xd9, 0x4c, 0x70, 0xe7, 0xb7, 0x69, 0x08, 0x97, 0xf8, 0x73, 0x08, 0x9d,
-	0x42, 0xc4, 0x31, 0x4b, 0x24, 0x7b, 0x1c, 0x8a, 0x21, 0x8f, 0x4e, 0xdc, 0xbd, 0x14, 0x23, 0x2c,
-	0x65, 0xf3, 0x87, 0x3f, 0xfe, 0xfe, 0x25, 0x90, 0x7a, 0x22, 0x65, 0xe4, 0xdb, 0x8a, 0xc7, 0x53,
-	0xcc, 0x21, 0x3c, 0x82, 0x49, 0xe6, 0x7c, 0x68, 0xd5, 0x23, 0xeb, 0xa0, 0x6f, 0x26, 0x52, 0xfe,
-	0x00, 0xc1, 0xb9, 0xc1, 0x38, 0x57, 0xd1, 0x1d, 0xc5, 0xeb, 0x11, 0x46, 0x94, 0x53, 0xdb, 0x6b,
-	0xcf, 0xd0, 0x77, 0x10, 0x1d, 0xf8, 0xa8, 0xa1, 0x8d, 0xcb, 0xbe, 0x85, 0x7d, 0xfa, 0xcd, 0x71,
-	0x30, 0x21, 0x62, 0x8d, 0x89, 0xb8, 0x6d, 0x2f, 0x7c, 0xd1, 0x5b, 0x07, 0xfa, 0x16, 0xa2, 0x03,
-	0x0f, 0x15, 0x4f, 0x01, 0xa3, 0xcf, 0x37, 0x4f, 0x01, 0x1e, 0xef, 0x1d, 0x39, 0xc9, 0x04, 0xc4,
-	0x91, 0x1f, 0xfb, 0xaf, 0x12, 0xcc, 0x0d, 0x59, 0x2e, 0xba, 0xe7, 0x9d, 0xdb, 0xe3, 0x8b, 0x90,
-	0xc8, 0x5c, 0x05, 0x2a, 0xa4, 0x6c, 0x33, 0x29, 0x5b, 0x68, 0xc3, 0x67, 0x43, 0x98, 0xb3, 0x2a,
-	0xa7, 0xfc, 0x9b, 0x72, 0x86, 0xba, 0x10, 0x71, 0x6e, 0xa6, 0xe7, 0x41, 0x1c, 0xb2, 0x4d, 0xcf,
-	0x83, 0x38, 0x6c, 0x96, 0xf2, 0x3a, 0xd3, 0x90, 0xb4, 0xf7, 0x63, 0xd9, 0x43, 0x46, 0x8d, 0xd3,
-	0x31, 0x6a, 0xee, 0x45, 0x3e, 0xd4, 0x2e, 0x23, 0xf5, 0xa1, 0x76, 0x5b, 0xe6, 0x38, 0x6a, 0xcc,
-	0xe9, 0x7e, 0x92, 0x60, 0xd6, 0xe5, 0x83, 0x68, 0xeb, 0x92, 0xe4, 0x83, 0x6e, 0x97, 0x48, 0x8f,
-	0x07, 0x0a, 0x29, 0x19, 0x26, 0x65, 0xdd, 0x96, 0xb2, 0xea, 0x2b, 0x45, 0x61, 0x66, 0x27, 0x04,
-	0x0d, 0x38, 0xa4, 0x8f, 0xa0, 0x51, 0xfb, 0xf5, 0x11, 0xe4, 0x61, 0xb6, 0xe3, 0x04, 0xf1, 0x6d,
-	0xe1, 0x82, 0x72, 0x9f, 0xfd, 0x7e, 0x9e, 0x94, 0xde, 0x9f, 0x27, 0xa5, 0xbf, 0xce, 0x93, 0xd2,
-	0xcf, 0x17, 0xc9, 0x89, 0xf7, 0x17, 0xc9, 0x89, 0x3f, 0x2f, 0x92, 0x13, 0x5f, 0x6d, 0xd4, 0x75,
-	0xda, 0x38, 0xae, 0x64, 0xab, 0x66, 0xcb, 0x49, 0xc2, 0xff, 0x6d, 0x93, 0xda, 0x3b, 0xe7, 0x97,
-	0x91, 0x55, 0x99, 0x62, 0xbf, 0x8b, 0x3e, 0xfe, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x42, 0xa8, 0xd7,
-	0x97, 0x14, 0x0e, 0x00, 0x00,
+	// 1375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xd8, 0x49, 0x6c, 0x3f, 0x27, 0x60, 0x86, 0x24, 0x98, 0x25, 0x38, 0x66, 0x21, 0x89,
+	0x63, 0x35, 0x5e, 0x91, 0x42, 0x05, 0x51, 0xa5, 0x2a, 0xfe, 0x20, 0x0d, 0x14, 0x82, 0xd6, 0xae,
+	0x10, 0x55, 0x25, 0x6b, 0x6d, 0x0f, 0xce, 0x16, 0x7b, 0xd7, 0xec, 0x4c, 0xd0, 0x5a, 0x14, 0xb5,
+	0xea, 0xb1, 0x87, 0xaa, 0x52, 0x2b, 0xf5, 0xd0, 0xbf, 0xa6, 0xb7, 0x1e, 0x91, 0x7a, 0xe1, 0x58,
+	0x91, 0x9e, 0x7a, 0xaa, 0xd4, 0x7f, 0xa0, 0xda, 0xd9, 0x59, 0xdb, 0xeb, 0xac, 0x3f, 0xc2, 0xa5,
+	0x17, 0x7b, 0x3e, 0x7e, 0xef, 0xfd, 0x7e, 0xef, 0xcd, 0xcc, 0x9b, 0x59, 0x58, 0xab, 0x9b, 0xb4,
+	0x6d, 0x52, 0x85, 0xd9, 0xca, 0xcb, 0x9b, 0x35, 0xc2, 0xb4, 0x9b, 0x0a, 0x25, 0xd6, 0x4b, 0xbd,
+	0x4e, 0x72, 0x1d, 0xcb, 0x64, 0x26, 0xbe, 0xe0, 0x02, 0x72, 0xcc, 0xce, 0x09, 0x80, 0xb4, 0xda,
+	0x34, 0xcd, 0x66, 0x8b, 0x28, 0x5a, 0x47, 0x57, 0x34, 0xc3, 0x30, 0x99, 0xc6, 0x74, 0xd3, 0xa0,
+	0xae, 0x81, 0x74, 0x5d, 0x78, 0xac, 0x69, 0x94, 0x28, 0x5a, 0xad, 0xae, 0xf7, 0x1c, 0x3b, 0x1d,
+	0x01, 0x92, 0x4e, 0xd3, 0x32, 0x5b, 0xcc, 0x65, 0x07, 0x1d, 0xbc, 0x38, 0x26, 0x56, 0xb7, 0x87,
+	0xe9, 0x68, 0x4d, 0xdd, 0xe0, 0x6c, 0x02, 0xbb, 0xca, 0x88, 0xd1, 0x20, 0x56, 0x5b, 0x37, 0x98,
+	0xc2, 0xba, 0x1d, 0x42, 0x95, 0x5a, 0xcb, 0xac, 0x3f, 0x1f, 0x39, 0xcb, 0x7f, 0xdd, 0x59, 0xf9,
+	0x5f, 0x04, 0x78, 0x9f, 0xb0, 0x8a, 0x4d, 0x4b, 0x2f, 0x89, 0xc1, 0x54, 0xf2, 0xe2, 0x98, 0x50,
+	0x86, 0x25, 0x98, 0x27, 0x4e, 0x9f, 0x26, 0x51, 0x3a, 0x9c, 0x89, 0xe5, 0x43, 0x49, 0xa4, 0x8a,
+	0x11, 0x7c, 0x1f, 0xa0, 0x2f, 0x21, 0x19, 0x4a, 0xa3, 0x4c, 0x7c, 0x67, 0x23, 0x27, 0x32, 0xe4,
+	0xe8, 0xcd, 0x71, 0xbd, 0x5e, 0xa6, 0x72, 0x8f, 0xb5, 0x26, 0x11, 0x7e, 0xb9, 0x9f, 0x01, 0x6b,
+	0x7c, 0x1b, 0xa2, 0xa6, 0xd5, 0x20, 0x56, 0xb5, 0xd6, 0x4d, 0x86, 0xd3, 0x28, 0x73, 0x6e, 0x47,
+	0xca, 0x9d, 0xca, 0x75, 0xee, 0xd0, 0x81, 0xe4, 0xbb, 0x6a, 0xc4, 0x74, 0x1b, 0x18, 0xc3, 0x6c,
+	0x47, 0x6b, 0x92, 0xe4, 0x6c, 0x1a, 0x65, 0x66, 0x55, 0xde, 0xc6, 0x4b, 0x30, 0xd7, 0xd2, 0xdb,
+	0x3a, 0x4b, 0xce, 0xf1, 0x41, 0xb7, 0xe3, 0x8c, 0x72, 0x35, 0xc9, 0xf9, 0x34, 0xca, 0xc4, 0x54,
+	0xb7, 0x23, 0xff, 0x8d, 0xe0, 0xa2, 0x2f, 0x6a, 0xda, 0x31, 0x0d, 0x4a, 0xf0, 0x26, 0x84, 0x99,
+	0xed, 0xc6, 0x1c, 0xdf, 0x59, 0x0e, 0x50, 0x52, 0xb1, 0x55, 0x07, 0x81, 0xf7, 0x61, 0x81, 0xd9,
+	0x55, 0x4b, 0xd8, 0xd1, 0x64, 0x88, 0x5b, 0xdc, 0xf0, 0x65, 0x81, 0xaf, 0xf4, 0x80, 0xa1, 0x00,
+	0xab, 0x71, 0xd6, 0x6b, 0x53, 0xfc, 0xc0, 0x97, 0xcc, 0x30, 0x4f, 0xe6, 0xe6, 0xc4, 0x64, 0xba,
+	0xd6, 0xa7, 0xb2, 0xb9, 0x04, 0x73, 0xcc, 0x64, 0x5a, 0x4b, 0xe4, 0xc5, 0xed, 0xc8, 0x04, 0x70,
+	0xde, 0x32, 0xb5, 0x46, 0x5d, 0xa3, 0xac, 0x62, 0x8b, 0x95, 0xc0, 0x97, 0x21, 0xca, 0xec, 0x6a,
+	0xad, 0xcb, 0x88, 0x13, 0x2f, 0xca, 0x2c, 0xa8, 0x11, 0x66, 0xe7, 0x9d, 0x2e, 0xbe, 0x05, 0xb3,
+	0x6d, 0xb3, 0x41, 0xf8, 0xd2, 0x9e, 0xdb, 0x49, 0x07, 0xa4, 0xa1, 0xe7, 0xef, 0xa1, 0xd9, 0x20,
+	0x2a, 0x47, 0xcb, 0x5f, 0xc2, 0x45, 0x1f, 0x8d, 0x48, 0x69, 0x09, 0xe2, 0x03, 0x99, 0xe2, 0x54,
+	0xd3, 0x26, 0x0a, 0xfa, 0x89, 0x92, 0x9f, 0xc0, 0xf9, 0xb2, 0xde, 0x3e, 0x6e, 0x69, 0xcc, 0xdb,
+	0x4b, 0x78, 0x0b, 0x42, 0xcc, 0x16, 0x0e, 0x83, 0xd7, 0x8a, 0x27, 0x28, 0xc4, 0x6c, 0x5f, 0xb0,
+	0x21, 0x5f, 0xb0, 0xf2, 0xf7, 0x08, 0x12, 0x7d, 0xcf, 0x42, 0xf4, 0xc7, 0x10, 0x6d, 0x6a, 0xb4,
+	0xaa, 0x1b, 0xcf, 0x4c, 0x41, 0x70, 0x6d, 0xb4, 0xe2, 0x7d, 0x8d, 0x1e, 0x18, 0xcf, 0x4c, 0x35,
+	0xd2, 0x74, 0x1b, 0xf8, 0x0e, 0xcc, 0x5b, 0x84, 0x1e, 0xb7, 0x98, 0x38, 0x1c, 0xe9, 0xd1, 0xb6,
+	0x2a, 0xc7, 0xa9, 0x02, 0x2f, 0xdf, 0x82, 0x65, 0x4f, 0x4b, 0xfe, 0xd8, 0x68, 0xb4, 0x7a, 0xb1,
+	0x5e, 0x81, 0x18, 0xb3, 0x69, 0x6f, 0xb9, 0xc2, 0x99, 0x05, 0x35, 0xca, 0x6c, 0xea, 0x86, 0xf0,
+	0x2b, 0x82, 0x95, 0x61, 0x33, 0x11, 0x48, 0x01, 0x22, 0xae, 0x6b, 0x6f, 0x53, 0x6f, 0x05, 0x24,
+	0xca, 0x6f, 0x5b, 0xb1, 0x85, 0x28, 0xcf, 0xd2, 0x97, 0x8d, 0xd0, 0x59, 0xb3, 0x21, 0xbf, 0x3d,
+	0xa5, 0xce, 0x63, 0xf8, 0xbf, 0xd2, 0x8c, 0x57, 0x21, 0x56, 0x37, 0x1b, 0x84, 0x76, 0xb4, 0x3a,
+	0xe1, 0x67, 0x2e, 0xa6, 0xf6, 0x07, 0x9c, 0xe2, 0xe2, 0x74, 0xf8, 0x21, 0x5a, 0x54, 0x79, 0xdb,
+	0x39, 0x59, 0xc4, 0xb2, 0x4c, 0x8b, 0x17, 0x97, 0x98, 0xea, 0x76, 0x64, 0x19, 0x16, 0x78, 0x15,
+	0xf1, 0x56, 0x09, 0xc3, 0xec, 0x91, 0x46, 0x8f, 0x78, 0x2c, 0x31, 0x95, 0xb7, 0xe5, 0xd7, 0xb0,
+	0x28, 0x30, 0x62, 0x49, 0xd6, 0x27, 0x6e, 0x5b, 0xbe, 0x65, 0x87, 0xce, 0x4d, 0xe8, 0x3d, 0xcf,
+	0x8d, 0x0d, 0x2b, 0xfb, 0x84, 0xe5, 0x9d, 0xfb, 0xe0, 0x89, 0xce, 0x8e, 0x2a, 0x36, 0xf5, 0xc4,
+	0xae, 0xc0, 0xfc, 0x11, 0xd1, 0x9b, 0x47, 0x8c, 0x6b, 0x09, 0xab, 0xa2, 0x87, 0xef, 0xbd, 0x7f,
+	0x79, 0x1f, 0x2c, 0x46, 0xf2, 0x3f, 0x08, 0x2e, 0x9d, 0xa2, 0x3e, 0x6b, 0x9d, 0xbd, 0x05, 0x51,
+	0x7e, 0x97, 0x55, 0xf5, 0x86, 0x90, 0x72, 0x39, 0xd7, 0xbf, 0xcf, 0x72, 0xee, 0x4d, 0xc6, 0x29,
+	0x0e, 0x8a, 0x6a, 0x84, 0x43, 0x0f, 0x1a, 0x78, 0x1b, 0xe6, 0x78, 0x53, 0xd4, 0xd3, 0x4b, 0x23,
+	0x4c, 0x54, 0x17, 0x85, 0xf7, 0x7d, 0x11, 0xcf, 0x9e, 0xa9, 0x06, 0xfb, 0x42, 0xfe, 0x00, 0xce,
+	0x57, 0xec, 0x22, 0x71, 0x76, 0xcc, 0xe4, 0x32, 0x2b, 0xdf, 0x85, 0x44, 0x1f, 0x7d, 0xa6, 0xcd,
+	0x21, 0xdf, 0x71, 0x88, 0x4a, 0xc6, 0x20, 0xd1, 0x94, 0x96, 0xdb, 0x90, 0xe8, 0x5b, 0x0a, 0xd2,
+	0x31, 0x1a, 0x6f, 0xc3, 0x92, 0x07, 0xdf, 0x6b, 0xeb, 0x86, 0xe9, 0xb1, 0x5d, 0x05, 0xd0, 0x9c,
+	0x7e, 0xf5, 0x2b, 0x6a, 0x1a, 0x62, 0xbf, 0xc7, 0xf8, 0xc8, 0x7d, 0x6a, 0x1a, 0xf2, 0x2e, 0x2c,
+	0x0f, 0x99, 0x09, 0xaa, 0x6b, 0xb0, 0xe0, 0xda, 0xd5, 0x74, 0x43, 0xb3, 0xba, 0x82, 0x2e, 0xce,
+	0xc7, 0xf2, 0x7c, 0x48, 0xbe, 0x0b, 0x4b, 0x5e, 0x5a, 0x7c, 0x94, 0x53, 0x98, 0x7e, 0x04, 0xcb,
+	0x43, 0xa6, 0x82, 0x76, 0xbc, 0xdc, 0xec, 0xa7, 0x10, 0x11, 0x4f, 0x0c, 0x9c, 0x84, 0xa5, 0x43,
+	0xb5, 0x58, 0x52, 0xab, 0xf9, 0xa7, 0xd5, 0xcf, 0x1f, 0x95, 0x1f, 0x97, 0x0a, 0x07, 0xf7, 0x0e,
+	0x4a, 0xc5, 0xc4, 0x0c, 0x4e, 0xc0, 0x42, 0x6f, 0x66, 0xaf, 0x5c, 0x48, 0x20, 0x7c, 0x01, 0x16,
+	0x7b, 0x23, 0xc5, 0x52, 0xb9, 0x90, 0x08, 0x65, 0xbf, 0x45, 0xb0, 0xe8, 0xbb, 0x1c, 0x71, 0x0a,
+	0xa4, 0xbc, 0x7a, 0xb8, 0x57, 0x2c, 0xec, 0x95, 0x2b, 0xd5, 0x87, 0x87, 0xc5, 0xd2, 0x90, 0xdb,
+	0x55, 0x58, 0x1a, 0x9a, 0xcf, 0x7f, 0x76, 0x58, 0x78, 0x90, 0x40, 0x52, 0x28, 0x8a, 0xf0, 0x25,
+	0xb8, 0x38, 0x34, 0x5b, 0x7e, 0xfa, 0xa8, 0x90, 0x08, 0x39, 0x3a, 0x87, 0x26, 0xf6, 0xf8, 0x4c,
+	0x78, 0xe7, 0x37, 0x80, 0x48, 0xd9, 0x7d, 0xbd, 0xe2, 0x57, 0x10, 0xf5, 0x4a, 0x2f, 0x96, 0xc7,
+	0x54, 0x7e, 0x91, 0x63, 0xe9, 0xfa, 0x58, 0x8c, 0x28, 0x29, 0x1b, 0xdf, 0xfd, 0xf1, 0xd7, 0x4f,
+	0xa1, 0xf4, 0x2e, 0xca, 0xca, 0x57, 0x94, 0x80, 0x97, 0xb3, 0x47, 0xf8, 0x33, 0x82, 0x73, 0xfe,
+	0xc2, 0x8f, 0x33, 0x13, 0x6f, 0x1f, 0x4f, 0xc9, 0xd6, 0x14, 0x48, 0xa1, 0x67, 0x9b, 0xeb, 0xd9,
+	0x94, 0xe5, 0x31, 0x62, 0xaa, 0x35, 0x6e, 0xb3, 0x8b, 0xb2, 0xf8, 0x05, 0xcc, 0xf1, 0x82, 0x8c,
+	0xd7, 0x02, 0x28, 0x06, 0xcb, 0xb9, 0x94, 0x1e, 0x0d, 0x10, 0xd4, 0xeb, 0x9c, 0x7a, 0x0d, 0x5f,
+	0x55, 0x82, 0x9e, 0xf2, 0x54, 0x79, 0xe5, 0x5c, 0x01, 0xaf, 0xf1, 0x37, 0x10, 0x1f, 0x78, 0x1a,
+	0xe1, 0xf5, 0x71, 0x2f, 0xaa, 0x3e, 0xfd, 0xc6, 0x24, 0x98, 0x10, 0x71, 0x8d, 0x8b, 0xb8, 0x22,
+	0xaf, 0x04, 0x8b, 0x70, 0x62, 0xfe, 0x1a, 0xe2, 0x03, 0xcf, 0xdd, 0x40, 0x01, 0xa7, 0x3f, 0x02,
+	0xa4, 0x8d, 0x49, 0x30, 0x21, 0x20, 0xc5, 0x05, 0x24, 0xf1, 0x08, 0x01, 0xf8, 0x17, 0x04, 0xe7,
+	0x87, 0x6e, 0x02, 0xbc, 0x15, 0xec, 0x3b, 0xe0, 0xa2, 0x92, 0xb2, 0xd3, 0x40, 0xfd, 0x7b, 0x01,
+	0xaf, 0x8f, 0x58, 0x10, 0x5e, 0xf0, 0x95, 0x57, 0xee, 0x55, 0xf7, 0x1a, 0x77, 0x21, 0xea, 0x15,
+	0x8c, 0xc0, 0xf3, 0x31, 0x54, 0xcd, 0xa5, 0xeb, 0x63, 0x31, 0x42, 0xc3, 0x0d, 0xae, 0x21, 0xe5,
+	0x9c, 0x8f, 0xcb, 0x01, 0x32, 0x1a, 0x2e, 0x1d, 0xa7, 0x2e, 0x19, 0x63, 0xa8, 0x4b, 0xc6, 0x64,
+	0xea, 0x92, 0x11, 0x44, 0x1d, 0xc8, 0x4b, 0x38, 0xd4, 0xd9, 0x0d, 0x3f, 0x20, 0x58, 0xf4, 0x95,
+	0x67, 0xbc, 0x39, 0xc6, 0xf9, 0x60, 0x11, 0x96, 0x32, 0x93, 0x81, 0x42, 0x4a, 0x96, 0x4b, 0xb9,
+	0xe1, 0x64, 0x61, 0x6d, 0xa4, 0x1a, 0x85, 0xd7, 0x60, 0x21, 0xa8, 0x48, 0x26, 0x09, 0x2a, 0x92,
+	0x29, 0x05, 0x15, 0xc9, 0x48, 0x41, 0xf2, 0xda, 0xc8, 0x35, 0x71, 0xd5, 0xec, 0xa2, 0x6c, 0xfe,
+	0x93, 0xdf, 0xdf, 0xa5, 0xd0, 0x9b, 0x77, 0x29, 0xf4, 0xe7, 0xbb, 0x14, 0xfa, 0xf1, 0x24, 0x35,
+	0xf3, 0xe6, 0x24, 0x35, 0xf3, 0xf6, 0x24, 0x35, 0xf3, 0xc5, 0x7a, 0x53, 0x67, 0x47, 0xc7, 0xb5,
+	0x5c, 0xdd, 0x6c, 0x7b, 0x7e, 0xdc, 0xbf, 0x6d, 0xda, 0x78, 0xee, 0x7d, 0x5f, 0xdb, 0xb5, 0x79,
+	0xfe, 0x75, 0xfd, 0xe1, 0x7f, 0x03, 0x00, 0x03, 0x6d, 0xa3, 0x68, 0x5a, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1242,6 +1441,9 @@ const _ = grpc.SupportPackageIsVersion4
 type ServiceClient interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(ctx context.Context, in *SimulateRequest, opts ...grpc.CallOption) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions, where
+	// every transaction observes the state changes of the ones preceding it.
+	SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1287,6 +1489,15 @@ func (c *serviceClient) Simulate(ctx context.Context, in *SimulateRequest, opts
 	return out, nil
 }
 
+func (c *serviceClient) SimulateBundle(ctx context.Context, in *SimulateBundleRequest, opts ...grpc.CallOption) (*SimulateBundleResponse, error) {
+	out := new(SimulateBundleResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/SimulateBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *serviceClient) GetTx(ctx context.Context, in *GetTxRequest, opts ...grpc.CallOption) (*GetTxResponse, error) {
 	out := new(GetTxResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.tx.v1beta1.Service/GetTx", in, out, opts...)
@@ -1363,6 +1574,9 @@ func (c *serviceClient) TxDecodeAmino(ctx context.Context, in *TxDecodeAminoRequ
 type ServiceServer interface {
 	// Simulate simulates executing a transaction for estimating gas usage.
 	Simulate(context.Context, *SimulateRequest) (*SimulateResponse, error)
+	// SimulateBundle simulates executing an ordered bundle of transactions, where
+	// every transaction observes the state changes of the ones preceding it.
+	SimulateBundle(context.Context, *SimulateBundleRequest) (*SimulateBundleResponse, error)
 	// GetTx fetches a tx by hash.
 	GetTx(context.Context, *GetTxRequest) (*GetTxResponse, error)
 	// BroadcastTx broadcast transaction.
@@ -1398,6 +1612,9 @@ type UnimplementedServiceServer struct {
 func (*UnimplementedServiceServer) Simulate(ctx context.Context, req *SimulateRequest) (*SimulateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
 }
+func (*UnimplementedServiceServer) SimulateBundle(ctx context.Context, req *SimulateBundleRequest) (*SimulateBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateBundle not implemented")
+}
 func (*UnimplementedServiceServer) GetTx(ctx context.Context, req *GetTxRequest) (*GetTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTx not implemented")
 }
@@ -1445,6 +1662,24 @@ func _Service_Simulate_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_SimulateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).SimulateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.tx.v1beta1.Service/SimulateBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).SimulateBundle(ctx, req.(*SimulateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Service_GetTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetTxRequest)
 	if err := dec(in); err != nil {
@@ -1597,6 +1832,10 @@ var _Service_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Simulate",
 			Handler:    _Service_Simulate_Handler,
 		},
+		{
+			MethodName: "SimulateBundle",
+			Handler:    _Service_SimulateBundle_Handler,
+		},
 		{
 			MethodName: "GetTx",
 			Handler:    _Service_GetTx_Handler,
@@ -1927,7 +2166,7 @@ func (m *SimulateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1937,27 +2176,29 @@ func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.TxsBytes) > 0 {
+		for iNdEx := len(m.TxsBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxsBytes[iNdEx])
+			copy(dAtA[i:], m.TxsBytes[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.TxsBytes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1967,19 +2208,19 @@ func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TxResponse != nil {
+	if m.GasInfo != nil {
 		{
-			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1989,22 +2230,24 @@ func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Tx != nil {
-		{
-			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+func (m *SimulateBundleTxResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2014,19 +2257,38 @@ func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SimulateBundleTxResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SimulateBundleTxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Code != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Result != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2036,15 +2298,22 @@ func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Height))
+	if m.GasInfo != nil {
+		{
+			size, err := m.GasInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetTxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2054,46 +2323,163 @@ func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Block != nil {
-		{
-			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Hash)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.BlockId != nil {
-		{
-			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxResponse != nil {
+		{
+			size, err := m.TxResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Tx != nil {
+		{
+			size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBlockWithTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBlockWithTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBlockWithTxsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockId != nil {
+		{
+			size, err := m.BlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
 			i -= size
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
@@ -2501,6 +2887,68 @@ func (m *SimulateResponse) Size() (n int) {
 	return n
 }
 
+func (m *SimulateBundleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxsBytes) > 0 {
+		for _, b := range m.TxsBytes {
+			l = len(b)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SimulateBundleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulateBundleTxResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasInfo != nil {
+		l = m.GasInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovService(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+
 func (m *GetTxRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3495,6 +3943,413 @@ func (m *SimulateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SimulateBundleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxsBytes = append(m.TxsBytes, make([]byte, postIndex-iNdEx))
+			copy(m.TxsBytes[len(m.TxsBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &SimulateBundleTxResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateBundleTxResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateBundleTxResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateBundleTxResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasInfo == nil {
+				m.GasInfo = &types.GasInfo{}
+			}
+			if err := m.GasInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &types.Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -361,6 +361,16 @@ type TxBody struct {
 	// timeout is the block height after which this transaction will not
 	// be processed by the chain
 	TimeoutHeight uint64 `protobuf:"varint,3,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// unordered, when set to true, indicates that the transaction signer(s)
+	// intend for the transaction to be evaluated and executed in an un-ordered
+	// fashion. Specifically, the account's nonce will NOT be checked or
+	// incremented, which allows for fire-and-forget as well as concurrent
+	// transaction execution.
+	//
+	// Note, when set to true, the existing 'timeout_height' value must be set and
+	// will be used to correspond to a height in which the transaction is deemed
+	// valid, and the sequence of every signer must be zero.
+	Unordered bool `protobuf:"varint,4,opt,name=unordered,proto3" json:"unordered,omitempty"`
 	// extension_options are arbitrary options that can be added by chains
 	// when the default options are not sufficient. If any of these are present
 	// and can't be handled, the transaction will be rejected
@@ -425,6 +435,13 @@ func (m *TxBody) GetTimeoutHeight() uint64 {
 	return 0
 }
 
+func (m *TxBody) GetUnordered() bool {
+	if m != nil {
+		return m.Unordered
+	}
+	return false
+}
+
 func (m *TxBody) GetExtensionOptions() []*types.Any {
 	if m != nil {
 		return m.ExtensionOptions
@@ -592,7 +609,6 @@ type ModeInfo struct {
 	// multisig signer
 	//
 	// Types that are valid to be assigned to Sum:
-	//
 	//	*ModeInfo_Single_
 	//	*ModeInfo_Multi_
 	Sum isModeInfo_Sum `protobuf_oneof:"sum"`
@@ -1020,74 +1036,74 @@ func init() {
 func init() { proto.RegisterFile("cosmos/tx/v1beta1/tx.proto", fileDescriptor_96d1575ffde80842) }
 
 var fileDescriptor_96d1575ffde80842 = []byte{
-	// 1059 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x64, 0x14, 0x21, 0xc7, 0x51, 0xdd, 0xe0, 0xaa,
-	0x60, 0x55, 0x64, 0xb7, 0x4d, 0x0f, 0x94, 0x0a, 0x01, 0x76, 0x43, 0x94, 0xaa, 0x14, 0xa4, 0x4d,
-	0x4e, 0xbd, 0xac, 0xc6, 0xeb, 0xc9, 0x7a, 0x54, 0xef, 0xcc, 0xb2, 0x33, 0x0b, 0xde, 0x23, 0x3f,
-	0x00, 0xa9, 0x42, 0x42, 0x48, 0x9c, 0x39, 0x20, 0x4e, 0x3d, 0x20, 0x7e, 0x43, 0x4f, 0xa8, 0xe2,
-	0xc4, 0x09, 0xaa, 0xe4, 0xd0, 0x3b, 0x7f, 0x00, 0x34, 0xb3, 0xb3, 0x9b, 0xb4, 0xa4, 0x4e, 0x11,
-	0x48, 0x5c, 0xec, 0x99, 0xb7, 0xdf, 0x7b, 0xf3, 0xbd, 0x37, 0xdf, 0xbc, 0x07, 0x9d, 0x80, 0x8b,
-	0x88, 0x0b, 0x57, 0xce, 0xdc, 0xcf, 0xae, 0x8f, 0x88, 0xc4, 0xd7, 0x5d, 0x39, 0x73, 0xe2, 0x84,
-	0x4b, 0x8e, 0x56, 0xf2, 0x6f, 0x8e, 0x9c, 0x39, 0xe6, 0x5b, 0x67, 0x05, 0x47, 0x94, 0x71, 0x57,
-	0xff, 0xe6, 0xa8, 0xce, 0x6a, 0xc8, 0x43, 0xae, 0x97, 0xae, 0x5a, 0x19, 0xeb, 0xa6, 0x89, 0x1b,
-	0x24, 0x59, 0x2c, 0xb9, 0x1b, 0xa5, 0x53, 0x49, 0x05, 0x0d, 0xcb, 0x43, 0x0a, 0x83, 0x81, 0x77,
-	0x0d, 0x7c, 0x84, 0x05, 0x29, 0x31, 0x01, 0xa7, 0xcc, 0x7c, 0x7f, 0xf3, 0x98, 0xa6, 0xa0, 0x21,
-	0xa3, 0xec, 0x38, 0x92, 0xd9, 0x1b, 0xe0, 0x5a, 0xc8, 0x79, 0x38, 0x25, 0xae, 0xde, 0x8d, 0xd2,
-	0x03, 0x17, 0xb3, 0xac, 0xf8, 0x94, 0xc7, 0xf0, 0x73, 0xae, 0x26, 0x37, 0xbd, 0xe9, 0x7d, 0x69,
-	0x41, 0x75, 0x7f, 0x86, 0x36, 0xa1, 0x36, 0xe2, 0xe3, 0xac, 0x6d, 0x6d, 0x58, 0xfd, 0x73, 0x5b,
-	0x6b, 0xce, 0xdf, 0xf2, 0x77, 0xf6, 0x67, 0x43, 0x3e, 0xce, 0x3c, 0x0d, 0x43, 0x37, 0xa1, 0x85,
-	0x53, 0x39, 0xf1, 0x29, 0x3b, 0xe0, 0xed, 0xaa, 0xf6, 0x59, 0x3f, 0xc5, 0x67, 0x90, 0xca, 0xc9,
-	0x1d, 0x76, 0xc0, 0xbd, 0x26, 0x36, 0x2b, 0xd4, 0x05, 0x50, 0xb4, 0xb1, 0x4c, 0x13, 0x22, 0xda,
-	0xf6, 0x86, 0xdd, 0x5f, 0xf4, 0x4e, 0x58, 0x7a, 0x0c, 0xea, 0xfb, 0x33, 0x0f, 0x7f, 0x8e, 0x2e,
-	0x02, 0xa8, 0xa3, 0xfc, 0x51, 0x26, 0x89, 0xd0, 0xbc, 0x16, 0xbd, 0x96, 0xb2, 0x0c, 0x95, 0x01,
-	0xbd, 0x01, 0x17, 0x4a, 0x06, 0x06, 0x53, 0xd5, 0x98, 0xa5, 0xe2, 0xa8, 0x1c, 0x77, 0xd6, 0x79,
-	0x5f, 0x59, 0xb0, 0xb0, 0x47, 0x43, 0xb6, 0xcd, 0x83, 0xff, 0xea, 0xc8, 0x35, 0x68, 0x06, 0x13,
-	0x4c, 0x99, 0x4f, 0xc7, 0x6d, 0x7b, 0xc3, 0xea, 0xb7, 0xbc, 0x05, 0xbd, 0xbf, 0x33, 0x46, 0x57,
-	0xe0, 0x3c, 0x0e, 0x02, 0x9e, 0x32, 0xe9, 0xb3, 0x34, 0x1a, 0x91, 0xa4, 0x5d, 0xdb, 0xb0, 0xfa,
-	0x35, 0x6f, 0xc9, 0x58, 0x3f, 0xd6, 0xc6, 0xde, 0x1f, 0x16, 0x2c, 0x1b, 0x52, 0xdb, 0x34, 0x21,
-	0x81, 0x1c, 0xa4, 0xb3, 0xb3, 0xd8, 0xdd, 0x00, 0x88, 0xd3, 0xd1, 0x94, 0x06, 0xfe, 0x03, 0x92,
-	0x99, 0x3b, 0x59, 0x75, 0x72, 0x4d, 0x38, 0x85, 0x26, 0x9c, 0x01, 0xcb, 0xbc, 0x56, 0x8e, 0xbb,
-	0x4b, 0xb2, 0x7f, 0x4f, 0x15, 0x75, 0xa0, 0x29, 0xc8, 0xa7, 0x29, 0x61, 0x01, 0x69, 0xd7, 0x35,
-	0xa0, 0xdc, 0xa3, 0xb7, 0xc0, 0x96, 0x34, 0x6e, 0x37, 0x34, 0x97, 0xd7, 0x4e, 0xd3, 0x14, 0x8d,
-	0x87, 0xd5, 0xb6, 0xe5, 0x29, 0x58, 0xef, 0xeb, 0x2a, 0x34, 0x72, 0x91, 0xa1, 0x6b, 0xd0, 0x8c,
-	0x88, 0x10, 0x38, 0xd4, 0x89, 0xda, 0x2f, 0xcd, 0xa4, 0x44, 0x21, 0x04, 0xb5, 0x88, 0x44, 0xb9,
-	0x16, 0x5b, 0x9e, 0x5e, 0xab, 0x0c, 0x24, 0x8d, 0x08, 0x4f, 0xa5, 0x3f, 0x21, 0x34, 0x9c, 0x48,
-	0x9d, 0x62, 0xcd, 0x5b, 0x32, 0xd6, 0x5d, 0x6d, 0x44, 0x43, 0x58, 0x21, 0x33, 0x49, 0x98, 0xa0,
-	0x9c, 0xf9, 0x3c, 0x96, 0x94, 0x33, 0xd1, 0xfe, 0x73, 0x61, 0xce, 0xb1, 0xcb, 0x25, 0xfe, 0x93,
-	0x1c, 0x8e, 0xee, 0x43, 0x97, 0x71, 0xe6, 0x07, 0x09, 0x95, 0x34, 0xc0, 0x53, 0xff, 0x94, 0x80,
-	0x17, 0xe6, 0x04, 0x5c, 0x67, 0x9c, 0xdd, 0x36, 0xbe, 0x1f, 0xbe, 0x10, 0xbb, 0xf7, 0x9d, 0x05,
-	0xcd, 0xe2, 0x21, 0xa1, 0x0f, 0x60, 0x51, 0x89, 0x97, 0x24, 0x5a, 0x85, 0x45, 0x75, 0x2e, 0x9e,
-	0x52, 0xdb, 0x3d, 0x0d, 0xd3, 0xaf, 0xef, 0x9c, 0x28, 0xd7, 0x02, 0xf5, 0xc1, 0x3e, 0x20, 0xc4,
-	0x08, 0xe4, 0xb4, 0x4b, 0xd9, 0x21, 0xc4, 0x53, 0x90, 0xe2, 0xfa, 0xec, 0x57, 0xbb, 0xbe, 0x6f,
-	0x2c, 0x80, 0xe3, 0x33, 0x5f, 0x90, 0xa3, 0xf5, 0x6a, 0x72, 0xbc, 0x09, 0xad, 0x88, 0x8f, 0xc9,
-	0x59, 0x6d, 0xe5, 0x1e, 0x1f, 0x93, 0xbc, 0xad, 0x44, 0x66, 0xf5, 0x9c, 0x0c, 0xed, 0xe7, 0x65,
-	0xd8, 0x7b, 0x5a, 0x85, 0x66, 0xe1, 0x82, 0xde, 0x85, 0x86, 0xa0, 0x2c, 0x9c, 0x12, 0xc3, 0xa9,
-	0x37, 0x27, 0xbe, 0xb3, 0xa7, 0x91, 0xbb, 0x15, 0xcf, 0xf8, 0xa0, 0x77, 0xa0, 0xae, 0xdb, 0xb7,
-	0x21, 0xf7, 0xfa, 0x3c, 0xe7, 0x7b, 0x0a, 0xb8, 0x5b, 0xf1, 0x72, 0x8f, 0xce, 0x00, 0x1a, 0x79,
-	0x38, 0xf4, 0x36, 0xd4, 0x14, 0x6f, 0x4d, 0xe0, 0xfc, 0xd6, 0xe5, 0x13, 0x31, 0x8a, 0x86, 0x7e,
-	0xf2, 0x0e, 0x55, 0x3c, 0x4f, 0x3b, 0x74, 0x1e, 0x5a, 0x50, 0xd7, 0x51, 0xd1, 0x5d, 0x68, 0x8e,
-	0xa8, 0xc4, 0x49, 0x82, 0x8b, 0xda, 0xba, 0x45, 0x98, 0x7c, 0xec, 0x38, 0xe5, 0x94, 0x29, 0x62,
-	0xdd, 0xe6, 0x51, 0x8c, 0x03, 0x39, 0xa4, 0x72, 0xa0, 0xdc, 0xbc, 0x32, 0x00, 0xba, 0x05, 0x50,
-	0x56, 0x5d, 0xb5, 0x34, 0xfb, 0xac, 0xb2, 0xb7, 0x8a, 0xb2, 0x8b, 0x61, 0x1d, 0x6c, 0x91, 0x46,
-	0xbd, 0x2f, 0xaa, 0x60, 0xef, 0x10, 0x82, 0x32, 0x68, 0xe0, 0x48, 0x75, 0x07, 0x23, 0xcc, 0x72,
-	0x90, 0xa8, 0xe9, 0x76, 0x82, 0x0a, 0x65, 0xc3, 0x9d, 0xc7, 0xbf, 0x5d, 0xaa, 0xfc, 0xf0, 0xfb,
-	0xa5, 0x7e, 0x48, 0xe5, 0x24, 0x1d, 0x39, 0x01, 0x8f, 0xdc, 0x62, 0x72, 0xea, 0xbf, 0x4d, 0x31,
-	0x7e, 0xe0, 0xca, 0x2c, 0x26, 0x42, 0x3b, 0x88, 0x6f, 0x9f, 0x3d, 0xba, 0xba, 0x38, 0x25, 0x21,
-	0x0e, 0x32, 0x5f, 0xcd, 0x47, 0xf1, 0xfd, 0xb3, 0x47, 0x57, 0x2d, 0xcf, 0x1c, 0x88, 0xd6, 0xa1,
-	0x15, 0x62, 0xe1, 0x4f, 0x69, 0x44, 0xa5, 0xbe, 0x9e, 0x9a, 0xd7, 0x0c, 0xb1, 0xf8, 0x48, 0xed,
-	0x91, 0x03, 0xf5, 0x18, 0x67, 0x24, 0xc9, 0x9b, 0xdc, 0xb0, 0xfd, 0xcb, 0x8f, 0x9b, 0xab, 0x86,
-	0xd9, 0x60, 0x3c, 0x4e, 0x88, 0x10, 0x7b, 0x32, 0xa1, 0x2c, 0xf4, 0x72, 0x18, 0xda, 0x82, 0x85,
-	0x30, 0xc1, 0x4c, 0x9a, 0xae, 0x37, 0xcf, 0xa3, 0x00, 0xf6, 0x7e, 0xb2, 0xc0, 0xde, 0xa7, 0xf1,
-	0xff, 0x59, 0x83, 0x6b, 0xd0, 0x90, 0x34, 0x8e, 0x49, 0x92, 0xf7, 0xc1, 0x39, 0xac, 0x0d, 0xee,
-	0x56, 0xb5, 0x6d, 0xf5, 0x7e, 0xb6, 0x60, 0x69, 0x90, 0xce, 0xf2, 0xc7, 0xbb, 0x8d, 0x25, 0x56,
-	0xe9, 0xe3, 0x1c, 0xae, 0xd5, 0x35, 0x37, 0x7d, 0x03, 0x44, 0xef, 0x41, 0x53, 0xc9, 0xd7, 0x1f,
-	0xf3, 0xc0, 0xbc, 0x8e, 0xcb, 0x2f, 0xe9, 0x4a, 0x27, 0xa7, 0x9a, 0xb7, 0x20, 0xcc, 0xf0, 0x2d,
-	0x5e, 0x85, 0xfd, 0x0f, 0x5f, 0x05, 0x5a, 0x06, 0x5b, 0xd0, 0x50, 0xdf, 0xd3, 0xa2, 0xa7, 0x96,
-	0xc3, 0xf7, 0x1f, 0x1f, 0x76, 0xad, 0x27, 0x87, 0x5d, 0xeb, 0xe9, 0x61, 0xd7, 0x7a, 0x78, 0xd4,
-	0xad, 0x3c, 0x39, 0xea, 0x56, 0x7e, 0x3d, 0xea, 0x56, 0xee, 0x5f, 0x39, 0xbb, 0xd0, 0xae, 0x9c,
-	0x8d, 0x1a, 0xba, 0x41, 0xdd, 0xf8, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x24, 0x52, 0x64, 0xe6, 0x23,
-	0x0a, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x7a, 0x6d, 0xc7, 0x7e, 0x4d, 0xda, 0x66, 0x14, 0xfd, 0xb5, 0x71, 0xfe, 0x75, 0x83,
+	0xab, 0x82, 0x55, 0x91, 0xdd, 0x36, 0x3d, 0x50, 0x2a, 0x04, 0xd8, 0x0d, 0x51, 0xaa, 0x52, 0x90,
+	0x36, 0x39, 0xf5, 0xb2, 0x1a, 0xef, 0x4e, 0xd6, 0xa3, 0x7a, 0x67, 0x96, 0x9d, 0x59, 0xf0, 0x1e,
+	0xf9, 0x00, 0x48, 0x15, 0x17, 0x24, 0xce, 0x1c, 0x10, 0xa7, 0x4a, 0x20, 0x3e, 0x43, 0x4f, 0xa8,
+	0xe2, 0xc4, 0x09, 0xaa, 0xe4, 0xd0, 0x3b, 0x5f, 0x00, 0xb4, 0xb3, 0xb3, 0x9b, 0xb4, 0x24, 0x71,
+	0x11, 0x48, 0x5c, 0xec, 0x99, 0xb7, 0xbf, 0xf7, 0x9b, 0xdf, 0x7b, 0xf3, 0xe6, 0x3d, 0xe8, 0xfa,
+	0x5c, 0x44, 0x5c, 0x38, 0x72, 0xe6, 0x7c, 0x7a, 0x63, 0x4c, 0x24, 0xbe, 0xe1, 0xc8, 0x99, 0x1d,
+	0x27, 0x5c, 0x72, 0xb4, 0x5c, 0x7c, 0xb3, 0xe5, 0xcc, 0xd6, 0xdf, 0xba, 0xcb, 0x38, 0xa2, 0x8c,
+	0x3b, 0xea, 0xb7, 0x40, 0x75, 0x57, 0x42, 0x1e, 0x72, 0xb5, 0x74, 0xf2, 0x95, 0xb6, 0x6e, 0x68,
+	0x5e, 0x3f, 0xc9, 0x62, 0xc9, 0x9d, 0x28, 0x9d, 0x4a, 0x2a, 0x68, 0x58, 0x1d, 0x52, 0x1a, 0x34,
+	0xbc, 0xa7, 0xe1, 0x63, 0x2c, 0x48, 0x85, 0xf1, 0x39, 0x65, 0xfa, 0xfb, 0x1b, 0x47, 0x32, 0x05,
+	0x0d, 0x19, 0x65, 0x47, 0x4c, 0x7a, 0xaf, 0x81, 0xab, 0x21, 0xe7, 0xe1, 0x94, 0x38, 0x6a, 0x37,
+	0x4e, 0xf7, 0x1d, 0xcc, 0xb2, 0xf2, 0x53, 0xc1, 0xe1, 0x15, 0x5a, 0x75, 0x6c, 0x6a, 0xd3, 0xff,
+	0xc2, 0x80, 0xfa, 0xde, 0x0c, 0x6d, 0x40, 0x63, 0xcc, 0x83, 0xcc, 0x32, 0xd6, 0x8d, 0xc1, 0xb9,
+	0xcd, 0x55, 0xfb, 0x2f, 0xf1, 0xdb, 0x7b, 0xb3, 0x11, 0x0f, 0x32, 0x57, 0xc1, 0xd0, 0x2d, 0xe8,
+	0xe0, 0x54, 0x4e, 0x3c, 0xca, 0xf6, 0xb9, 0x55, 0x57, 0x3e, 0x6b, 0x27, 0xf8, 0x0c, 0x53, 0x39,
+	0xb9, 0xcb, 0xf6, 0xb9, 0xdb, 0xc6, 0x7a, 0x85, 0x7a, 0x00, 0xb9, 0x6c, 0x2c, 0xd3, 0x84, 0x08,
+	0xcb, 0x5c, 0x37, 0x07, 0x8b, 0xee, 0x31, 0x4b, 0x9f, 0x41, 0x73, 0x6f, 0xe6, 0xe2, 0xcf, 0xd0,
+	0x25, 0x80, 0xfc, 0x28, 0x6f, 0x9c, 0x49, 0x22, 0x94, 0xae, 0x45, 0xb7, 0x93, 0x5b, 0x46, 0xb9,
+	0x01, 0xbd, 0x0e, 0x17, 0x2a, 0x05, 0x1a, 0x53, 0x57, 0x98, 0xa5, 0xf2, 0xa8, 0x02, 0x37, 0xef,
+	0xbc, 0x2f, 0x0d, 0x58, 0xd8, 0xa5, 0x21, 0xdb, 0xe2, 0xfe, 0xbf, 0x75, 0xe4, 0x2a, 0xb4, 0xfd,
+	0x09, 0xa6, 0xcc, 0xa3, 0x81, 0x65, 0xae, 0x1b, 0x83, 0x8e, 0xbb, 0xa0, 0xf6, 0x77, 0x03, 0x74,
+	0x15, 0xce, 0x63, 0xdf, 0xe7, 0x29, 0x93, 0x1e, 0x4b, 0xa3, 0x31, 0x49, 0xac, 0xc6, 0xba, 0x31,
+	0x68, 0xb8, 0x4b, 0xda, 0xfa, 0x91, 0x32, 0xf6, 0x7f, 0x37, 0xe0, 0xa2, 0x16, 0xb5, 0x45, 0x13,
+	0xe2, 0xcb, 0x61, 0x3a, 0x9b, 0xa7, 0xee, 0x26, 0x40, 0x9c, 0x8e, 0xa7, 0xd4, 0xf7, 0x1e, 0x92,
+	0x4c, 0xdf, 0xc9, 0x8a, 0x5d, 0xd4, 0x84, 0x5d, 0xd6, 0x84, 0x3d, 0x64, 0x99, 0xdb, 0x29, 0x70,
+	0xf7, 0x48, 0xf6, 0xcf, 0xa5, 0xa2, 0x2e, 0xb4, 0x05, 0xf9, 0x24, 0x25, 0xcc, 0x27, 0x56, 0x53,
+	0x01, 0xaa, 0x3d, 0x7a, 0x13, 0x4c, 0x49, 0x63, 0xab, 0xa5, 0xb4, 0xfc, 0xef, 0xa4, 0x9a, 0xa2,
+	0xf1, 0xa8, 0x6e, 0x19, 0x6e, 0x0e, 0xeb, 0x7f, 0x5f, 0x87, 0x56, 0x51, 0x64, 0xe8, 0x3a, 0xb4,
+	0x23, 0x22, 0x04, 0x0e, 0x55, 0xa0, 0xe6, 0xa9, 0x91, 0x54, 0x28, 0x84, 0xa0, 0x11, 0x91, 0xa8,
+	0xa8, 0xc5, 0x8e, 0xab, 0xd6, 0x79, 0x04, 0x92, 0x46, 0x84, 0xa7, 0xd2, 0x9b, 0x10, 0x1a, 0x4e,
+	0xa4, 0x0a, 0xb1, 0xe1, 0x2e, 0x69, 0xeb, 0x8e, 0x32, 0xa2, 0xff, 0x43, 0x27, 0x65, 0x3c, 0x09,
+	0x48, 0x42, 0x02, 0x15, 0x63, 0xdb, 0x3d, 0x32, 0xa0, 0x11, 0x2c, 0x93, 0x99, 0x24, 0x4c, 0x50,
+	0xce, 0x3c, 0x1e, 0x4b, 0xca, 0x99, 0xb0, 0xfe, 0x58, 0x38, 0x43, 0xd4, 0xc5, 0x0a, 0xff, 0x71,
+	0x01, 0x47, 0x0f, 0xa0, 0xc7, 0x38, 0xf3, 0xfc, 0x84, 0x4a, 0xea, 0xe3, 0xa9, 0x77, 0x02, 0xe1,
+	0x85, 0x33, 0x08, 0xd7, 0x18, 0x67, 0x77, 0xb4, 0xef, 0x07, 0x2f, 0x71, 0xf7, 0xbf, 0x31, 0xa0,
+	0x5d, 0x3e, 0x33, 0xf4, 0x3e, 0x2c, 0xe6, 0xa5, 0x4d, 0x12, 0x55, 0xa3, 0x65, 0xee, 0x2e, 0x9d,
+	0x90, 0xf9, 0x5d, 0x05, 0x53, 0x6f, 0xf3, 0x9c, 0xa8, 0xd6, 0x02, 0x0d, 0xc0, 0xdc, 0x27, 0xc4,
+	0xaa, 0x9f, 0x7a, 0x65, 0xdb, 0x84, 0xb8, 0x39, 0xa4, 0xbc, 0x5c, 0xf3, 0xd5, 0x2e, 0xf7, 0x2b,
+	0x03, 0xe0, 0xe8, 0xcc, 0x97, 0x8a, 0xd5, 0x78, 0xb5, 0x62, 0xbd, 0x05, 0x9d, 0x88, 0x07, 0x64,
+	0x5e, 0xd3, 0xb9, 0xcf, 0x03, 0x52, 0x34, 0x9d, 0x48, 0xaf, 0x5e, 0x28, 0x52, 0xf3, 0xc5, 0x22,
+	0xed, 0x3f, 0xab, 0x43, 0xbb, 0x74, 0x41, 0xef, 0x40, 0x4b, 0x50, 0x16, 0x4e, 0x89, 0xd6, 0xd4,
+	0x3f, 0x83, 0xdf, 0xde, 0x55, 0xc8, 0x9d, 0x9a, 0xab, 0x7d, 0xd0, 0xdb, 0xd0, 0x54, 0xcd, 0x5d,
+	0x8b, 0x7b, 0xed, 0x2c, 0xe7, 0xfb, 0x39, 0x70, 0xa7, 0xe6, 0x16, 0x1e, 0xdd, 0x21, 0xb4, 0x0a,
+	0x3a, 0xf4, 0x16, 0x34, 0x72, 0xdd, 0x4a, 0xc0, 0xf9, 0xcd, 0x2b, 0xc7, 0x38, 0xca, 0x76, 0x7f,
+	0xfc, 0x0e, 0x73, 0x3e, 0x57, 0x39, 0x74, 0x1f, 0x19, 0xd0, 0x54, 0xac, 0xe8, 0x1e, 0xb4, 0xc7,
+	0x54, 0xe2, 0x24, 0xc1, 0x65, 0x6e, 0x9d, 0x92, 0xa6, 0x18, 0x4a, 0x76, 0x35, 0x83, 0x4a, 0xae,
+	0x3b, 0x3c, 0x8a, 0xb1, 0x2f, 0x47, 0x54, 0x0e, 0x73, 0x37, 0xb7, 0x22, 0x40, 0xb7, 0x01, 0xaa,
+	0xac, 0xe7, 0x0d, 0xcf, 0x9c, 0x97, 0xf6, 0x4e, 0x99, 0x76, 0x31, 0x6a, 0x82, 0x29, 0xd2, 0xa8,
+	0xff, 0x79, 0x1d, 0xcc, 0x6d, 0x42, 0x50, 0x06, 0x2d, 0x1c, 0xe5, 0xbd, 0x43, 0x17, 0x66, 0x35,
+	0x66, 0xf2, 0xd9, 0x77, 0x4c, 0x0a, 0x65, 0xa3, 0xed, 0x27, 0xbf, 0x5e, 0xae, 0x7d, 0xf7, 0xdb,
+	0xe5, 0x41, 0x48, 0xe5, 0x24, 0x1d, 0xdb, 0x3e, 0x8f, 0x9c, 0x72, 0xae, 0xaa, 0xbf, 0x0d, 0x11,
+	0x3c, 0x74, 0x64, 0x16, 0x13, 0xa1, 0x1c, 0xc4, 0xd7, 0xcf, 0x1f, 0x5f, 0x5b, 0x9c, 0x92, 0x10,
+	0xfb, 0x99, 0x97, 0x4f, 0x4f, 0xf1, 0xed, 0xf3, 0xc7, 0xd7, 0x0c, 0x57, 0x1f, 0x88, 0xd6, 0xa0,
+	0x13, 0x62, 0xe1, 0x4d, 0x69, 0x44, 0xa5, 0xba, 0x9e, 0x86, 0xdb, 0x0e, 0xb1, 0xf8, 0x30, 0xdf,
+	0x23, 0x1b, 0x9a, 0x31, 0xce, 0x48, 0x52, 0xb4, 0xc0, 0x91, 0xf5, 0xf3, 0x0f, 0x1b, 0x2b, 0x5a,
+	0xd9, 0x30, 0x08, 0x12, 0x22, 0xc4, 0xae, 0x4c, 0x28, 0x0b, 0xdd, 0x02, 0x86, 0x36, 0x61, 0x21,
+	0x4c, 0x30, 0x93, 0xba, 0x27, 0x9e, 0xe5, 0x51, 0x02, 0xfb, 0x3f, 0x1a, 0x60, 0xee, 0xd1, 0xf8,
+	0xbf, 0xcc, 0xc1, 0x75, 0x68, 0x49, 0x1a, 0xc7, 0x24, 0xb1, 0xea, 0x73, 0x54, 0x6b, 0xdc, 0xed,
+	0xba, 0x65, 0xf4, 0x7f, 0x32, 0x60, 0x69, 0x98, 0xce, 0x8a, 0xc7, 0xbb, 0x85, 0x25, 0xce, 0xc3,
+	0xc7, 0x05, 0xdc, 0x32, 0xe6, 0x10, 0x95, 0x40, 0xf4, 0x2e, 0xb4, 0xf3, 0xf2, 0xf5, 0x02, 0xee,
+	0xeb, 0xd7, 0x71, 0xe5, 0x94, 0xae, 0x74, 0x7c, 0xe6, 0xb9, 0x0b, 0xa2, 0xb0, 0x54, 0xaf, 0xc2,
+	0xfc, 0x9b, 0xaf, 0x02, 0x5d, 0x04, 0x53, 0xd0, 0x50, 0xdd, 0xd3, 0xa2, 0x9b, 0x2f, 0x47, 0xef,
+	0x3d, 0x39, 0xe8, 0x19, 0x4f, 0x0f, 0x7a, 0xc6, 0xb3, 0x83, 0x9e, 0xf1, 0xe8, 0xb0, 0x57, 0x7b,
+	0x7a, 0xd8, 0xab, 0xfd, 0x72, 0xd8, 0xab, 0x3d, 0xb8, 0x3a, 0x3f, 0xd1, 0x8e, 0x9c, 0x8d, 0x5b,
+	0xaa, 0x41, 0xdd, 0xfc, 0x73, 0x00, 0xb1, 0x48, 0xf7, 0x44, 0x41, 0x0a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -1364,6 +1380,16 @@ func (m *TxBody) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xfa
 		}
 	}
+	if m.Unordered {
+		i--
+		if m.Unordered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TimeoutHeight != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutHeight))
 		i--
@@ -1942,6 +1968,9 @@ func (m *TxBody) Size() (n int) {
 	if m.TimeoutHeight != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutHeight))
 	}
+	if m.Unordered {
+		n += 2
+	}
 	if len(m.ExtensionOptions) > 0 {
 		for _, e := range m.ExtensionOptions {
 			l = e.Size()
@@ -2955,6 +2984,26 @@ func (m *TxBody) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unordered = bool(v != 0)
 		case 1023:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionOptions", wireType)
@@ -85,6 +85,21 @@ func (t *Tx) ValidateBasic() error {
 		}
 	}
 
+	if body.Unordered {
+		if body.TimeoutHeight == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_height set")
+		}
+
+		for _, signerInfo := range authInfo.SignerInfos {
+			if signerInfo.Sequence != 0 {
+				return errorsmod.Wrapf(
+					sdkerrors.ErrInvalidRequest,
+					"unordered transaction must have sequence 0 for every signer, got %d", signerInfo.Sequence,
+				)
+			}
+		}
+	}
+
 	sigs := t.Signatures
 
 	if len(sigs) == 0 {
@@ -79,6 +79,15 @@ type (
 		GetTimeoutHeight() uint64
 	}
 
+	// TxWithUnordered extends the TxWithTimeoutHeight interface by allowing a
+	// transaction to be unordered, i.e. to not be bound to the sequence of its
+	// signers, in which case its timeout height must be set.
+	TxWithUnordered interface {
+		TxWithTimeoutHeight
+
+		GetUnordered() bool
+	}
+
 	// HasValidateBasic defines a type that has a ValidateBasic method.
 	// ValidateBasic is deprecated and now facultative.
 	// Prefer validating messages directly in the msg server.
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// EndBlocker updates the base fee of the fee market based on the gas used by
+// the block, and removes the hashes of the unordered txs which timed out.
+func EndBlocker(ctx context.Context, ak keeper.AccountKeeper) error {
+	var gasUsed uint64
+	if blockGasMeter := sdk.UnwrapSDKContext(ctx).BlockGasMeter(); blockGasMeter != nil {
+		gasUsed = blockGasMeter.GasConsumed()
+	}
+
+	if err := ak.UpdateBaseFee(ctx, gasUsed); err != nil {
+		return err
+	}
+
+	return ak.RemoveExpiredUnorderedTxs(ctx)
+}
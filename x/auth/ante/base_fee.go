@@ -0,0 +1,70 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// checkBaseFee checks that the fee pays the base fee of the fee market for each
+// unit of gas of the tx, if the fee market is enabled. Unlike the min gas prices
+// of the validator, the base fee is part of the consensus and is thus enforced
+// during both CheckTx and FinalizeBlock, except for genesis txs.
+func checkBaseFee(ctx sdk.Context, ak AccountKeeper, feeTx sdk.FeeTx, fee sdk.Coins) error {
+	baseFee, enabled, err := getBaseFee(ctx, ak)
+	if err != nil || !enabled || ctx.BlockHeight() == 0 {
+		return err
+	}
+
+	requiredFee := requiredBaseFee(baseFee, feeTx.GetGas())
+	if fee.AmountOf(requiredFee.Denom).LT(requiredFee.Amount) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees to pay the base fee; got: %s required: %s", fee, requiredFee)
+	}
+
+	return nil
+}
+
+// newTxFeeCheckerWithBaseFee returns the default TxFeeChecker, which checks the
+// fee against the min gas prices of the validator. If the fee market is
+// enabled, the tx priority is the tip paid on top of the base fee per unit of
+// gas, otherwise it is computed from the gas price.
+func newTxFeeCheckerWithBaseFee(ak AccountKeeper) TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		fee, priority, err := checkTxFeeWithValidatorMinGasPrices(ctx, tx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		baseFee, enabled, err := getBaseFee(ctx, ak)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !enabled || ctx.BlockHeight() == 0 {
+			return fee, priority, nil
+		}
+
+		gas := tx.(sdk.FeeTx).GetGas()
+		tip := fee.AmountOf(baseFee.Denom).Sub(requiredBaseFee(baseFee, gas).Amount)
+		if !tip.IsPositive() {
+			return fee, 0, nil
+		}
+
+		return fee, getTxPriority(sdk.NewCoins(sdk.NewCoin(baseFee.Denom, tip)), int64(gas)), nil
+	}
+}
+
+// getBaseFee returns the base fee of the fee market without consuming gas, such
+// that the gas consumed by txs does not depend on whether it is enabled.
+func getBaseFee(ctx sdk.Context, ak AccountKeeper) (sdk.DecCoin, bool, error) {
+	return ak.GetBaseFee(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+}
+
+// requiredBaseFee returns the base fee to pay for the given gas, where
+// fee = ceil(baseFee * gas).
+func requiredBaseFee(baseFee sdk.DecCoin, gas uint64) sdk.Coin {
+	amount := baseFee.Amount.MulInt(sdkmath.NewIntFromUint64(gas))
+	return sdk.NewCoin(baseFee.Denom, amount.Ceil().RoundInt())
+}
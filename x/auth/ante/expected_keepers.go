@@ -13,12 +13,20 @@ import (
 // Interface provides support to use non-sdk AccountKeeper for AnteHandler's decorators.
 type AccountKeeper interface {
 	GetParams(ctx context.Context) (params types.Params)
+	GetBaseFee(ctx context.Context) (baseFee sdk.DecCoin, enabled bool, err error)
 	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	SetAccount(ctx context.Context, acc sdk.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	AddressCodec() address.Codec
 }
 
+// UnorderedTxKeeper defines the expected keeper recording the hashes of the
+// unordered txs included in a block until they time out.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) (bool, error)
+	AddUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) error
+}
+
 // FeegrantKeeper defines the expected feegrant keeper.
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
@@ -17,6 +17,7 @@ type TxFeeChecker func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error)
 
 // DeductFeeDecorator deducts fees from the fee payer. The fee payer is the fee granter (if specified) or first signer of the tx.
 // If the fee payer does not have the funds to pay for the fees, return an InsufficientFunds error.
+// If the fee market of the auth module is enabled, the fees must also pay the base fee for the gas limit of the tx.
 // Call next AnteHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
@@ -28,7 +29,7 @@ type DeductFeeDecorator struct {
 
 func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, tfc TxFeeChecker) DeductFeeDecorator {
 	if tfc == nil {
-		tfc = checkTxFeeWithValidatorMinGasPrices
+		tfc = newTxFeeCheckerWithBaseFee(ak)
 	}
 
 	return DeductFeeDecorator{
@@ -60,6 +61,10 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		if err != nil {
 			return ctx, err
 		}
+
+		if err := checkBaseFee(ctx, dfd.accountKeeper, feeTx, fee); err != nil {
+			return ctx, err
+		}
 	}
 	if err := dfd.checkDeductFee(ctx, tx, fee); err != nil {
 		return ctx, err
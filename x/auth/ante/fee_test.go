@@ -111,6 +111,53 @@ func TestEnsureMempoolFees(t *testing.T) {
 	require.Equal(t, int64(10), newCtx.Priority())
 }
 
+func TestDeductFeeDecorator_BaseFee(t *testing.T) {
+	s := SetupTestSuite(t, true)
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	params := authtypes.DefaultParams()
+	params.FeeMarket = authtypes.NewFeeMarketParams("atom", math.LegacyNewDec(8), 1000)
+	require.NoError(t, s.accountKeeper.Params.Set(s.ctx, params))
+
+	mfd := ante.NewDeductFeeDecorator(s.accountKeeper, s.bankKeeper, s.feeGrantKeeper, nil)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	accs := s.CreateTestAccounts(1)
+
+	// a gas price of 10atom, i.e. a tip of 2atom per unit of gas
+	msg := testdata.NewTestMsg(accs[0].acc.GetAddress())
+	feeAmount := testdata.NewTestFeeAmount()
+	require.NoError(t, s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(15)
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), accs[0].acc.GetAddress(), authtypes.FeeCollectorName, feeAmount).Return(nil).Times(3)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accs[0].priv}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(s.ctx, privs, accNums, accSeqs, s.ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	newCtx, err := antehandler(s.ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), newCtx.Priority())
+
+	_, err = antehandler(s.ctx.WithIsCheckTx(false), tx, false)
+	require.NoError(t, err)
+
+	// the base fee is enforced during both CheckTx and FinalizeBlock
+	require.NoError(t, s.accountKeeper.BaseFee.Set(s.ctx, math.LegacyNewDec(11)))
+
+	_, err = antehandler(s.ctx, tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	_, err = antehandler(s.ctx.WithIsCheckTx(false), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+
+	// but not for genesis txs
+	_, err = antehandler(s.ctx.WithIsCheckTx(false).WithBlockHeight(0), tx, false)
+	require.NoError(t, err)
+}
+
 func TestDeductFees(t *testing.T) {
 	s := SetupTestSuite(t, false)
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
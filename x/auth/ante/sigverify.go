@@ -285,8 +285,10 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
 		}
 
-		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		// Check account sequence number, unless the tx is unordered, in which
+		// case the sequence is zero as enforced by ValidateBasic.
+		unordered := IsUnorderedTx(tx)
+		if !unordered && sig.Sequence != acc.GetSequence() {
 			return ctx, errorsmod.Wrapf(
 				sdkerrors.ErrWrongSequence,
 				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
@@ -309,7 +311,7 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simul
 				Address:       acc.GetAddress().String(),
 				ChainID:       chainID,
 				AccountNumber: accNum,
-				Sequence:      acc.GetSequence(),
+				Sequence:      sig.Sequence,
 				PubKey: &anypb.Any{
 					TypeUrl: anyPk.TypeUrl,
 					Value:   anyPk.Value,
@@ -364,6 +366,11 @@ func (isd IncrementSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, sim
 		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	// unordered txs are not bound to the sequence of their signers
+	if IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
 	// increment sequence of all signers
 	signers, err := sigTx.GetSigners()
 	if err != nil {
@@ -440,6 +447,13 @@ func DefaultSigVerificationGasConsumer(
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *secp256r1.WebAuthnPubKey:
+		if params.SigVerifyCostWebAuthn == 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "WebAuthn public keys are disabled")
+		}
+		meter.ConsumeGas(params.SigVerifyCostWebAuthn, "ante verify: webauthn secp256r1")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -77,6 +77,8 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 	msg := []byte{1, 2, 3, 4}
 
 	p := types.DefaultParams()
+	noWebAuthn := types.DefaultParams()
+	noWebAuthn.SigVerifyCostWebAuthn = 0
 	skR1, _ := secp256r1.GenPrivKey()
 	pkSet1, sigSet1 := generatePubKeysAndSignatures(5, msg, false)
 	multisigKey1 := kmultisig.NewLegacyAminoPubKey(2, pkSet1)
@@ -105,6 +107,8 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		{"PubKeyEd25519", args{storetypes.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, p.SigVerifyCostED25519, true},
 		{"PubKeySecp256k1", args{storetypes.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, p.SigVerifyCostSecp256k1, false},
 		{"PubKeySecp256r1", args{storetypes.NewInfiniteGasMeter(), nil, skR1.PubKey(), params}, p.SigVerifyCostSecp256r1(), false},
+		{"WebAuthnPubKey", args{storetypes.NewInfiniteGasMeter(), nil, secp256r1.NewWebAuthnPubKey(skR1.PubKey().(*secp256r1.PubKey)), params}, p.SigVerifyCostWebAuthn, false},
+		{"WebAuthnPubKey disabled", args{storetypes.NewInfiniteGasMeter(), nil, secp256r1.NewWebAuthnPubKey(skR1.PubKey().(*secp256r1.PubKey)), noWebAuthn}, 0, true},
 		{"Multisig", args{storetypes.NewInfiniteGasMeter(), multisignature1, multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{storetypes.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
 	}
@@ -0,0 +1,190 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// UnorderedTxDecorator defines an AnteHandler decorator that is responsible for
+// checking if a transaction is intended to be unordered and, if so, evaluates
+// the transaction accordingly. An unordered transaction bypasses the sequence
+// checks and increments of its signers, which allows fire-and-forget and
+// concurrent transaction broadcasting, removing the necessity of ordering on
+// the sender-side.
+//
+// Instead, replays are prevented by recording a hash of the content of every
+// unordered transaction in state until its mandatory timeout height, from which
+// point it can no longer be included in a block. The hash doesn't cover the
+// signatures nor the raw tx bytes, so re-encoding a transaction doesn't allow
+// replaying it. The timeout height may not be further
+// ahead of the current block height than the UnorderedTxMaxTimeoutDelta param,
+// which bounds the number of hashes kept in state. Unordered transactions are
+// rejected if the param is zero or if no UnorderedTxKeeper is provided.
+//
+// Since the legacy amino JSON and textual sign modes do not cover the unordered
+// flag of the transaction, unordered transactions must be signed with
+// SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX.
+//
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type UnorderedTxDecorator struct {
+	ak  AccountKeeper
+	utk UnorderedTxKeeper
+}
+
+func NewUnorderedTxDecorator(ak AccountKeeper, utk UnorderedTxKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		ak:  ak,
+		utk: utk,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	maxTimeoutDelta := utd.ak.GetParams(ctx).UnorderedTxMaxTimeoutDelta
+	if utd.utk == nil || maxTimeoutDelta == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrNotSupported, "unordered transactions are not supported")
+	}
+
+	timeoutHeight := tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unordered transaction must have timeout_height set")
+	}
+	if timeoutHeight > uint64(ctx.BlockHeight())+maxTimeoutDelta {
+		return ctx, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"unordered transaction has a timeout_height that is too far in the future; block height: %d, timeout height: %d, max delta: %d",
+			ctx.BlockHeight(), timeoutHeight, maxTimeoutDelta,
+		)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	// signatures are not set when simulating
+	if !simulate {
+		for _, sig := range sigs {
+			if !signsUnordered(sig.Data) {
+				return ctx, errorsmod.Wrap(
+					sdkerrors.ErrNotSupported,
+					"unordered transactions must be signed with SIGN_MODE_DIRECT or SIGN_MODE_DIRECT_AUX",
+				)
+			}
+		}
+	}
+
+	txHash, err := unorderedTxHash(tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	contains, err := utd.utk.ContainsUnorderedTx(ctx, timeoutHeight, txHash)
+	if err != nil {
+		return ctx, err
+	}
+	if contains {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unordered transaction %X has already been included", txHash)
+	}
+
+	if err := utd.utk.AddUnorderedTx(ctx, timeoutHeight, txHash); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// IsUnorderedTx returns whether the tx is unordered, in which case the sequences
+// of its signers are neither checked nor incremented.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// unorderedTxHash returns the hash an unordered tx is recorded under. It covers
+// the messages, memo, timeout height, extension options and fee of the tx,
+// encoded deterministically, which every sign mode accepted for unordered txs
+// signs over.
+func unorderedTxHash(tx sdk.Tx) ([]byte, error) {
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	// re-pack the messages so that their encoding is canonical
+	msgs, err := txtypes.SetMsgs(tx.GetMsgs())
+	if err != nil {
+		return nil, err
+	}
+
+	body := &txtypes.TxBody{
+		Messages:      msgs,
+		Memo:          memoTx.GetMemo(),
+		TimeoutHeight: tx.(sdk.TxWithUnordered).GetTimeoutHeight(),
+		Unordered:     true,
+	}
+	if extTx, ok := tx.(HasExtensionOptionsTx); ok {
+		body.ExtensionOptions = extTx.GetExtensionOptions()
+		body.NonCriticalExtensionOptions = extTx.GetNonCriticalExtensionOptions()
+	}
+
+	bodyBz, err := body.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	feeBz, err := (&txtypes.Fee{Amount: feeTx.GetFee(), GasLimit: feeTx.GetGas()}).Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	for _, bz := range [][]byte{bodyBz, feeBz, feeTx.FeePayer(), feeTx.FeeGranter()} {
+		h.Write(binary.AppendUvarint(nil, uint64(len(bz))))
+		h.Write(bz)
+	}
+	return h.Sum(nil), nil
+}
+
+// signsUnordered checks SignatureData to see if all signers are using a sign
+// mode covering the unordered flag of the tx, i.e. SIGN_MODE_DIRECT or
+// SIGN_MODE_DIRECT_AUX, which sign over the body bytes.
+func signsUnordered(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		switch v.SignMode {
+		case signing.SignMode_SIGN_MODE_DIRECT, signing.SignMode_SIGN_MODE_DIRECT_AUX:
+			return true
+		default:
+			return false
+		}
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if !signsUnordered(s) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
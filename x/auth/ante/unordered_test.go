@@ -0,0 +1,190 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestUnorderedTxDecorator(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	suite.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	anteHandler, err := ante.NewAnteHandler(
+		ante.HandlerOptions{
+			AccountKeeper:     suite.accountKeeper,
+			BankKeeper:        suite.bankKeeper,
+			FeegrantKeeper:    suite.feeGrantKeeper,
+			SignModeHandler:   suite.encCfg.TxConfig.SignModeHandler(),
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: suite.accountKeeper,
+		},
+	)
+	require.NoError(t, err)
+
+	accs := suite.CreateTestAccounts(1)
+	acc := accs[0]
+
+	newTxBuilder := func(timeout uint64, memo string) {
+		suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, suite.txBuilder.SetMsgs(testdata.NewTestMsg(acc.acc.GetAddress())))
+		suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+		suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+		suite.txBuilder.SetMemo(memo)
+		suite.txBuilder.SetTimeoutHeight(timeout)
+		suite.txBuilder.SetUnordered(true)
+	}
+
+	runTx := func(ctx sdk.Context, tx sdk.Tx) error {
+		txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		_, err = anteHandler(ctx.WithTxBytes(txBytes), tx, false)
+		return err
+	}
+
+	runSignedTx := func(ctx sdk.Context, timeout uint64, memo string, seq uint64) error {
+		newTxBuilder(timeout, memo)
+
+		privs, accNums, accSeqs := []cryptotypes.PrivKey{acc.priv}, []uint64{acc.acc.GetAccountNumber()}, []uint64{seq}
+		tx, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+		require.NoError(t, err)
+
+		return runTx(ctx, tx)
+	}
+
+	// unordered txs are disabled by default
+	err = runSignedTx(suite.ctx, 5, "", 0)
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	params := types.DefaultParams()
+	params.UnorderedTxMaxTimeoutDelta = 10
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+
+	// the timeout height is mandatory and bounded
+	err = runSignedTx(suite.ctx, 0, "", 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	err = runSignedTx(suite.ctx, 12, "", 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the sequence must be zero
+	err = runSignedTx(suite.ctx, 5, "", 1)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the unordered flag must be covered by the signature
+	newTxBuilder(5, "")
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: acc.priv.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_TEXTUAL,
+			Signature: []byte("signature"),
+		},
+	}))
+	err = runTx(suite.ctx, suite.txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	// neither do legacy amino JSON signatures
+	newTxBuilder(5, "amino")
+	require.NoError(t, suite.txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: acc.priv.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+			Signature: []byte("signature"),
+		},
+	}))
+	err = runTx(suite.ctx, suite.txBuilder.GetTx())
+	require.ErrorIs(t, err, sdkerrors.ErrNotSupported)
+
+	// distinct unordered txs of the same signer are accepted without touching
+	// its sequence, but not replayed
+	require.NoError(t, runSignedTx(suite.ctx, 5, "first", 0))
+	require.NoError(t, runSignedTx(suite.ctx, 5, "second", 0))
+	require.Equal(t, uint64(0), suite.accountKeeper.GetAccount(suite.ctx, acc.acc.GetAddress()).GetSequence())
+
+	err = runSignedTx(suite.ctx, 5, "first", 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the hashes are kept until the txs time out
+	require.NoError(t, auth.EndBlocker(suite.ctx.WithBlockHeight(4), suite.accountKeeper))
+	err = runSignedTx(suite.ctx, 5, "first", 0)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	require.NoError(t, auth.EndBlocker(suite.ctx.WithBlockHeight(5), suite.accountKeeper))
+	iter, err := suite.accountKeeper.UnorderedTxs.Iterate(suite.ctx, nil)
+	require.NoError(t, err)
+	keys, err := iter.Keys()
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
+func TestUnorderedTxDecoratorReencodedReplay(t *testing.T) {
+	suite := SetupTestSuite(t, false)
+	params := types.DefaultParams()
+	params.UnorderedTxMaxTimeoutDelta = 10
+	require.NoError(t, suite.accountKeeper.Params.Set(suite.ctx, params))
+	anteHandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(suite.accountKeeper, suite.accountKeeper))
+
+	privs := []cryptotypes.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := []cryptotypes.PubKey{privs[0].PubKey(), privs[1].PubKey()}
+	multisigKey := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	sigData := multisig.NewMultisig(len(pubKeys))
+	for _, pk := range pubKeys {
+		require.NoError(t, multisig.AddSignatureV2(sigData, signing.SignatureV2{
+			PubKey: pk,
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: []byte("signature")},
+		}, pubKeys))
+	}
+
+	txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(sdk.AccAddress(multisigKey.Address()))))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetTimeoutHeight(5)
+	txBuilder.SetUnordered(true)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{PubKey: multisigKey, Data: sigData}))
+
+	txBytes, err := suite.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	// re-encode the MultiSignature with overlong field tags, which decodes to
+	// the same signatures
+	var raw txtypes.TxRaw
+	require.NoError(t, raw.Unmarshal(txBytes))
+	var multiSig cryptotypes.MultiSignature
+	require.NoError(t, multiSig.Unmarshal(raw.Signatures[0]))
+	var reencoded []byte
+	for _, sig := range multiSig.Signatures {
+		reencoded = append(reencoded, 0x8a, 0x00, byte(len(sig)))
+		reencoded = append(reencoded, sig...)
+	}
+	raw.Signatures[0] = reencoded
+	replayBytes, err := raw.Marshal()
+	require.NoError(t, err)
+	require.NotEqual(t, txBytes, replayBytes)
+
+	replayTx, err := suite.clientCtx.TxConfig.TxDecoder()(replayBytes)
+	require.NoError(t, err)
+	replaySigs, err := replayTx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+	require.NoError(t, err)
+	require.Equal(t, sigData, replaySigs[0].Data)
+
+	_, err = anteHandler(suite.ctx.WithTxBytes(txBytes), txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	_, err = anteHandler(suite.ctx.WithTxBytes(replayBytes), replayTx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// GetSimulateBundleCmd returns a command that simulates an ordered bundle of
+// transactions.
+func GetSimulateBundleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-bundle [file_path]...",
+		Short: "Simulate the execution of an ordered bundle of transactions",
+		Long: strings.TrimSpace(`Simulate the execution of an ordered bundle of transactions,
+where every transaction observes the state changes of the transactions preceding
+it, on top of the latest state of the node. The result of every transaction is
+printed, which includes its gas consumption and either the events it emitted or
+the error it failed with. As in a block, a failed transaction does not abort the
+bundle, its state changes being discarded.
+
+Read the JSON-encoded transactions from [file_path], one transaction per line,
+e.g. as generated by the sign-batch command. Transactions are simulated in the
+order of the files and of the lines of every file. If you supply a dash (-)
+argument in place of an input filename, the command reads from standard input.
+
+Signatures are not verified, yet the signer infos of the transactions must be
+set, with the sequences of the transactions of a given signer following each
+other.`),
+		Example: fmt.Sprintf("%s tx simulate-bundle <file_path>...", version.AppName),
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); offline {
+				return errors.New("cannot simulate txs during offline mode")
+			}
+
+			scanner, err := authclient.ReadTxsFromInput(clientCtx.TxConfig, args...)
+			if err != nil {
+				return err
+			}
+
+			var txsBytes [][]byte
+			for scanner.Scan() {
+				txBytes, err := clientCtx.TxConfig.TxEncoder()(scanner.Tx())
+				if err != nil {
+					return err
+				}
+
+				txsBytes = append(txsBytes, txBytes)
+			}
+			if err := scanner.UnmarshalErr(); err != nil {
+				return err
+			}
+
+			res, err := tx.SimulateBundle(clientCtx, txsBytes)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
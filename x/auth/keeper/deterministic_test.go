@@ -203,6 +203,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 			rapid.Uint64Min(1).Draw(t, "tx-size-cost-per-byte"),
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-ed25519"),
 			rapid.Uint64Min(1).Draw(t, "sig-verify-cost-Secp256k1"),
+			rapid.Uint64().Draw(t, "sig-verify-cost-webauthn"),
 		)
 		err := suite.accountKeeper.Params.Set(suite.ctx, params)
 		suite.Require().NoError(err)
@@ -212,7 +213,7 @@ func (suite *DeterministicTestSuite) TestGRPCQueryParameters() {
 	})
 
 	// Regression test
-	params := types.NewParams(15, 167, 100, 1, 21457)
+	params := types.NewParams(15, 167, 100, 1, 21457, 0)
 
 	err := suite.accountKeeper.Params.Set(suite.ctx, params)
 	suite.Require().NoError(err)
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBaseFee returns the current base fee per unit of gas of the fee market,
+// and false if the fee market is disabled. The base fee defaults to the min
+// base fee of the fee market until it is first updated.
+func (ak AccountKeeper) GetBaseFee(ctx context.Context) (sdk.DecCoin, bool, error) {
+	feeMarket := ak.GetParams(ctx).FeeMarket
+	if feeMarket == nil {
+		return sdk.DecCoin{}, false, nil
+	}
+
+	baseFee, err := ak.BaseFee.Get(ctx)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return sdk.DecCoin{}, false, err
+		}
+
+		baseFee = feeMarket.MinBaseFee
+	}
+
+	// the min base fee may have been raised since the last update
+	if baseFee.LT(feeMarket.MinBaseFee) {
+		baseFee = feeMarket.MinBaseFee
+	}
+
+	return sdk.NewDecCoinFromDec(feeMarket.Denom, baseFee), true, nil
+}
+
+// UpdateBaseFee sets the base fee of the next block given the gas used by the
+// current block. It is a no-op if the fee market is disabled.
+func (ak AccountKeeper) UpdateBaseFee(ctx context.Context, blockGasUsed uint64) error {
+	baseFee, enabled, err := ak.GetBaseFee(ctx)
+	if err != nil || !enabled {
+		return err
+	}
+
+	feeMarket := ak.GetParams(ctx).FeeMarket
+	return ak.BaseFee.Set(ctx, feeMarket.NextBaseFee(baseFee.Amount, blockGasUsed))
+}
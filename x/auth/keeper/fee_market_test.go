@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (suite *KeeperTestSuite) TestBaseFee() {
+	suite.SetupTest() // reset
+	ctx := suite.ctx
+	require := suite.Require()
+
+	// the fee market is disabled by default
+	require.NoError(suite.accountKeeper.Params.Set(ctx, types.DefaultParams()))
+	_, enabled, err := suite.accountKeeper.GetBaseFee(ctx)
+	require.NoError(err)
+	require.False(enabled)
+	require.NoError(suite.accountKeeper.UpdateBaseFee(ctx, 1000))
+
+	res, err := suite.queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Empty(res.BaseFee)
+
+	params := types.DefaultParams()
+	params.FeeMarket = types.NewFeeMarketParams("stake", math.LegacyNewDec(8), 1000)
+	_, err = suite.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: suite.accountKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(err)
+
+	// the base fee starts from the min base fee and increases with full blocks
+	baseFee, enabled, err := suite.accountKeeper.GetBaseFee(ctx)
+	require.NoError(err)
+	require.True(enabled)
+	require.Equal(sdk.NewDecCoin("stake", math.NewInt(8)), baseFee)
+
+	blockGasMeter := storetypes.NewInfiniteGasMeter()
+	blockGasMeter.ConsumeGas(2000, "block")
+	require.NoError(auth.EndBlocker(ctx.WithBlockGasMeter(blockGasMeter), suite.accountKeeper))
+
+	res, err = suite.queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(9))), res.BaseFee)
+
+	// the base fee is exported and imported along with the params
+	genState := suite.accountKeeper.ExportGenesis(ctx)
+	require.Equal(math.LegacyNewDec(9), genState.BaseFee)
+	require.NoError(types.ValidateGenesis(*genState))
+
+	suite.SetupTest() // reset
+	suite.accountKeeper.InitGenesis(suite.ctx, *genState)
+	baseFee, _, err = suite.accountKeeper.GetBaseFee(suite.ctx)
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoin("stake", math.NewInt(9)), baseFee)
+
+	// the base fee restarts from the min base fee if the denom changes
+	params.FeeMarket.Denom = "atom"
+	_, err = suite.msgServer.UpdateParams(suite.ctx, &types.MsgUpdateParams{
+		Authority: suite.accountKeeper.GetAuthority(),
+		Params:    params,
+	})
+	require.NoError(err)
+
+	baseFee, _, err = suite.accountKeeper.GetBaseFee(suite.ctx)
+	require.NoError(err)
+	require.Equal(sdk.NewDecCoin("atom", math.NewInt(8)), baseFee)
+}
@@ -16,6 +16,12 @@ func (ak AccountKeeper) InitGenesis(ctx context.Context, data types.GenesisState
 		panic(err)
 	}
 
+	if data.Params.FeeMarket != nil && !data.BaseFee.IsNil() && data.BaseFee.IsPositive() {
+		if err := ak.BaseFee.Set(ctx, data.BaseFee); err != nil {
+			panic(err)
+		}
+	}
+
 	accounts, err := types.UnpackAccounts(data.Accounts)
 	if err != nil {
 		panic(err)
@@ -47,5 +53,14 @@ func (ak AccountKeeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+	baseFee, enabled, err := ak.GetBaseFee(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if enabled {
+		genState.BaseFee = baseFee.Amount
+	}
+
+	return genState
 }
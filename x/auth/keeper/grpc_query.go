@@ -101,6 +101,25 @@ func (s queryServer) Params(ctx context.Context, req *types.QueryParamsRequest)
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// BaseFee returns the current base fee of the fee market
+func (s queryServer) BaseFee(ctx context.Context, req *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	fee, enabled, err := s.k.GetBaseFee(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var baseFee sdk.DecCoins
+	if enabled {
+		baseFee = sdk.NewDecCoins(fee)
+	}
+
+	return &types.QueryBaseFeeResponse{BaseFee: baseFee}, nil
+}
+
 // ModuleAccounts returns all the existing Module Accounts
 func (s queryServer) ModuleAccounts(ctx context.Context, req *types.QueryModuleAccountsRequest) (*types.QueryModuleAccountsResponse, error) {
 	if req == nil {
@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -103,6 +104,10 @@ type AccountKeeper struct {
 	AccountNumber collections.Sequence
 	// Accounts key: AccAddr | value: AccountI | index: AccountsIndex
 	Accounts *collections.IndexedMap[sdk.AccAddress, sdk.AccountI, AccountsIndexes]
+	// BaseFee is the current base fee per unit of gas of the fee market
+	BaseFee collections.Item[math.LegacyDec]
+	// UnorderedTxs key: TimeoutHeight+TxHash | value: none
+	UnorderedTxs collections.KeySet[collections.Pair[uint64, []byte]]
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -135,6 +140,8 @@ func NewAccountKeeper(
 		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		AccountNumber: collections.NewSequence(sb, types.GlobalAccountNumberKey, "account_number"),
 		Accounts:      collections.NewIndexedMap(sb, types.AddressStoreKeyPrefix, "accounts", sdk.AccAddressKey, codec.CollInterfaceValue[sdk.AccountI](cdc), NewAccountIndexes(sb)),
+		BaseFee:       collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.LegacyDecValue),
+		UnorderedTxs:  collections.NewKeySet(sb, types.UnorderedTxsKeyPrefix, "unordered_txs", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey)),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -33,6 +33,16 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the base fee restarts from the min base fee when the fee market is
+	// disabled or charges another denom
+	feeMarket := ms.ak.GetParams(ctx).FeeMarket
+	if feeMarket == nil || msg.Params.FeeMarket == nil || feeMarket.Denom != msg.Params.FeeMarket.Denom {
+		if err := ms.ak.BaseFee.Remove(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := ms.ak.Params.Set(ctx, msg.Params); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContainsUnorderedTx returns whether an unordered tx with the given timeout
+// height and hash has already been included in a block and has not timed out.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) (bool, error) {
+	return ak.UnorderedTxs.Has(ctx, collections.Join(timeoutHeight, txHash))
+}
+
+// AddUnorderedTx records the hash of an unordered tx until its timeout height,
+// such that it cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx context.Context, timeoutHeight uint64, txHash []byte) error {
+	return ak.UnorderedTxs.Set(ctx, collections.Join(timeoutHeight, txHash))
+}
+
+// RemoveExpiredUnorderedTxs removes the hashes of the unordered txs timing out
+// at or before the current block height, as they can no longer be included in
+// any subsequent block.
+func (ak AccountKeeper) RemoveExpiredUnorderedTxs(ctx context.Context) error {
+	height := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return ak.UnorderedTxs.Clear(ctx, collections.NewPrefixUntilPairRange[uint64, []byte](height))
+}
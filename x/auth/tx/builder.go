@@ -221,6 +221,11 @@ func (w *wrapper) GetTimeoutHeight() uint64 {
 	return w.tx.Body.TimeoutHeight
 }
 
+// GetUnordered returns whether the transaction is unordered.
+func (w *wrapper) GetUnordered() bool {
+	return w.tx.Body.Unordered
+}
+
 func (w *wrapper) GetSignaturesV2() ([]signing.SignatureV2, error) {
 	signerInfos := w.tx.AuthInfo.SignerInfos
 	sigs := w.tx.Signatures
@@ -283,6 +288,14 @@ func (w *wrapper) SetTimeoutHeight(height uint64) {
 	w.bodyBz = nil
 }
 
+// SetUnordered sets whether the transaction is unordered.
+func (w *wrapper) SetUnordered(v bool) {
+	w.tx.Body.Unordered = v
+
+	// set bodyBz to nil because the cached bodyBz no longer matches tx.Body
+	w.bodyBz = nil
+}
+
 func (w *wrapper) SetMemo(memo string) {
 	w.tx.Body.Memo = memo
 
@@ -456,6 +469,9 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 	if w.tx.Body.TimeoutHeight != 0 && w.tx.Body.TimeoutHeight != body.TimeoutHeight {
 		return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has timeout height %d, got %d in AuxSignerData", w.tx.Body.TimeoutHeight, body.TimeoutHeight)
 	}
+	if w.tx.Body.Unordered && !body.Unordered {
+		return sdkerrors.ErrInvalidRequest.Wrap("TxBuilder is unordered, got ordered tx in AuxSignerData")
+	}
 	if len(w.tx.Body.ExtensionOptions) != 0 {
 		if len(w.tx.Body.ExtensionOptions) != len(body.ExtensionOptions) {
 			return sdkerrors.ErrInvalidRequest.Wrapf("TxBuilder has %d extension options, got %d in AuxSignerData", len(w.tx.Body.ExtensionOptions), len(body.ExtensionOptions))
@@ -489,6 +505,7 @@ func (w *wrapper) AddAuxSignerData(data tx.AuxSignerData) error {
 
 	w.SetMemo(body.Memo)
 	w.SetTimeoutHeight(body.TimeoutHeight)
+	w.SetUnordered(body.Unordered)
 	w.SetExtensionOptions(body.ExtensionOptions...)
 	w.SetNonCriticalExtensionOptions(body.NonCriticalExtensionOptions...)
 	msgs := make([]sdk.Msg, len(body.Messages))
@@ -138,13 +138,17 @@ func newAnteHandler(txConfig client.TxConfig, in ModuleInputs) (sdk.AnteHandler,
 		return nil, fmt.Errorf("both AccountKeeper and BankKeeper are required")
 	}
 
+	// the x/auth keeper records the unordered txs, if they are enabled
+	unorderedTxKeeper, _ := in.AccountKeeper.(ante.UnorderedTxKeeper)
+
 	anteHandler, err := ante.NewAnteHandler(
 		ante.HandlerOptions{
-			AccountKeeper:   in.AccountKeeper,
-			BankKeeper:      in.BankKeeper,
-			SignModeHandler: txConfig.SignModeHandler(),
-			FeegrantKeeper:  in.FeeGrantKeeper,
-			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+			AccountKeeper:     in.AccountKeeper,
+			BankKeeper:        in.BankKeeper,
+			SignModeHandler:   txConfig.SignModeHandler(),
+			FeegrantKeeper:    in.FeeGrantKeeper,
+			SigGasConsumer:    ante.DefaultSigVerificationGasConsumer,
+			UnorderedTxKeeper: unorderedTxKeeper,
 		},
 	)
 	if err != nil {
@@ -44,6 +44,7 @@ func TestUnknownFields(t *testing.T) {
 	tests := []struct {
 		name      string
 		body      *testdata.TestUpdatedTxBody
+		bodyExtra []byte
 		authInfo  *testdata.TestUpdatedAuthInfo
 		shouldErr bool
 	}{
@@ -65,11 +66,21 @@ func TestUnknownFields(t *testing.T) {
 			shouldErr: false,
 		},
 		{
-			name: "critical fields in TxBody should error on decode",
+			name: "field 4 in TxBody is the unordered flag and should pass",
 			body: &testdata.TestUpdatedTxBody{
 				Memo:         "foo",
-				SomeNewField: 10,
+				SomeNewField: 1,
+			},
+			authInfo:  &testdata.TestUpdatedAuthInfo{},
+			shouldErr: false,
+		},
+		{
+			name: "critical fields in TxBody should error on decode",
+			body: &testdata.TestUpdatedTxBody{
+				Memo: "foo",
 			},
+			// field 10, varint 10
+			bodyExtra: []byte{0x50, 0x0a},
 			authInfo:  &testdata.TestUpdatedAuthInfo{},
 			shouldErr: true,
 		},
@@ -100,6 +111,7 @@ func TestUnknownFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			bodyBz, err := tt.body.Marshal()
 			require.NoError(t, err)
+			bodyBz = append(bodyBz, tt.bodyExtra...)
 
 			authInfoBz, err := tt.authInfo.Marshal()
 			require.NoError(t, err)
@@ -2,6 +2,7 @@ package tx
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -11,6 +12,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -24,20 +27,40 @@ import (
 // baseAppSimulateFn is the signature of the Baseapp#Simulate function.
 type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 
+// baseAppSimulateBundleFn is the signature of the Baseapp#SimulateBundle function.
+type baseAppSimulateBundleFn func(txs [][]byte) ([]sdk.SimulationResult, error)
+
 // txServer is the server for the protobuf Tx service.
 type txServer struct {
 	clientCtx         client.Context
 	simulate          baseAppSimulateFn
+	simulateBundle    baseAppSimulateBundleFn
 	interfaceRegistry codectypes.InterfaceRegistry
 }
 
+// TxServerOption defines an option of the Tx service server.
+type TxServerOption func(*txServer)
+
+// WithSimulateBundle enables the SimulateBundle RPC method of the Tx service,
+// which is otherwise unimplemented, e.g. with Baseapp#SimulateBundle.
+func WithSimulateBundle(simulateBundle baseAppSimulateBundleFn) TxServerOption {
+	return func(s *txServer) {
+		s.simulateBundle = simulateBundle
+	}
+}
+
 // NewTxServer creates a new Tx service server.
-func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry) txtypes.ServiceServer {
-	return txServer{
+func NewTxServer(clientCtx client.Context, simulate baseAppSimulateFn, interfaceRegistry codectypes.InterfaceRegistry, opts ...TxServerOption) txtypes.ServiceServer {
+	s := txServer{
 		clientCtx:         clientCtx,
 		simulate:          simulate,
 		interfaceRegistry: interfaceRegistry,
 	}
+	for _, opt := range opts {
+		opt(&s)
+	}
+
+	return s
 }
 
 var _ txtypes.ServiceServer = txServer{}
@@ -106,6 +129,52 @@ func (s txServer) Simulate(ctx context.Context, req *txtypes.SimulateRequest) (*
 	}, nil
 }
 
+// SimulateBundle implements the ServiceServer.SimulateBundle RPC method.
+func (s txServer) SimulateBundle(ctx context.Context, req *txtypes.SimulateBundleRequest) (*txtypes.SimulateBundleResponse, error) {
+	if req == nil || len(req.TxsBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty bundle is not allowed")
+	}
+
+	if s.simulateBundle == nil {
+		return nil, status.Error(codes.Unimplemented, "bundle simulation is not enabled")
+	}
+
+	for i, txBytes := range req.TxsBytes {
+		if len(txBytes) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "empty txBytes of tx %d is not allowed", i)
+		}
+	}
+
+	results, err := s.simulateBundle(req.TxsBytes)
+	switch {
+	case errors.Is(err, sdkerrors.ErrOutOfGas):
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var total sdk.GasInfo
+	resp := &txtypes.SimulateBundleResponse{
+		Results: make([]*txtypes.SimulateBundleTxResult, len(results)),
+		GasInfo: &total,
+	}
+	for i, res := range results {
+		gasInfo := res.GasInfo
+		total.GasWanted += gasInfo.GasWanted
+		total.GasUsed += gasInfo.GasUsed
+
+		txResult := &txtypes.SimulateBundleTxResult{GasInfo: &gasInfo, Result: res.Result}
+		if res.Err != nil {
+			txResult.Codespace, txResult.Code, txResult.Error = errorsmod.ABCIInfo(res.Err, false)
+			txResult.Result = nil
+		}
+
+		resp.Results[i] = txResult
+	}
+
+	return resp, nil
+}
+
 // GetTx implements the ServiceServer.GetTx RPC method.
 func (s txServer) GetTx(ctx context.Context, req *txtypes.GetTxRequest) (*txtypes.GetTxResponse, error) {
 	if req == nil {
@@ -310,10 +379,11 @@ func RegisterTxService(
 	clientCtx client.Context,
 	simulateFn baseAppSimulateFn,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	opts ...TxServerOption,
 ) {
 	txtypes.RegisterServiceServer(
 		qrt,
-		NewTxServer(clientCtx, simulateFn, interfaceRegistry),
+		NewTxServer(clientCtx, simulateFn, interfaceRegistry, opts...),
 	)
 }
 
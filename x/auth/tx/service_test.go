@@ -0,0 +1,76 @@
+package tx_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+func TestSimulateBundle(t *testing.T) {
+	interfaceRegistry := testutil.CodecOptions{}.NewInterfaceRegistry()
+	simulate := func([]byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil }
+
+	// the simulated bundle fails on its second tx
+	var simulated [][]byte
+	simulateBundle := func(txs [][]byte) ([]sdk.SimulationResult, error) {
+		simulated = txs
+		switch len(txs) {
+		case 3:
+			return nil, sdkerrors.ErrInvalidRequest.Wrap("too many txs")
+		case 4:
+			return nil, sdkerrors.ErrOutOfGas.Wrap("out of gas")
+		}
+
+		return []sdk.SimulationResult{
+			{GasInfo: sdk.GasInfo{GasWanted: 10, GasUsed: 5}, Result: &sdk.Result{Log: "ok"}},
+			{GasInfo: sdk.GasInfo{GasWanted: 20, GasUsed: 7}, Err: sdkerrors.ErrInsufficientFunds.Wrap("not enough")},
+		}, nil
+	}
+
+	srv := tx.NewTxServer(client.Context{}, simulate, interfaceRegistry, tx.WithSimulateBundle(simulateBundle))
+	txs := [][]byte{[]byte("tx1"), []byte("tx2")}
+
+	res, err := srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs})
+	require.NoError(t, err)
+	require.Equal(t, txs, simulated)
+	require.Equal(t, &sdk.GasInfo{GasWanted: 30, GasUsed: 12}, res.GasInfo)
+	require.Len(t, res.Results, 2)
+
+	require.Equal(t, &sdk.GasInfo{GasWanted: 10, GasUsed: 5}, res.Results[0].GasInfo)
+	require.Equal(t, "ok", res.Results[0].Result.Log)
+	require.Zero(t, res.Results[0].Code)
+
+	require.Equal(t, &sdk.GasInfo{GasWanted: 20, GasUsed: 7}, res.Results[1].GasInfo)
+	require.Nil(t, res.Results[1].Result)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.Codespace(), res.Results[1].Codespace)
+	require.Equal(t, sdkerrors.ErrInsufficientFunds.ABCICode(), res.Results[1].Code)
+	require.Contains(t, res.Results[1].Error, "not enough")
+
+	_, err = srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: [][]byte{txs[0], nil}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// bundles exceeding the limits of the app are rejected
+	_, err = srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: append(txs, txs[0])})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: append(txs, txs...)})
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// the method is unimplemented unless enabled
+	srv = tx.NewTxServer(client.Context{}, simulate, interfaceRegistry)
+	_, err = srv.SimulateBundle(context.Background(), &txtypes.SimulateBundleRequest{TxsBytes: txs})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -174,6 +175,19 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty"`
+	// fee_market defines the parameters of the consensus-level base fee charged
+	// per unit of gas. The base fee is not enforced if unset.
+	FeeMarket *FeeMarketParams `protobuf:"bytes,6,opt,name=fee_market,json=feeMarket,proto3" json:"fee_market,omitempty"`
+	// unordered_tx_max_timeout_delta defines the maximum number of blocks ahead
+	// of the current block height the timeout height of an unordered tx may be
+	// set to, which bounds how long its hash is kept to prevent replays.
+	// Unordered txs are rejected if zero.
+	UnorderedTxMaxTimeoutDelta uint64 `protobuf:"varint,7,opt,name=unordered_tx_max_timeout_delta,json=unorderedTxMaxTimeoutDelta,proto3" json:"unordered_tx_max_timeout_delta,omitempty"`
+	// sig_verify_cost_webauthn defines the gas charged to verify the secp256r1
+	// signature of a WebAuthn assertion, including decoding the assertion and
+	// hashing its client and authenticator data. WebAuthn public keys are
+	// rejected if zero.
+	SigVerifyCostWebAuthn uint64 `protobuf:"varint,8,opt,name=sig_verify_cost_webauthn,json=sigVerifyCostWebauthn,proto3" json:"sig_verify_cost_webauthn,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -244,63 +258,172 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeMarket() *FeeMarketParams {
+	if m != nil {
+		return m.FeeMarket
+	}
+	return nil
+}
+
+func (m *Params) GetUnorderedTxMaxTimeoutDelta() uint64 {
+	if m != nil {
+		return m.UnorderedTxMaxTimeoutDelta
+	}
+	return 0
+}
+
+func (m *Params) GetSigVerifyCostWebAuthn() uint64 {
+	if m != nil {
+		return m.SigVerifyCostWebAuthn
+	}
+	return 0
+}
+
+// FeeMarketParams defines the parameters of the EIP-1559 style base fee, which
+// every tx must pay per unit of gas and which adjusts at the end of each block
+// based on the gas used by the block compared to the target block gas.
+type FeeMarketParams struct {
+	// denom defines the denomination the base fee is charged in.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_base_fee defines the lowest base fee the base fee can decrease to,
+	// which is also the initial base fee.
+	MinBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_base_fee"`
+	// target_block_gas defines the gas used by a block for which the base fee
+	// remains unchanged. The base fee increases if a block uses more gas and
+	// decreases if it uses less.
+	TargetBlockGas uint64 `protobuf:"varint,3,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty"`
+	// base_fee_change_denominator bounds the change of the base fee from one
+	// block to the next to 1/base_fee_change_denominator of the base fee.
+	BaseFeeChangeDenominator uint64 `protobuf:"varint,4,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+}
+
+func (m *FeeMarketParams) Reset()         { *m = FeeMarketParams{} }
+func (m *FeeMarketParams) String() string { return proto.CompactTextString(m) }
+func (*FeeMarketParams) ProtoMessage()    {}
+func (*FeeMarketParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{4}
+}
+func (m *FeeMarketParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeMarketParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeMarketParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeMarketParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeMarketParams.Merge(m, src)
+}
+func (m *FeeMarketParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeMarketParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeMarketParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeMarketParams proto.InternalMessageInfo
+
+func (m *FeeMarketParams) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeMarketParams) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *FeeMarketParams) GetBaseFeeChangeDenominator() uint64 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*ModuleCredential)(nil), "cosmos.auth.v1beta1.ModuleCredential")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*FeeMarketParams)(nil), "cosmos.auth.v1beta1.FeeMarketParams")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x4e, 0xeb, 0x46,
-	0x14, 0x8d, 0x93, 0x14, 0xca, 0x04, 0x68, 0x31, 0x29, 0x35, 0x51, 0x15, 0x9b, 0x48, 0x2d, 0x29,
-	0x2a, 0x76, 0x93, 0x8a, 0x4a, 0xcd, 0x8e, 0xa4, 0x55, 0x85, 0x28, 0x14, 0x39, 0x2a, 0x0b, 0x36,
-	0xd6, 0xd8, 0x19, 0xcc, 0x88, 0x8c, 0xc7, 0xf5, 0x8c, 0x51, 0xcc, 0xba, 0x0b, 0xd4, 0x55, 0xd5,
-	0x2f, 0xa0, 0xfd, 0x02, 0x16, 0x7c, 0x44, 0xd5, 0x15, 0xea, 0xa6, 0x6f, 0x15, 0x3d, 0x85, 0x05,
-	0xe8, 0xe9, 0x7d, 0xc4, 0x93, 0x67, 0x1c, 0x48, 0x78, 0xd9, 0x44, 0x9e, 0x73, 0xce, 0xbd, 0xf7,
-	0xdc, 0xe3, 0x89, 0x41, 0xd5, 0xa3, 0x8c, 0x50, 0x66, 0xc1, 0x98, 0x9f, 0x59, 0x17, 0x0d, 0x17,
-	0x71, 0xd8, 0x10, 0x07, 0x33, 0x8c, 0x28, 0xa7, 0xea, 0xaa, 0xe4, 0x4d, 0x01, 0x65, 0x7c, 0x65,
-	0x05, 0x12, 0x1c, 0x50, 0x4b, 0xfc, 0x4a, 0x5d, 0x65, 0x5d, 0xea, 0x1c, 0x71, 0xb2, 0xb2, 0x22,
-	0x49, 0x95, 0x7d, 0xea, 0x53, 0x89, 0xa7, 0x4f, 0xe3, 0x02, 0x9f, 0x52, 0xbf, 0x8f, 0x2c, 0x71,
-	0x72, 0xe3, 0x53, 0x0b, 0x06, 0x89, 0xa4, 0x6a, 0x7f, 0xe5, 0x41, 0xa9, 0x0d, 0x19, 0xda, 0xf5,
-	0x3c, 0x1a, 0x07, 0x5c, 0x6d, 0x82, 0x79, 0xd8, 0xeb, 0x45, 0x88, 0x31, 0x4d, 0x31, 0x94, 0xfa,
-	0x42, 0x5b, 0xfb, 0xef, 0x76, 0xbb, 0x9c, 0xcd, 0xd8, 0x95, 0x4c, 0x97, 0x47, 0x38, 0xf0, 0xed,
-	0xb1, 0x50, 0x3d, 0x06, 0xf3, 0x61, 0xec, 0x3a, 0xe7, 0x28, 0xd1, 0xf2, 0x86, 0x52, 0x2f, 0x35,
-	0xcb, 0xa6, 0x1c, 0x68, 0x8e, 0x07, 0x9a, 0xbb, 0x41, 0xd2, 0xde, 0x7c, 0x33, 0xd4, 0xcb, 0x61,
-	0xec, 0xf6, 0xb1, 0x97, 0x6a, 0xbf, 0xa2, 0x04, 0x73, 0x44, 0x42, 0x9e, 0xfc, 0xfd, 0x70, 0xb3,
-	0x05, 0x9e, 0x09, 0x7b, 0x2e, 0x8c, 0xdd, 0x7d, 0x94, 0xa8, 0x9f, 0x83, 0x65, 0x28, 0x6d, 0x39,
-	0x41, 0x4c, 0x5c, 0x14, 0x69, 0x05, 0x43, 0xa9, 0x17, 0xed, 0xa5, 0x0c, 0x3d, 0x14, 0xa0, 0x5a,
-	0x01, 0x1f, 0x32, 0xf4, 0x6b, 0x8c, 0x02, 0x0f, 0x69, 0x45, 0x21, 0x78, 0x3a, 0xb7, 0x3a, 0x57,
-	0xd7, 0x7a, 0xee, 0xf1, 0x5a, 0xcf, 0xfd, 0x7b, 0xbb, 0xfd, 0xd9, 0x8c, 0x78, 0xcd, 0x6c, 0xef,
-	0xbd, 0xdf, 0x1f, 0x6e, 0xb6, 0xd6, 0xa4, 0x60, 0x9b, 0xf5, 0xce, 0xad, 0x89, 0x4c, 0x6a, 0x6f,
-	0x15, 0xb0, 0x74, 0x40, 0x7b, 0x71, 0xff, 0x29, 0xa5, 0x3d, 0xb0, 0xe8, 0x42, 0x86, 0x9c, 0xcc,
-	0x88, 0x88, 0xaa, 0xd4, 0x34, 0xcc, 0x59, 0x13, 0x26, 0x3a, 0xb5, 0x8b, 0x77, 0x43, 0x5d, 0xb1,
-	0x4b, 0xee, 0x44, 0xe0, 0x2a, 0x28, 0x06, 0x90, 0x20, 0x91, 0xdc, 0x82, 0x2d, 0x9e, 0x55, 0x03,
-	0x94, 0x42, 0x14, 0x11, 0xcc, 0x18, 0xa6, 0x01, 0xd3, 0x0a, 0x46, 0xa1, 0xbe, 0x60, 0x4f, 0x42,
-	0xad, 0x93, 0x2b, 0xb9, 0x53, 0x6d, 0xd6, 0xc4, 0x29, 0xaf, 0x62, 0x33, 0x6d, 0x62, 0xb3, 0x29,
-	0xf6, 0xcf, 0x87, 0x9b, 0xad, 0x65, 0x22, 0x90, 0xf1, 0x32, 0xb5, 0xdf, 0x14, 0xf0, 0xb1, 0x14,
-	0x75, 0x22, 0xd4, 0x43, 0x01, 0xc7, 0xb0, 0xaf, 0xea, 0xa0, 0x94, 0xc9, 0x84, 0x5b, 0x71, 0x37,
-	0x6c, 0x20, 0xa1, 0xc3, 0xd4, 0xf3, 0x26, 0xf8, 0xa8, 0x87, 0x22, 0x7c, 0x01, 0x39, 0xa6, 0x41,
-	0xfa, 0x1a, 0x99, 0x96, 0x37, 0x0a, 0xf5, 0x45, 0x7b, 0xf9, 0x19, 0xde, 0x47, 0x09, 0x6b, 0x7d,
-	0x91, 0x1a, 0xda, 0x98, 0x30, 0xf4, 0x63, 0x44, 0xe3, 0x30, 0xf3, 0xf3, 0x3c, 0xb1, 0xf6, 0x7f,
-	0x1e, 0xcc, 0x1d, 0xc1, 0x08, 0x12, 0xa6, 0x9a, 0x60, 0x95, 0xc0, 0x81, 0x43, 0x10, 0xa1, 0x8e,
-	0x77, 0x06, 0x23, 0xe8, 0x71, 0x14, 0xc9, 0x0b, 0x5a, 0xb4, 0x57, 0x08, 0x1c, 0x1c, 0x20, 0x42,
-	0x3b, 0x4f, 0x84, 0x6a, 0x80, 0x45, 0x3e, 0x70, 0x18, 0xf6, 0x9d, 0x3e, 0x26, 0x98, 0x8b, 0x6c,
-	0x8b, 0x36, 0xe0, 0x83, 0x2e, 0xf6, 0x7f, 0x4a, 0x11, 0xf5, 0x6b, 0xf0, 0x89, 0x50, 0x5c, 0x22,
-	0xc7, 0xa3, 0x8c, 0x3b, 0x21, 0x8a, 0x1c, 0x37, 0xe1, 0x28, 0xbb, 0x61, 0x2b, 0xa9, 0xf4, 0x12,
-	0x75, 0x28, 0xe3, 0x47, 0x28, 0x6a, 0x27, 0x1c, 0xa9, 0x3f, 0x83, 0x4f, 0xd3, 0x86, 0x17, 0x28,
-	0xc2, 0xa7, 0x89, 0x2c, 0x42, 0xbd, 0xe6, 0xce, 0x4e, 0xe3, 0x3b, 0x79, 0xe9, 0xda, 0xda, 0x68,
-	0xa8, 0x97, 0xbb, 0xd8, 0x3f, 0x16, 0x8a, 0xb4, 0xf4, 0x87, 0xef, 0x05, 0x6f, 0x97, 0xd9, 0x14,
-	0x2a, 0xab, 0xd4, 0x5f, 0xc0, 0xfa, 0xcb, 0x86, 0x0c, 0x79, 0x61, 0x73, 0xe7, 0xdb, 0xf3, 0x86,
-	0xf6, 0x81, 0x68, 0x59, 0x19, 0x0d, 0xf5, 0xb5, 0xa9, 0x96, 0xdd, 0xb1, 0xc2, 0x5e, 0x63, 0x33,
-	0xf1, 0xd6, 0xc6, 0xe3, 0xb5, 0xae, 0xbc, 0x7c, 0xe7, 0x03, 0xf9, 0xcd, 0x91, 0x71, 0xb6, 0x3b,
-	0xff, 0x8c, 0xaa, 0xca, 0xdd, 0xa8, 0xaa, 0xbc, 0x1e, 0x55, 0x95, 0x3f, 0xee, 0xab, 0xb9, 0xbb,
-	0xfb, 0x6a, 0xee, 0xd5, 0x7d, 0x35, 0x77, 0xf2, 0xa5, 0x8f, 0xf9, 0x59, 0xec, 0x9a, 0x1e, 0x25,
-	0xd9, 0x77, 0xc5, 0x7a, 0xbf, 0x0b, 0x4f, 0x42, 0xc4, 0xdc, 0x39, 0xf1, 0xdf, 0xfe, 0xe6, 0x5d,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x84, 0x5b, 0x40, 0xd5, 0x04, 0x00, 0x00,
+	// 970 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x4f, 0x4f, 0x1b, 0x47,
+	0x1c, 0xf5, 0x82, 0x03, 0x61, 0x4c, 0x48, 0x98, 0x18, 0xba, 0x90, 0xca, 0xeb, 0x58, 0x6d, 0xe3,
+	0xa2, 0xb2, 0x2e, 0x54, 0x44, 0x2a, 0x52, 0x0f, 0xd8, 0x34, 0x51, 0x94, 0x90, 0x46, 0x4b, 0x9a,
+	0x56, 0xb9, 0xac, 0x66, 0x77, 0x7f, 0x2c, 0x23, 0x7b, 0x76, 0xdc, 0x9d, 0x59, 0xea, 0xcd, 0xb9,
+	0x87, 0xa8, 0xa7, 0xaa, 0x9f, 0x80, 0xf6, 0xd4, 0x23, 0x07, 0x3e, 0x44, 0xd4, 0x13, 0xca, 0xa9,
+	0xea, 0xc1, 0xaa, 0xcc, 0x81, 0xa8, 0xea, 0xa1, 0x1f, 0xa1, 0xda, 0x99, 0x35, 0x18, 0xea, 0x0b,
+	0xda, 0x79, 0xbf, 0xf7, 0xfb, 0xf7, 0x78, 0x33, 0x46, 0x15, 0x9f, 0x0b, 0xc6, 0x45, 0x83, 0x24,
+	0x72, 0xbf, 0x71, 0xb0, 0xe6, 0x81, 0x24, 0x6b, 0xea, 0x60, 0x77, 0x63, 0x2e, 0x39, 0xbe, 0xad,
+	0xe3, 0xb6, 0x82, 0xf2, 0xf8, 0xf2, 0x3c, 0x61, 0x34, 0xe2, 0x0d, 0xf5, 0x57, 0xf3, 0x96, 0x97,
+	0x34, 0xcf, 0x55, 0xa7, 0x46, 0x9e, 0xa4, 0x43, 0xe5, 0x90, 0x87, 0x5c, 0xe3, 0xd9, 0xd7, 0x30,
+	0x21, 0xe4, 0x3c, 0xec, 0x40, 0x43, 0x9d, 0xbc, 0x64, 0xaf, 0x41, 0xa2, 0x54, 0x87, 0x6a, 0xbf,
+	0x4c, 0xa0, 0x52, 0x93, 0x08, 0xd8, 0xf2, 0x7d, 0x9e, 0x44, 0x12, 0xaf, 0xa3, 0x69, 0x12, 0x04,
+	0x31, 0x08, 0x61, 0x1a, 0x55, 0xa3, 0x3e, 0xd3, 0x34, 0xdf, 0x1e, 0xaf, 0x96, 0xf3, 0x1e, 0x5b,
+	0x3a, 0xb2, 0x2b, 0x63, 0x1a, 0x85, 0xce, 0x90, 0x88, 0x5f, 0xa0, 0xe9, 0x6e, 0xe2, 0xb9, 0x6d,
+	0x48, 0xcd, 0x89, 0xaa, 0x51, 0x2f, 0xad, 0x97, 0x6d, 0xdd, 0xd0, 0x1e, 0x36, 0xb4, 0xb7, 0xa2,
+	0xb4, 0x79, 0xef, 0xef, 0xbe, 0x55, 0xee, 0x26, 0x5e, 0x87, 0xfa, 0x19, 0xf7, 0x13, 0xce, 0xa8,
+	0x04, 0xd6, 0x95, 0xe9, 0xaf, 0x67, 0x47, 0x2b, 0xe8, 0x22, 0xe0, 0x4c, 0x75, 0x13, 0xef, 0x31,
+	0xa4, 0xf8, 0x43, 0x34, 0x47, 0xf4, 0x58, 0x6e, 0x94, 0x30, 0x0f, 0x62, 0x73, 0xb2, 0x6a, 0xd4,
+	0x8b, 0xce, 0x8d, 0x1c, 0x7d, 0xaa, 0x40, 0xbc, 0x8c, 0xae, 0x0b, 0xf8, 0x2e, 0x81, 0xc8, 0x07,
+	0xb3, 0xa8, 0x08, 0xe7, 0xe7, 0xcd, 0xd6, 0xeb, 0x43, 0xab, 0xf0, 0xee, 0xd0, 0x2a, 0xfc, 0x7e,
+	0xbc, 0xfa, 0xfe, 0x18, 0x79, 0xed, 0x7c, 0xef, 0x47, 0x3f, 0x9e, 0x1d, 0xad, 0x2c, 0x6a, 0xc2,
+	0xaa, 0x08, 0xda, 0x8d, 0x11, 0x4d, 0x6a, 0xff, 0x18, 0xe8, 0xc6, 0x0e, 0x0f, 0x92, 0xce, 0xb9,
+	0x4a, 0x8f, 0xd0, 0xac, 0x47, 0x04, 0xb8, 0xf9, 0x20, 0x4a, 0xaa, 0xd2, 0x7a, 0xd5, 0x1e, 0xd7,
+	0x61, 0xa4, 0x52, 0xb3, 0x78, 0xd2, 0xb7, 0x0c, 0xa7, 0xe4, 0x8d, 0x08, 0x8e, 0x51, 0x31, 0x22,
+	0x0c, 0x94, 0x72, 0x33, 0x8e, 0xfa, 0xc6, 0x55, 0x54, 0xea, 0x42, 0xcc, 0xa8, 0x10, 0x94, 0x47,
+	0xc2, 0x9c, 0xac, 0x4e, 0xd6, 0x67, 0x9c, 0x51, 0x68, 0xf3, 0xe5, 0x6b, 0xbd, 0x53, 0x6d, 0x5c,
+	0xc7, 0x4b, 0xb3, 0xaa, 0xcd, 0xcc, 0x91, 0xcd, 0x2e, 0x45, 0x7f, 0x3e, 0x3b, 0x5a, 0x99, 0x63,
+	0x0a, 0x19, 0x2e, 0x53, 0xfb, 0xc1, 0x40, 0xb7, 0x34, 0xa9, 0x15, 0x43, 0x00, 0x91, 0xa4, 0xa4,
+	0x83, 0x2d, 0x54, 0xca, 0x69, 0x6a, 0x5a, 0xe5, 0x0d, 0x07, 0x69, 0xe8, 0x69, 0x36, 0xf3, 0x3d,
+	0x74, 0x33, 0x80, 0x98, 0x1e, 0x10, 0x49, 0x79, 0x94, 0xfd, 0x1b, 0x85, 0x39, 0x51, 0x9d, 0xac,
+	0xcf, 0x3a, 0x73, 0x17, 0xf0, 0x63, 0x48, 0xc5, 0xe6, 0x47, 0xd9, 0x40, 0x77, 0x47, 0x06, 0x7a,
+	0x18, 0xf3, 0xa4, 0x9b, 0xcf, 0x73, 0xd1, 0xb1, 0x76, 0x5c, 0x44, 0x53, 0xcf, 0x48, 0x4c, 0x98,
+	0xc0, 0x36, 0xba, 0xcd, 0x48, 0xcf, 0x65, 0xc0, 0xb8, 0xeb, 0xef, 0x93, 0x98, 0xf8, 0x12, 0x62,
+	0x6d, 0xd0, 0xa2, 0x33, 0xcf, 0x48, 0x6f, 0x07, 0x18, 0x6f, 0x9d, 0x07, 0x70, 0x15, 0xcd, 0xca,
+	0x9e, 0x2b, 0x68, 0xe8, 0x76, 0x28, 0xa3, 0x52, 0x69, 0x5b, 0x74, 0x90, 0xec, 0xed, 0xd2, 0xf0,
+	0x49, 0x86, 0xe0, 0x4f, 0xd1, 0x82, 0x62, 0xbc, 0x02, 0xd7, 0xe7, 0x42, 0xba, 0x5d, 0x88, 0x5d,
+	0x2f, 0x95, 0x90, 0x3b, 0x6c, 0x3e, 0xa3, 0xbe, 0x82, 0x16, 0x17, 0xf2, 0x19, 0xc4, 0xcd, 0x54,
+	0x02, 0xfe, 0x0a, 0xbd, 0x97, 0x15, 0x3c, 0x80, 0x98, 0xee, 0xa5, 0x3a, 0x09, 0x82, 0xf5, 0x8d,
+	0x8d, 0xb5, 0xcf, 0xb5, 0xe9, 0x9a, 0xe6, 0xa0, 0x6f, 0x95, 0x77, 0x69, 0xf8, 0x42, 0x31, 0xb2,
+	0xd4, 0x2f, 0xb7, 0x55, 0xdc, 0x29, 0x8b, 0x4b, 0xa8, 0xce, 0xc2, 0x5f, 0xa3, 0xa5, 0xab, 0x05,
+	0x05, 0xf8, 0xdd, 0xf5, 0x8d, 0xfb, 0xed, 0x35, 0xf3, 0x9a, 0x2a, 0xb9, 0x3c, 0xe8, 0x5b, 0x8b,
+	0x97, 0x4a, 0xee, 0x0e, 0x19, 0xce, 0xa2, 0x18, 0x8b, 0xe3, 0x16, 0x42, 0x7b, 0x00, 0x2e, 0x23,
+	0x71, 0x1b, 0xa4, 0x39, 0xa5, 0x8c, 0xf9, 0xc1, 0x58, 0x63, 0x3e, 0x00, 0xd8, 0x51, 0x2c, 0xad,
+	0xb2, 0x33, 0xb3, 0x37, 0x04, 0x70, 0x13, 0x55, 0x92, 0x88, 0xc7, 0x01, 0xc4, 0x10, 0xb8, 0xb2,
+	0xe7, 0x66, 0xea, 0x4b, 0xca, 0x80, 0x27, 0xd2, 0x0d, 0xa0, 0x23, 0x89, 0x39, 0xad, 0x74, 0x5a,
+	0x3e, 0x67, 0x3d, 0xef, 0xed, 0x90, 0xde, 0x73, 0x4d, 0xd9, 0xce, 0x18, 0xd8, 0x41, 0xe6, 0xd5,
+	0xfd, 0xbe, 0x07, 0x2f, 0x9b, 0x20, 0x32, 0xaf, 0xab, 0xf5, 0x96, 0x06, 0x7d, 0x6b, 0xe1, 0xd2,
+	0x7a, 0xdf, 0x80, 0xb7, 0x95, 0x11, 0x9c, 0x05, 0x71, 0x05, 0x56, 0x79, 0x9b, 0x77, 0xdf, 0x1d,
+	0x5a, 0xc6, 0x55, 0x43, 0xf7, 0xf4, 0x83, 0xaa, 0xb7, 0xa8, 0xfd, 0x6b, 0xa0, 0x9b, 0x57, 0x36,
+	0xc3, 0x65, 0x74, 0x2d, 0x80, 0x88, 0xb3, 0xdc, 0xb6, 0xfa, 0x80, 0xbf, 0x45, 0xb3, 0x8c, 0x46,
+	0xae, 0xba, 0xc8, 0x7b, 0x90, 0xdf, 0xc0, 0xe6, 0xfd, 0x37, 0x7d, 0xab, 0xf0, 0x67, 0xdf, 0xba,
+	0xa3, 0x3b, 0x88, 0xa0, 0x6d, 0x53, 0xde, 0x60, 0x44, 0xee, 0xdb, 0x4f, 0x20, 0x24, 0x7e, 0xba,
+	0x0d, 0xfe, 0xdb, 0xe3, 0x55, 0x94, 0x2b, 0xba, 0x0d, 0xfe, 0x6f, 0x67, 0x47, 0x2b, 0x86, 0x83,
+	0x18, 0x8d, 0xb2, 0xab, 0xfe, 0x00, 0x00, 0xd7, 0xd1, 0x2d, 0x49, 0xe2, 0x10, 0xa4, 0xeb, 0x75,
+	0xb8, 0xdf, 0x76, 0x43, 0x22, 0x72, 0x63, 0xcd, 0x69, 0xbc, 0x99, 0xc1, 0x0f, 0x89, 0xc0, 0x5f,
+	0xa0, 0x3b, 0xc3, 0xfe, 0x99, 0xb3, 0xa3, 0x10, 0x5c, 0x35, 0x1c, 0x8d, 0x88, 0xe4, 0x71, 0xfe,
+	0x9c, 0x99, 0x9e, 0xae, 0xdb, 0x52, 0x84, 0xed, 0x8b, 0xf8, 0x66, 0x31, 0xd3, 0xa3, 0xd9, 0x7a,
+	0x33, 0xa8, 0x18, 0x27, 0x83, 0x8a, 0xf1, 0xd7, 0xa0, 0x62, 0xfc, 0x74, 0x5a, 0x29, 0x9c, 0x9c,
+	0x56, 0x0a, 0x7f, 0x9c, 0x56, 0x0a, 0x2f, 0x3f, 0x0e, 0xa9, 0xdc, 0x4f, 0x3c, 0xdb, 0xe7, 0x2c,
+	0xff, 0x9d, 0x68, 0xfc, 0x5f, 0x38, 0x99, 0x76, 0x41, 0x78, 0x53, 0xea, 0xad, 0xfe, 0xec, 0xbf,
+	0x01, 0x00, 0xc2, 0x04, 0x4b, 0xd0, 0xa5, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -337,6 +460,48 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if !this.FeeMarket.Equal(that1.FeeMarket) {
+		return false
+	}
+	if this.UnorderedTxMaxTimeoutDelta != that1.UnorderedTxMaxTimeoutDelta {
+		return false
+	}
+	if this.SigVerifyCostWebAuthn != that1.SigVerifyCostWebAuthn {
+		return false
+	}
+	return true
+}
+func (this *FeeMarketParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeMarketParams)
+	if !ok {
+		that2, ok := that.(FeeMarketParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.MinBaseFee.Equal(that1.MinBaseFee) {
+		return false
+	}
+	if this.TargetBlockGas != that1.TargetBlockGas {
+		return false
+	}
+	if this.BaseFeeChangeDenominator != that1.BaseFeeChangeDenominator {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -501,6 +666,28 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SigVerifyCostWebAuthn != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostWebAuthn))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UnorderedTxMaxTimeoutDelta != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.UnorderedTxMaxTimeoutDelta))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FeeMarket != nil {
+		{
+			size, err := m.FeeMarket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuth(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -529,6 +716,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeMarketParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMarketParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMarketParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -626,6 +863,37 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if m.FeeMarket != nil {
+		l = m.FeeMarket.Size()
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.UnorderedTxMaxTimeoutDelta != 0 {
+		n += 1 + sovAuth(uint64(m.UnorderedTxMaxTimeoutDelta))
+	}
+	if m.SigVerifyCostWebAuthn != 0 {
+		n += 1 + sovAuth(uint64(m.SigVerifyCostWebAuthn))
+	}
+	return n
+}
+
+func (m *FeeMarketParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovAuth(uint64(m.TargetBlockGas))
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovAuth(uint64(m.BaseFeeChangeDenominator))
+	}
 	return n
 }
 
@@ -1179,6 +1447,234 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeMarket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeMarket == nil {
+				m.FeeMarket = &FeeMarketParams{}
+			}
+			if err := m.FeeMarket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedTxMaxTimeoutDelta", wireType)
+			}
+			m.UnorderedTxMaxTimeoutDelta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnorderedTxMaxTimeoutDelta |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigVerifyCostWebAuthn", wireType)
+			}
+			m.SigVerifyCostWebAuthn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SigVerifyCostWebAuthn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeMarketParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeMarketParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeMarketParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
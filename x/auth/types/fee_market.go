@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultBaseFeeChangeDenominator defines the default bound of the change of
+// the base fee from one block to the next, as in EIP-1559.
+const DefaultBaseFeeChangeDenominator uint64 = 8
+
+// NewFeeMarketParams creates new fee market parameters charging a base fee of
+// at least minBaseFee in the given denom, targeting targetBlockGas per block.
+func NewFeeMarketParams(denom string, minBaseFee math.LegacyDec, targetBlockGas uint64) *FeeMarketParams {
+	return &FeeMarketParams{
+		Denom:                    denom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: DefaultBaseFeeChangeDenominator,
+	}
+}
+
+// Validate checks that the fee market parameters have valid values.
+func (p FeeMarketParams) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return fmt.Errorf("invalid base fee denom: %w", err)
+	}
+
+	// a zero base fee could never increase again
+	if p.MinBaseFee.IsNil() || !p.MinBaseFee.IsPositive() {
+		return fmt.Errorf("min base fee must be positive: %s", p.MinBaseFee)
+	}
+
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("invalid target block gas: %d", p.TargetBlockGas)
+	}
+
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("invalid base fee change denominator: %d", p.BaseFeeChangeDenominator)
+	}
+
+	return nil
+}
+
+// NextBaseFee returns the base fee of the next block, given the base fee and
+// the gas used by the current block. As in EIP-1559, the base fee changes
+// proportionally to the difference between the gas used and the target block
+// gas, by at most 1/BaseFeeChangeDenominator of the base fee once the block
+// uses twice the target block gas, and never decreases below the min base fee.
+func (p FeeMarketParams) NextBaseFee(baseFee math.LegacyDec, blockGasUsed uint64) math.LegacyDec {
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	delta := math.NewIntFromUint64(blockGasUsed).Sub(target)
+	if delta.GT(target) {
+		delta = target
+	}
+
+	change := baseFee.MulInt(delta).QuoInt(target).QuoInt(math.NewIntFromUint64(p.BaseFeeChangeDenominator))
+
+	return math.LegacyMaxDec(baseFee.Add(change), p.MinBaseFee)
+}
@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestFeeMarketParams_Validate(t *testing.T) {
+	valid := types.NewFeeMarketParams("stake", math.LegacyNewDecWithPrec(1, 2), 1000)
+	require.NoError(t, valid.Validate())
+
+	params := types.DefaultParams()
+	params.FeeMarket = valid
+	require.NoError(t, params.Validate())
+
+	tests := []struct {
+		name   string
+		modify func(p *types.FeeMarketParams)
+		errMsg string
+	}{
+		{"invalid denom", func(p *types.FeeMarketParams) { p.Denom = "" }, "invalid base fee denom"},
+		{"nil min base fee", func(p *types.FeeMarketParams) { p.MinBaseFee = math.LegacyDec{} }, "min base fee must be positive"},
+		{"zero min base fee", func(p *types.FeeMarketParams) { p.MinBaseFee = math.LegacyZeroDec() }, "min base fee must be positive"},
+		{"zero target block gas", func(p *types.FeeMarketParams) { p.TargetBlockGas = 0 }, "invalid target block gas"},
+		{"zero change denominator", func(p *types.FeeMarketParams) { p.BaseFeeChangeDenominator = 0 }, "invalid base fee change denominator"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := *valid
+			tt.modify(&p)
+			require.ErrorContains(t, p.Validate(), tt.errMsg)
+
+			params.FeeMarket = &p
+			require.ErrorContains(t, params.Validate(), tt.errMsg)
+		})
+	}
+}
+
+func TestFeeMarketParams_NextBaseFee(t *testing.T) {
+	params := types.NewFeeMarketParams("stake", math.LegacyNewDec(10), 1000)
+	baseFee := math.LegacyNewDec(80)
+
+	tests := []struct {
+		name     string
+		baseFee  math.LegacyDec
+		gasUsed  uint64
+		expected math.LegacyDec
+	}{
+		{"target block gas", baseFee, 1000, baseFee},
+		{"full block", baseFee, 2000, math.LegacyNewDec(90)},
+		{"more than twice the target", baseFee, 10000, math.LegacyNewDec(90)},
+		{"half block", baseFee, 1500, math.LegacyNewDec(85)},
+		{"empty block", baseFee, 0, math.LegacyNewDec(70)},
+		{"min base fee", math.LegacyNewDec(11), 0, math.LegacyNewDec(10)},
+		{"fractional base fee", math.LegacyNewDecWithPrec(10125, 3), 1100, math.LegacyMustNewDecFromStr("10.2515625")},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected.String(), params.NextBaseFee(tt.baseFee, tt.gasUsed).String())
+		})
+	}
+}
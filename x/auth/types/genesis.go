@@ -8,6 +8,8 @@ import (
 
 	proto "github.com/cosmos/gogoproto/proto"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +30,7 @@ func NewGenesisState(params Params, accounts GenesisAccounts) *GenesisState {
 	return &GenesisState{
 		Params:   params,
 		Accounts: genAccounts,
+		BaseFee:  math.LegacyZeroDec(),
 	}
 }
 
@@ -67,6 +70,10 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if !data.BaseFee.IsNil() && data.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee cannot be negative: %s", data.BaseFee)
+	}
+
 	genAccs, err := UnpackAccounts(data.Accounts)
 	if err != nil {
 		return err
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -31,6 +33,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// base_fee defines the current base fee per unit of gas, if the fee market
+	// is enabled in the params.
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,24 +92,29 @@ func init() {
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x42, 0xe4, 0x05,
-	0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x81, 0x91, 0x8b, 0xc7, 0x1d,
-	0xe2, 0x94, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x3b, 0x2e, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0xdc,
-	0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x69, 0x3d, 0x2c, 0x4e, 0xd3, 0x0b, 0x00, 0x2b,
-	0x71, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0x5d, 0x42,
-	0x06, 0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a,
-	0xdc, 0x46, 0x22, 0x7a, 0x10, 0x7f, 0xe8, 0xc1, 0xfc, 0xa1, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57,
-	0xe5, 0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e,
-	0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99,
-	0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xaf, 0x41, 0x28, 0xdd, 0xe2, 0x94,
-	0x6c, 0xfd, 0x0a, 0x88, 0x3f, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x70, 0x8d, 0xea, 0x6c, 0x01, 0x00, 0x00,
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0x3a, 0x41,
+	0x10, 0xc7, 0x6f, 0x7f, 0x24, 0xfc, 0xe0, 0xb0, 0xf1, 0xa4, 0xe0, 0x4f, 0x72, 0xa0, 0x15, 0x9a,
+	0xb0, 0x2b, 0xd8, 0x9b, 0x88, 0x44, 0x1b, 0x0a, 0x83, 0x9d, 0x0d, 0xd9, 0x5b, 0x86, 0xe5, 0x82,
+	0x77, 0x4b, 0xd8, 0x3d, 0xe3, 0xbd, 0x85, 0x8f, 0x61, 0x69, 0xe1, 0x43, 0x50, 0x19, 0x62, 0x65,
+	0x2c, 0x88, 0x81, 0xc2, 0xd7, 0x30, 0xb7, 0xbb, 0x5a, 0xd1, 0xec, 0xce, 0xce, 0x7e, 0xe6, 0x3b,
+	0xdf, 0x19, 0xf7, 0x90, 0x09, 0x19, 0x09, 0x49, 0x68, 0xa2, 0xa6, 0xe4, 0xa1, 0x13, 0x80, 0xa2,
+	0x1d, 0xc2, 0x21, 0x06, 0x19, 0x4a, 0x3c, 0x5f, 0x08, 0x25, 0xbc, 0x03, 0x83, 0xe0, 0x0c, 0xc1,
+	0x16, 0xa9, 0x55, 0xb9, 0x10, 0xfc, 0x1e, 0x88, 0x46, 0x82, 0x64, 0x42, 0x68, 0x9c, 0x1a, 0xbe,
+	0x56, 0xe6, 0x82, 0x0b, 0x1d, 0x92, 0x2c, 0xb2, 0x59, 0x7f, 0x57, 0x23, 0x2d, 0x69, 0xfe, 0xf7,
+	0x69, 0x14, 0xc6, 0x82, 0xe8, 0xd3, 0xa6, 0xaa, 0xa6, 0x64, 0x64, 0xb4, 0xac, 0x0b, 0xfd, 0x38,
+	0x7a, 0x43, 0xee, 0xde, 0xb5, 0x71, 0x79, 0xab, 0xa8, 0x02, 0xef, 0xdc, 0xcd, 0xcf, 0xe9, 0x82,
+	0x46, 0xb2, 0x82, 0x9a, 0xa8, 0x55, 0xea, 0xd6, 0xf1, 0x0e, 0xd7, 0xf8, 0x46, 0x23, 0xbd, 0xe2,
+	0x72, 0xdd, 0x70, 0x9e, 0xbf, 0x5f, 0x4e, 0xd0, 0xd0, 0x56, 0x79, 0xa7, 0x6e, 0x81, 0x32, 0x26,
+	0x92, 0x58, 0xc9, 0xca, 0xbf, 0x66, 0xae, 0x55, 0xea, 0x96, 0xb1, 0x19, 0x11, 0xff, 0x8e, 0x88,
+	0x2f, 0xe2, 0x74, 0xf8, 0x47, 0x79, 0x03, 0xb7, 0x10, 0x50, 0x09, 0xa3, 0x09, 0x40, 0x25, 0xd7,
+	0x44, 0xad, 0x62, 0xaf, 0x93, 0xc9, 0x7e, 0xae, 0x1b, 0x75, 0xd3, 0x5a, 0x8e, 0x67, 0x38, 0x14,
+	0x24, 0xa2, 0x6a, 0x8a, 0x07, 0xc0, 0x29, 0x4b, 0xfb, 0xc0, 0xde, 0x5f, 0xdb, 0xae, 0x75, 0xd6,
+	0x07, 0x36, 0xfc, 0x9f, 0x49, 0x5c, 0x01, 0xf4, 0x2e, 0x97, 0x1b, 0x1f, 0xad, 0x36, 0x3e, 0xfa,
+	0xda, 0xf8, 0xe8, 0x69, 0xeb, 0x3b, 0xab, 0xad, 0xef, 0x7c, 0x6c, 0x7d, 0xe7, 0xee, 0x98, 0x87,
+	0x6a, 0x9a, 0x04, 0x98, 0x89, 0xc8, 0xee, 0xc0, 0x5e, 0x6d, 0x39, 0x9e, 0x91, 0x47, 0xb3, 0x50,
+	0x95, 0xce, 0x41, 0x06, 0x79, 0x6d, 0xf5, 0xec, 0x67, 0x00, 0x16, 0xdf, 0x33, 0x97, 0xd5, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +137,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +199,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -284,6 +306,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
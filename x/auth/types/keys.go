@@ -28,4 +28,10 @@ var (
 
 	// AccountNumberStoreKeyPrefix prefix for account-by-id store
 	AccountNumberStoreKeyPrefix = collections.NewPrefix("accountNumber")
+
+	// BaseFeeKey is the key of the current base fee of the fee market
+	BaseFeeKey = collections.NewPrefix(3)
+
+	// UnorderedTxsKeyPrefix prefix for the unordered-txs-by-timeout-height store
+	UnorderedTxsKeyPrefix = collections.NewPrefix(4)
 )
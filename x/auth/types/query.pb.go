@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -962,6 +964,90 @@ func (m *QueryAccountInfoResponse) GetInfo() *BaseAccount {
 	return nil
 }
 
+// QueryBaseFeeRequest is the request type for the Query/BaseFee RPC method.
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{20}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response type for the Query/BaseFee RPC method.
+type QueryBaseFeeResponse struct {
+	// base_fee defines the current base fee per unit of gas, which is empty if
+	// the fee market is disabled.
+	BaseFee github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=base_fee,json=baseFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"base_fee"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c451370b3929a27c, []int{21}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountsRequest)(nil), "cosmos.auth.v1beta1.QueryAccountsRequest")
 	proto.RegisterType((*QueryAccountsResponse)(nil), "cosmos.auth.v1beta1.QueryAccountsResponse")
@@ -983,79 +1069,87 @@ func init() {
 	proto.RegisterType((*QueryAccountAddressByIDResponse)(nil), "cosmos.auth.v1beta1.QueryAccountAddressByIDResponse")
 	proto.RegisterType((*QueryAccountInfoRequest)(nil), "cosmos.auth.v1beta1.QueryAccountInfoRequest")
 	proto.RegisterType((*QueryAccountInfoResponse)(nil), "cosmos.auth.v1beta1.QueryAccountInfoResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "cosmos.auth.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "cosmos.auth.v1beta1.QueryBaseFeeResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/query.proto", fileDescriptor_c451370b3929a27c) }
 
 var fileDescriptor_c451370b3929a27c = []byte{
-	// 1065 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe3, 0x6e, 0x69, 0xbb, 0xaf, 0xd9, 0x22, 0x4d, 0xb3, 0x22, 0x38, 0x6d, 0x12, 0xb9,
-	0xd0, 0xa6, 0x65, 0x63, 0xd3, 0x34, 0x2b, 0xf1, 0xe3, 0x54, 0xef, 0x02, 0xea, 0x61, 0x51, 0x70,
-	0x57, 0x08, 0x71, 0x20, 0x72, 0x62, 0x27, 0xb5, 0xd8, 0x78, 0xb2, 0xb1, 0x03, 0x1b, 0xaa, 0x5c,
-	0x90, 0x90, 0x7a, 0x41, 0x42, 0x82, 0x3f, 0x60, 0x0f, 0x88, 0xf3, 0x22, 0x95, 0x1b, 0x7f, 0xc0,
-	0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x42, 0xa8, 0x45, 0x82, 0x1b, 0xff, 0x02, 0xca, 0xcc, 0xb3, 0x63,
-	0xb7, 0x93, 0xc4, 0x85, 0x53, 0x9d, 0x99, 0xf7, 0xbe, 0xef, 0x33, 0x6f, 0x9e, 0xfd, 0x2d, 0x14,
-	0x9a, 0xd4, 0xeb, 0x50, 0x4f, 0x33, 0xfb, 0xfe, 0x91, 0xf6, 0xd9, 0x6e, 0xc3, 0xf6, 0xcd, 0x5d,
-	0xed, 0x61, 0xdf, 0xee, 0x0d, 0xd4, 0x6e, 0x8f, 0xfa, 0x94, 0xac, 0xf2, 0x00, 0x75, 0x14, 0xa0,
-	0x62, 0x80, 0xbc, 0x83, 0x59, 0x0d, 0xd3, 0xb3, 0x79, 0x74, 0x98, 0xdb, 0x35, 0xdb, 0x8e, 0x6b,
-	0xfa, 0x0e, 0x75, 0xb9, 0x80, 0x9c, 0x69, 0xd3, 0x36, 0x65, 0x8f, 0xda, 0xe8, 0x09, 0x57, 0x5f,
-	0x6e, 0x53, 0xda, 0x7e, 0x60, 0x6b, 0xec, 0x57, 0xa3, 0xdf, 0xd2, 0x4c, 0x17, 0x2b, 0xca, 0x6b,
-	0xb8, 0x65, 0x76, 0x1d, 0xcd, 0x74, 0x5d, 0xea, 0x33, 0x35, 0x0f, 0x77, 0xf3, 0x22, 0x60, 0x06,
-	0x87, 0xc2, 0x7c, 0xbf, 0xce, 0x2b, 0x22, 0x3c, 0xdf, 0xca, 0x61, 0x6a, 0x00, 0x1c, 0x3d, 0xa7,
-	0xf2, 0x09, 0x64, 0x3e, 0x18, 0xfd, 0xdc, 0x6f, 0x36, 0x69, 0xdf, 0xf5, 0x3d, 0xc3, 0x7e, 0xd8,
-	0xb7, 0x3d, 0x9f, 0xbc, 0x0b, 0x30, 0x3e, 0x52, 0x56, 0x2a, 0x4a, 0xa5, 0xe5, 0xca, 0xa6, 0x8a,
-	0xba, 0xa3, 0xf3, 0xab, 0x5c, 0x05, 0x51, 0xd4, 0x9a, 0xd9, 0xb6, 0x31, 0xd7, 0x88, 0x64, 0x2a,
-	0xa7, 0x12, 0xdc, 0xbc, 0x50, 0xc0, 0xeb, 0x52, 0xd7, 0xb3, 0x89, 0x01, 0x4b, 0x26, 0xae, 0x65,
-	0xa5, 0xe2, 0xb5, 0xd2, 0x72, 0x25, 0xa3, 0xf2, 0x16, 0xa8, 0x41, 0x77, 0xd4, 0x7d, 0x77, 0xa0,
-	0x17, 0x9f, 0x9d, 0x96, 0xd7, 0x04, 0xb7, 0xa1, 0xa2, 0xe2, 0x81, 0x11, 0xea, 0x90, 0xf7, 0x62,
-	0xd4, 0x73, 0x8c, 0x7a, 0x6b, 0x26, 0x35, 0x07, 0x8a, 0x61, 0x1f, 0xc2, 0x6a, 0x94, 0x3a, 0xe8,
-	0x4a, 0x05, 0x16, 0x4d, 0xcb, 0xea, 0xd9, 0x9e, 0xc7, 0x5a, 0x72, 0x5d, 0xcf, 0xfe, 0x72, 0x5a,
-	0xce, 0xa0, 0xfe, 0x3e, 0xdf, 0x39, 0xf4, 0x7b, 0x8e, 0xdb, 0x36, 0x82, 0xc0, 0xb7, 0x96, 0x4e,
-	0x1e, 0x17, 0x52, 0x7f, 0x3f, 0x2e, 0xa4, 0x94, 0xa3, 0x78, 0xaf, 0xc3, 0x4e, 0xd4, 0x60, 0x11,
-	0x4f, 0x80, 0x8d, 0xfe, 0xaf, 0x8d, 0x08, 0x64, 0x94, 0x0c, 0x10, 0x56, 0xa9, 0x66, 0xf6, 0xcc,
-	0x4e, 0x70, 0xa7, 0x4a, 0x0d, 0x0f, 0x15, 0xac, 0x62, 0xf9, 0x37, 0x61, 0xa1, 0xcb, 0x56, 0xb0,
-	0x7a, 0x4e, 0x15, 0x15, 0xe1, 0x49, 0xfa, 0xfc, 0xd3, 0xdf, 0x0b, 0x29, 0x03, 0x13, 0x94, 0x35,
-	0x90, 0x99, 0xe2, 0x3d, 0x6a, 0xf5, 0x1f, 0xd8, 0x17, 0x66, 0x48, 0xf9, 0x1c, 0x72, 0xc2, 0x5d,
-	0xac, 0xfb, 0x51, 0xc2, 0x01, 0xd8, 0x7c, 0x76, 0x5a, 0x56, 0x44, 0x48, 0x31, 0xdd, 0xc8, 0x18,
-	0x28, 0xb7, 0xa1, 0x70, 0xb9, 0xb0, 0x3e, 0x78, 0xdf, 0xec, 0x04, 0x33, 0x4a, 0x08, 0xcc, 0xbb,
-	0x66, 0xc7, 0xe6, 0xd7, 0x68, 0xb0, 0x67, 0xe5, 0x0b, 0x28, 0x4e, 0x4e, 0x43, 0xe8, 0x0f, 0x93,
-	0xdd, 0x55, 0x52, 0xe6, 0xf0, 0xc6, 0x6e, 0xc2, 0xaa, 0x6e, 0x37, 0x8f, 0xf6, 0x2a, 0xb5, 0x9e,
-	0xdd, 0x72, 0x1e, 0x05, 0x2d, 0x7c, 0x1b, 0x32, 0xf1, 0x65, 0xc4, 0xd8, 0x80, 0x1b, 0x0d, 0xb6,
-	0x5e, 0xef, 0xb2, 0x0d, 0x3c, 0x47, 0xba, 0x11, 0x09, 0x56, 0x74, 0xc8, 0xe1, 0x4c, 0xea, 0x03,
-	0xdf, 0xf6, 0xee, 0x53, 0x1c, 0x4d, 0x6c, 0xc1, 0x06, 0xdc, 0xc0, 0x19, 0xad, 0x37, 0x46, 0xfb,
-	0x4c, 0x23, 0x6d, 0xa4, 0xcd, 0x48, 0x8e, 0xf2, 0x0e, 0xac, 0x89, 0x35, 0x10, 0xe4, 0x55, 0x58,
-	0x09, 0x44, 0x3c, 0xb6, 0x83, 0x24, 0x81, 0x34, 0x0f, 0x57, 0xee, 0x86, 0x28, 0x7c, 0xe1, 0x3e,
-	0x65, 0x72, 0x01, 0x4a, 0x42, 0x95, 0x3b, 0x21, 0xcc, 0x05, 0x95, 0x71, 0x57, 0x66, 0x9f, 0xe8,
-	0x10, 0xf2, 0xd1, 0xb7, 0x30, 0x3c, 0xdd, 0xc1, 0xdd, 0xf1, 0x6c, 0xcc, 0x39, 0x16, 0xcb, 0xbd,
-	0xa6, 0xcf, 0x65, 0x25, 0x63, 0xce, 0xb1, 0xc8, 0x3a, 0x00, 0x5e, 0x55, 0xdd, 0xb1, 0xd8, 0x97,
-	0x65, 0xde, 0xb8, 0x8e, 0x2b, 0x07, 0x96, 0x62, 0xe1, 0xc4, 0x89, 0x44, 0x11, 0x6e, 0x1f, 0x5e,
-	0x0c, 0x14, 0x92, 0x7e, 0x43, 0x56, 0xcc, 0x98, 0x9c, 0x72, 0x0f, 0x5e, 0x8a, 0x56, 0x39, 0x70,
-	0x5b, 0xf4, 0x7f, 0x7c, 0x99, 0x94, 0x1a, 0x64, 0x2f, 0xcb, 0x21, 0x6d, 0x15, 0xe6, 0x1d, 0xb7,
-	0x45, 0x71, 0xc8, 0x8b, 0xc2, 0x4f, 0x82, 0x6e, 0x7a, 0xc1, 0x24, 0x1b, 0x2c, 0xba, 0xf2, 0x4f,
-	0x1a, 0x5e, 0x60, 0x92, 0xe4, 0x6b, 0x09, 0x96, 0x82, 0x37, 0x9e, 0x6c, 0x0b, 0xd3, 0x45, 0xbe,
-	0x23, 0xef, 0x24, 0x09, 0xe5, 0x8c, 0xca, 0xce, 0xc9, 0x5f, 0x4f, 0x76, 0xa4, 0x2f, 0x7f, 0xfd,
-	0xf3, 0xdb, 0xb9, 0x02, 0x59, 0xd7, 0x84, 0x0e, 0x19, 0x20, 0x7c, 0x27, 0xc1, 0x22, 0x0a, 0x90,
-	0xd2, 0xcc, 0x1a, 0x01, 0xcd, 0x76, 0x82, 0x48, 0x84, 0xa9, 0x8e, 0x61, 0xb6, 0xc9, 0xd6, 0x54,
-	0x18, 0xed, 0x18, 0x6f, 0x60, 0x48, 0x7e, 0x92, 0x80, 0x5c, 0x9e, 0x19, 0xb2, 0x37, 0xb3, 0xee,
-	0xe5, 0xb1, 0x95, 0xab, 0x57, 0x4b, 0xba, 0x02, 0x77, 0xf8, 0x4e, 0xd5, 0x1d, 0x4b, 0x3b, 0x76,
-	0xac, 0x21, 0xf9, 0x4a, 0x82, 0x05, 0xee, 0x08, 0x64, 0x6b, 0x72, 0xd9, 0x98, 0xfd, 0xc8, 0xa5,
-	0xd9, 0x81, 0xc8, 0x54, 0x1a, 0x33, 0xad, 0x93, 0x9c, 0x90, 0x89, 0x1b, 0x10, 0xf9, 0x41, 0x82,
-	0x95, 0xb8, 0xbd, 0x10, 0x6d, 0x72, 0x19, 0xa1, 0x4d, 0xc9, 0xaf, 0x27, 0x4f, 0x40, 0xbe, 0xdd,
-	0x31, 0xdf, 0x26, 0x79, 0x45, 0xc8, 0xd7, 0x61, 0x99, 0xf5, 0x70, 0xfe, 0x7e, 0x96, 0x60, 0x55,
-	0xe0, 0x2b, 0xa4, 0x9a, 0xb0, 0x78, 0xcc, 0xbd, 0xe4, 0xdb, 0x57, 0xcc, 0x42, 0xee, 0x37, 0xc6,
-	0xdc, 0x65, 0xf2, 0x5a, 0x12, 0x6e, 0xed, 0x78, 0xe4, 0x8c, 0x43, 0x72, 0x22, 0x41, 0x3a, 0x6a,
-	0x44, 0x13, 0xde, 0x21, 0x81, 0x85, 0x4d, 0x78, 0x87, 0x44, 0xae, 0xa6, 0x6c, 0x4c, 0xbd, 0x72,
-	0xee, 0x6d, 0xe4, 0x89, 0x04, 0x19, 0x91, 0x25, 0x11, 0xf1, 0x3d, 0x4e, 0x71, 0x40, 0x79, 0xf7,
-	0x0a, 0x19, 0x88, 0xb8, 0x37, 0xb5, 0x7b, 0x1c, 0x31, 0x7c, 0xbf, 0xb9, 0x0b, 0x0d, 0xc9, 0x8f,
-	0x63, 0xe4, 0x98, 0x71, 0x4d, 0x47, 0x16, 0x39, 0xe5, 0x74, 0x64, 0xa1, 0x2b, 0x2a, 0x55, 0x86,
-	0xac, 0x92, 0x5b, 0x89, 0x90, 0xb9, 0xff, 0x0e, 0xc9, 0xf7, 0x12, 0x2c, 0x47, 0x8c, 0x81, 0xdc,
-	0x9a, 0xf9, 0x75, 0x89, 0xd8, 0x91, 0x5c, 0x4e, 0x18, 0x9d, 0x7c, 0x30, 0x43, 0xf7, 0x75, 0x5b,
-	0x74, 0xfc, 0x01, 0xd5, 0xef, 0x3c, 0x3d, 0xcb, 0x4b, 0xcf, 0xcf, 0xf2, 0xd2, 0x1f, 0x67, 0x79,
-	0xe9, 0x9b, 0xf3, 0x7c, 0xea, 0xf9, 0x79, 0x3e, 0xf5, 0xdb, 0x79, 0x3e, 0xf5, 0xf1, 0x76, 0xdb,
-	0xf1, 0x8f, 0xfa, 0x0d, 0xb5, 0x49, 0x3b, 0x81, 0x20, 0xff, 0x53, 0xf6, 0xac, 0x4f, 0xb5, 0x47,
-	0x5c, 0xdd, 0x1f, 0x74, 0x6d, 0xaf, 0xb1, 0xc0, 0xfe, 0x77, 0xdb, 0xfb, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x1f, 0xa2, 0x5f, 0xef, 0x16, 0x0e, 0x00, 0x00,
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xe3, 0x54,
+	0x10, 0xcf, 0xeb, 0x96, 0x6d, 0x77, 0xfa, 0x07, 0xe9, 0x35, 0x2b, 0x8a, 0xdb, 0x26, 0x95, 0x0b,
+	0x6d, 0xd3, 0xdd, 0xd8, 0xf4, 0xcf, 0x4a, 0xfc, 0x39, 0xd5, 0x2d, 0x8b, 0x7a, 0x58, 0x14, 0xdc,
+	0x15, 0x42, 0x1c, 0x88, 0x9c, 0xd8, 0x49, 0x2d, 0x1a, 0xbf, 0x6c, 0xec, 0xc0, 0x96, 0xaa, 0x17,
+	0x04, 0x52, 0x85, 0x84, 0x84, 0x04, 0x1f, 0x60, 0x0f, 0x88, 0x03, 0xa7, 0x45, 0x2a, 0x37, 0x3e,
+	0xc0, 0x6a, 0x4f, 0x2b, 0xb8, 0x70, 0x02, 0xd4, 0x22, 0xc1, 0x87, 0xe0, 0x80, 0xfc, 0xde, 0x3c,
+	0xc7, 0x6e, 0xdd, 0xc4, 0x85, 0x53, 0xd3, 0x37, 0x33, 0xbf, 0xf9, 0xcd, 0xbc, 0x79, 0xf3, 0x33,
+	0x14, 0xeb, 0xcc, 0x6f, 0x31, 0x5f, 0xb7, 0xba, 0xc1, 0x9e, 0xfe, 0xd1, 0x6a, 0xcd, 0x09, 0xac,
+	0x55, 0xfd, 0x41, 0xd7, 0xe9, 0x1c, 0x68, 0xed, 0x0e, 0x0b, 0x18, 0x9d, 0x12, 0x0e, 0x5a, 0xe8,
+	0xa0, 0xa1, 0x83, 0xb2, 0x82, 0x51, 0x35, 0xcb, 0x77, 0x84, 0x77, 0x14, 0xdb, 0xb6, 0x9a, 0xae,
+	0x67, 0x05, 0x2e, 0xf3, 0x04, 0x80, 0x52, 0x88, 0xfb, 0x4a, 0xaf, 0x3a, 0x73, 0xa5, 0x3d, 0xdf,
+	0x64, 0x4d, 0xc6, 0x7f, 0xea, 0xe1, 0x2f, 0x3c, 0x7d, 0xb1, 0xc9, 0x58, 0x73, 0xdf, 0xd1, 0xf9,
+	0x7f, 0xb5, 0x6e, 0x43, 0xb7, 0x3c, 0x64, 0xa4, 0xcc, 0xa2, 0xc9, 0x6a, 0xbb, 0xba, 0xe5, 0x79,
+	0x2c, 0xe0, 0xd9, 0xfc, 0x73, 0xe9, 0x12, 0x05, 0x71, 0xf2, 0x08, 0x2c, 0xec, 0x55, 0x91, 0x11,
+	0x8b, 0x13, 0xa6, 0x19, 0x0c, 0x95, 0x05, 0xc5, 0xfb, 0xa0, 0x7e, 0x00, 0xf9, 0x77, 0xc2, 0x7f,
+	0x37, 0xeb, 0x75, 0xd6, 0xf5, 0x02, 0xdf, 0x74, 0x1e, 0x74, 0x1d, 0x3f, 0xa0, 0x77, 0x01, 0x7a,
+	0x25, 0x4f, 0x93, 0x79, 0xb2, 0x3c, 0xb6, 0xb6, 0xa8, 0x21, 0x6e, 0x58, 0xb3, 0x26, 0x50, 0x90,
+	0x8a, 0x56, 0xb1, 0x9a, 0x0e, 0xc6, 0x9a, 0xb1, 0x48, 0xf5, 0x84, 0xc0, 0xcd, 0x73, 0x09, 0xfc,
+	0x36, 0xf3, 0x7c, 0x87, 0x9a, 0x30, 0x6a, 0xe1, 0xd9, 0x34, 0x99, 0xbf, 0xb6, 0x3c, 0xb6, 0x96,
+	0xd7, 0x44, 0x0b, 0x34, 0xd9, 0x1d, 0x6d, 0xd3, 0x3b, 0x30, 0xe6, 0x9f, 0x9e, 0x94, 0x67, 0x53,
+	0x6e, 0x4b, 0x43, 0xc4, 0x1d, 0x33, 0xc2, 0xa1, 0x6f, 0x25, 0x58, 0x0f, 0x71, 0xd6, 0x4b, 0x03,
+	0x59, 0x0b, 0x42, 0x09, 0xda, 0xbb, 0x30, 0x15, 0x67, 0x2d, 0xbb, 0xb2, 0x06, 0x23, 0x96, 0x6d,
+	0x77, 0x1c, 0xdf, 0xe7, 0x2d, 0xb9, 0x61, 0x4c, 0xff, 0x7c, 0x52, 0xce, 0x23, 0xfe, 0xa6, 0xb0,
+	0xec, 0x06, 0x1d, 0xd7, 0x6b, 0x9a, 0xd2, 0xf1, 0xf5, 0xd1, 0xe3, 0x47, 0xc5, 0xdc, 0xdf, 0x8f,
+	0x8a, 0x39, 0x75, 0x2f, 0xd9, 0xeb, 0xa8, 0x13, 0x15, 0x18, 0xc1, 0x0a, 0xb0, 0xd1, 0xff, 0xb5,
+	0x11, 0x12, 0x46, 0xcd, 0x03, 0xe5, 0x99, 0x2a, 0x56, 0xc7, 0x6a, 0xc9, 0x3b, 0x55, 0x2b, 0x30,
+	0x95, 0x38, 0xc5, 0xf4, 0xaf, 0xc1, 0xf5, 0x36, 0x3f, 0xc1, 0xec, 0x33, 0x5a, 0x5a, 0x12, 0x11,
+	0x64, 0x0c, 0x3f, 0xf9, 0xad, 0x98, 0x33, 0x31, 0x40, 0x9d, 0x05, 0x85, 0x23, 0xde, 0x63, 0x76,
+	0x77, 0xdf, 0x39, 0x37, 0x43, 0xea, 0xc7, 0x30, 0x93, 0x6a, 0xc5, 0xbc, 0xef, 0x65, 0x1c, 0x80,
+	0xc5, 0xa7, 0x27, 0x65, 0x35, 0x8d, 0x52, 0x02, 0x37, 0x36, 0x06, 0xea, 0x1d, 0x28, 0x5e, 0x4c,
+	0x6c, 0x1c, 0xbc, 0x6d, 0xb5, 0xe4, 0x8c, 0x52, 0x0a, 0xc3, 0x9e, 0xd5, 0x72, 0xc4, 0x35, 0x9a,
+	0xfc, 0xb7, 0xfa, 0x09, 0xcc, 0x5f, 0x1e, 0x86, 0xa4, 0xdf, 0xcd, 0x76, 0x57, 0x59, 0x39, 0x47,
+	0x37, 0x76, 0x13, 0xa6, 0x0c, 0xa7, 0xbe, 0xb7, 0xbe, 0x56, 0xe9, 0x38, 0x0d, 0xf7, 0xa1, 0x6c,
+	0xe1, 0x1b, 0x90, 0x4f, 0x1e, 0x23, 0x8d, 0x05, 0x98, 0xa8, 0xf1, 0xf3, 0x6a, 0x9b, 0x1b, 0xb0,
+	0x8e, 0xf1, 0x5a, 0xcc, 0x59, 0x35, 0x60, 0x06, 0x67, 0xd2, 0x38, 0x08, 0x1c, 0xff, 0x3e, 0xc3,
+	0xd1, 0xc4, 0x16, 0x2c, 0xc0, 0x04, 0xce, 0x68, 0xb5, 0x16, 0xda, 0x39, 0xc6, 0xb8, 0x39, 0x6e,
+	0xc5, 0x62, 0xd4, 0x37, 0x61, 0x36, 0x1d, 0x03, 0x89, 0xbc, 0x0c, 0x93, 0x12, 0xc4, 0xe7, 0x16,
+	0x64, 0x22, 0xa1, 0x85, 0xbb, 0xba, 0x1d, 0x51, 0x11, 0x07, 0xf7, 0x19, 0x87, 0x93, 0x54, 0x32,
+	0xa2, 0x6c, 0x45, 0x64, 0xce, 0xa1, 0xf4, 0xba, 0x32, 0xb8, 0xa2, 0x5d, 0x28, 0xc4, 0x5f, 0x61,
+	0x54, 0xdd, 0xce, 0x76, 0x6f, 0x36, 0x86, 0x5c, 0x9b, 0xc7, 0x5e, 0x33, 0x86, 0xa6, 0x89, 0x39,
+	0xe4, 0xda, 0x74, 0x0e, 0x00, 0xaf, 0xaa, 0xea, 0xda, 0x7c, 0xb3, 0x0c, 0x9b, 0x37, 0xf0, 0x64,
+	0xc7, 0x56, 0x6d, 0x28, 0x5e, 0x0a, 0x8a, 0xe4, 0x36, 0xe1, 0x79, 0x89, 0x90, 0x75, 0x87, 0x4c,
+	0x5a, 0x09, 0x38, 0xf5, 0x1e, 0xbc, 0x10, 0xcf, 0xb2, 0xe3, 0x35, 0xd8, 0xff, 0xd8, 0x4c, 0x6a,
+	0x05, 0xa6, 0x2f, 0xc2, 0x21, 0xdb, 0x0d, 0x18, 0x76, 0xbd, 0x06, 0xc3, 0x21, 0x9f, 0x4f, 0x5d,
+	0x09, 0x86, 0xe5, 0xcb, 0x49, 0x36, 0xb9, 0x77, 0x38, 0xc5, 0x1c, 0x31, 0xb4, 0xdc, 0x75, 0xe4,
+	0x63, 0x53, 0x3f, 0x23, 0x90, 0x4f, 0x9e, 0x63, 0x96, 0x7d, 0x18, 0x0d, 0xb7, 0x72, 0xb5, 0xe1,
+	0x38, 0xb8, 0x02, 0x66, 0x13, 0xdb, 0x5a, 0x66, 0xda, 0x76, 0xea, 0x5b, 0xcc, 0xf5, 0x8c, 0xf5,
+	0x70, 0xfb, 0x7c, 0xff, 0x7b, 0xf1, 0x56, 0xd3, 0x0d, 0xf6, 0xba, 0x35, 0xad, 0xce, 0x5a, 0xa8,
+	0x75, 0xf8, 0xa7, 0xec, 0xdb, 0x1f, 0xea, 0xc1, 0x41, 0xdb, 0xf1, 0x65, 0x8c, 0x6f, 0x8e, 0xd4,
+	0x44, 0xd6, 0xb5, 0x7f, 0x26, 0xe0, 0x39, 0x4e, 0x83, 0x7e, 0x49, 0x60, 0x54, 0xee, 0x23, 0x5a,
+	0x4a, 0x2d, 0x2e, 0x4d, 0x15, 0x95, 0x95, 0x2c, 0xae, 0xa2, 0x36, 0x75, 0xe5, 0xf8, 0xaf, 0xc7,
+	0x2b, 0xe4, 0xd3, 0x5f, 0xfe, 0xfc, 0x7a, 0xa8, 0x48, 0xe7, 0xf4, 0x54, 0xfd, 0x96, 0x14, 0xbe,
+	0x21, 0x30, 0x82, 0x00, 0x74, 0x79, 0x60, 0x0e, 0xc9, 0xa6, 0x94, 0xc1, 0x13, 0xc9, 0x6c, 0xf4,
+	0xc8, 0x94, 0xe8, 0x52, 0x5f, 0x32, 0xfa, 0x21, 0xce, 0xc7, 0x11, 0xfd, 0x91, 0x00, 0xbd, 0x38,
+	0xd1, 0x74, 0x7d, 0x60, 0xde, 0x8b, 0x8f, 0x4a, 0xd9, 0xb8, 0x5a, 0xd0, 0x15, 0x78, 0x47, 0x2f,
+	0xbe, 0xea, 0xda, 0xfa, 0xa1, 0x6b, 0x1f, 0xd1, 0xcf, 0x09, 0x5c, 0x17, 0x7a, 0x45, 0x97, 0x2e,
+	0x4f, 0x9b, 0x10, 0x47, 0x65, 0x79, 0xb0, 0x23, 0x72, 0x5a, 0xee, 0x71, 0x9a, 0xa3, 0x33, 0xa9,
+	0x9c, 0x84, 0x3c, 0xd2, 0xef, 0x08, 0x4c, 0x26, 0xc5, 0x8f, 0xea, 0x97, 0xa7, 0x49, 0x15, 0x51,
+	0xe5, 0x95, 0xec, 0x01, 0xc8, 0x6f, 0xb5, 0xc7, 0x6f, 0x91, 0xbe, 0x94, 0xca, 0xaf, 0xc5, 0x23,
+	0xab, 0xd1, 0xfc, 0xfd, 0x44, 0x60, 0x2a, 0x45, 0xf5, 0xe8, 0x46, 0xc6, 0xe4, 0x09, 0x6d, 0x55,
+	0xee, 0x5c, 0x31, 0x0a, 0x79, 0xbf, 0xda, 0xe3, 0x5d, 0xa6, 0xb7, 0xb2, 0xf0, 0xd6, 0x0f, 0x43,
+	0xdd, 0x3e, 0xa2, 0xc7, 0x04, 0xc6, 0xe3, 0x32, 0x79, 0xc9, 0x1b, 0x4a, 0x11, 0x58, 0xa5, 0x94,
+	0xc1, 0x13, 0xf9, 0x2d, 0xf4, 0xbd, 0x72, 0xa1, 0xbc, 0xf4, 0x31, 0x81, 0x7c, 0x9a, 0x60, 0xd2,
+	0xf4, 0x7b, 0xec, 0xa3, 0xcf, 0xca, 0xea, 0x15, 0x22, 0x90, 0xe2, 0x7a, 0xdf, 0xee, 0x09, 0x8a,
+	0xfa, 0x61, 0x42, 0x23, 0x8f, 0xe8, 0x0f, 0x3d, 0xca, 0x09, 0x59, 0xed, 0x4f, 0x39, 0x4d, 0xc7,
+	0x95, 0xd5, 0x2b, 0x44, 0xc8, 0x17, 0xce, 0x29, 0x6b, 0xf4, 0x76, 0x26, 0xca, 0xe2, 0xeb, 0xe0,
+	0x88, 0x7e, 0x4b, 0x60, 0x2c, 0x26, 0x5b, 0xf4, 0xf6, 0xc0, 0xed, 0x12, 0x13, 0x4b, 0xa5, 0x9c,
+	0xd1, 0x3b, 0xfb, 0x60, 0x46, 0xdf, 0x06, 0x5e, 0x83, 0xc5, 0x16, 0xe8, 0x17, 0x04, 0x46, 0x50,
+	0xf3, 0xfa, 0xed, 0xf5, 0xa4, 0x5c, 0x2a, 0xa5, 0x0c, 0x9e, 0xd9, 0x45, 0x46, 0x0a, 0xac, 0xb1,
+	0xf5, 0xe4, 0xb4, 0x40, 0x9e, 0x9d, 0x16, 0xc8, 0x1f, 0xa7, 0x05, 0xf2, 0xd5, 0x59, 0x21, 0xf7,
+	0xec, 0xac, 0x90, 0xfb, 0xf5, 0xac, 0x90, 0x7b, 0xbf, 0xd4, 0x57, 0x4e, 0x1f, 0x0a, 0x3c, 0xae,
+	0xaa, 0xb5, 0xeb, 0xfc, 0x33, 0x77, 0xfd, 0xdf, 0x01, 0x00, 0xbd, 0xa1, 0xe2, 0x1f, 0x61, 0x0f,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1107,6 +1201,8 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(ctx context.Context, in *QueryAccountInfoRequest, opts ...grpc.CallOption) (*QueryAccountInfoResponse, error)
+	// BaseFee queries the current base fee per unit of gas of the fee market.
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -1207,6 +1303,15 @@ func (c *queryClient) AccountInfo(ctx context.Context, in *QueryAccountInfoReque
 	return out, nil
 }
 
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.auth.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Accounts returns all the existing accounts.
@@ -1246,6 +1351,8 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.47
 	AccountInfo(context.Context, *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error)
+	// BaseFee queries the current base fee per unit of gas of the fee market.
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1282,6 +1389,9 @@ func (*UnimplementedQueryServer) AddressStringToBytes(ctx context.Context, req *
 func (*UnimplementedQueryServer) AccountInfo(ctx context.Context, req *QueryAccountInfoRequest) (*QueryAccountInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountInfo not implemented")
 }
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1467,6 +1577,24 @@ func _Query_AccountInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.auth.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.auth.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1511,6 +1639,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountInfo",
 			Handler:    _Query_AccountInfo_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/auth/v1beta1/query.proto",
@@ -2147,6 +2279,66 @@ func (m *QueryAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		for iNdEx := len(m.BaseFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2414,6 +2606,30 @@ func (m *QueryAccountInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseFee) > 0 {
+		for _, e := range m.BaseFee {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4031,6 +4247,140 @@ func (m *QueryAccountInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFee = append(m.BaseFee, types1.DecCoin{})
+			if err := m.BaseFee[len(m.BaseFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,6 +11,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +31,46 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FilterOperator defines the comparison applied by a MsgFieldFilter.
+type FilterOperator int32
+
+const (
+	// FILTER_OPERATOR_UNSPECIFIED specifies an unknown operator
+	FilterOperator_FILTER_OPERATOR_UNSPECIFIED FilterOperator = 0
+	// FILTER_OPERATOR_EQUAL requires the field to be equal to the single value
+	FilterOperator_FILTER_OPERATOR_EQUAL FilterOperator = 1
+	// FILTER_OPERATOR_IN requires the field to be equal to one of the values
+	FilterOperator_FILTER_OPERATOR_IN FilterOperator = 2
+	// FILTER_OPERATOR_LTE requires the numeric field to be lower than or equal to the single value
+	FilterOperator_FILTER_OPERATOR_LTE FilterOperator = 3
+	// FILTER_OPERATOR_GTE requires the numeric field to be greater than or equal to the single value
+	FilterOperator_FILTER_OPERATOR_GTE FilterOperator = 4
+)
+
+var FilterOperator_name = map[int32]string{
+	0: "FILTER_OPERATOR_UNSPECIFIED",
+	1: "FILTER_OPERATOR_EQUAL",
+	2: "FILTER_OPERATOR_IN",
+	3: "FILTER_OPERATOR_LTE",
+	4: "FILTER_OPERATOR_GTE",
+}
+
+var FilterOperator_value = map[string]int32{
+	"FILTER_OPERATOR_UNSPECIFIED": 0,
+	"FILTER_OPERATOR_EQUAL":       1,
+	"FILTER_OPERATOR_IN":          2,
+	"FILTER_OPERATOR_LTE":         3,
+	"FILTER_OPERATOR_GTE":         4,
+}
+
+func (x FilterOperator) String() string {
+	return proto.EnumName(FilterOperator_name, int32(x))
+}
+
+func (FilterOperator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{0}
+}
+
 // GenericAuthorization gives the grantee unrestricted permissions to execute
 // the provided method on behalf of the granter's account.
 type GenericAuthorization struct {
@@ -70,6 +111,105 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// FilteredAuthorization gives the grantee permissions to execute the provided
+// method on behalf of the granter's account, restricted to the Msgs whose fields
+// satisfy every filter and optionally to a number of executions per period.
+type FilteredAuthorization struct {
+	// Msg, identified by it's type URL, to grant permissions to execute
+	Msg string `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	// filters are the predicates every executed Msg must satisfy.
+	Filters []MsgFieldFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters"`
+	// max_calls is the maximum number of Msgs that can be executed in a period.
+	// There is no limit if zero.
+	MaxCalls uint64 `protobuf:"varint,3,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// period is the time duration after which the number of executed Msgs is
+	// reset. If zero, max_calls limits the executions over the grant lifetime.
+	Period time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+	// calls is the number of Msgs executed in the current period.
+	Calls uint64 `protobuf:"varint,5,opt,name=calls,proto3" json:"calls,omitempty"`
+	// period_reset is the time at which the current period ends and a new one
+	// begins. It is set on the first execution of the grant.
+	PeriodReset time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *FilteredAuthorization) Reset()         { *m = FilteredAuthorization{} }
+func (m *FilteredAuthorization) String() string { return proto.CompactTextString(m) }
+func (*FilteredAuthorization) ProtoMessage()    {}
+func (*FilteredAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *FilteredAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilteredAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilteredAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilteredAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilteredAuthorization.Merge(m, src)
+}
+func (m *FilteredAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilteredAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilteredAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilteredAuthorization proto.InternalMessageInfo
+
+// MsgFieldFilter is a predicate over a field of a Msg.
+type MsgFieldFilter struct {
+	// path is the dot separated list of proto field names leading from the Msg
+	// to the filtered field, e.g. "amount.denom". When a repeated field is
+	// traversed the predicate must hold for each of its elements.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// operator is the comparison applied to the field value.
+	Operator FilterOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=cosmos.authz.v1beta1.FilterOperator" json:"operator,omitempty"`
+	// values are the operands of the comparison, in their string representation.
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *MsgFieldFilter) Reset()         { *m = MsgFieldFilter{} }
+func (m *MsgFieldFilter) String() string { return proto.CompactTextString(m) }
+func (*MsgFieldFilter) ProtoMessage()    {}
+func (*MsgFieldFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *MsgFieldFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFieldFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFieldFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFieldFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFieldFilter.Merge(m, src)
+}
+func (m *MsgFieldFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFieldFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFieldFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFieldFilter proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +224,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +266,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +305,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{5}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +335,10 @@ func (m *GrantQueueItem) XXX_DiscardUnknown() {
 var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("cosmos.authz.v1beta1.FilterOperator", FilterOperator_name, FilterOperator_value)
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*FilteredAuthorization)(nil), "cosmos.authz.v1beta1.FilteredAuthorization")
+	proto.RegisterType((*MsgFieldFilter)(nil), "cosmos.authz.v1beta1.MsgFieldFilter")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +347,53 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0xeb, 0xf1, 0xe7, 0x7c, 0x3a, 0x04, 0x51, 0x86, 0xd2, 0x21, 0xa9, 0x22, 0x84,
-	0x4e, 0x48, 0x8d, 0x75, 0x07, 0x13, 0x13, 0x8d, 0x90, 0x4e, 0xb0, 0x11, 0x8e, 0x85, 0xa5, 0x72,
-	0x5a, 0xe3, 0x5a, 0xd4, 0x71, 0x64, 0x3b, 0xe8, 0x72, 0x1f, 0x81, 0xe9, 0x3e, 0x03, 0x9f, 0x00,
-	0xa4, 0x7e, 0x88, 0x8a, 0xa9, 0x62, 0x62, 0xe2, 0x4f, 0x3b, 0xf0, 0x35, 0x50, 0xed, 0x44, 0x34,
-	0xb4, 0x12, 0x1d, 0x58, 0x22, 0xdb, 0xef, 0xf3, 0xbc, 0xef, 0x93, 0x5f, 0x62, 0xd8, 0x1d, 0x0a,
-	0xc5, 0x85, 0x42, 0xb8, 0xd0, 0xe3, 0x4b, 0xf4, 0xee, 0x24, 0x25, 0x1a, 0x9f, 0xd8, 0x5d, 0x94,
-	0x4b, 0xa1, 0x85, 0xeb, 0x59, 0x45, 0x64, 0xcf, 0x2a, 0x45, 0xe7, 0x0e, 0xe6, 0x2c, 0x13, 0xc8,
-	0x3c, 0xad, 0xb0, 0x73, 0xd7, 0x0a, 0x07, 0x66, 0x87, 0x2a, 0x97, 0x2d, 0x05, 0x54, 0x08, 0x3a,
-	0x21, 0xc8, 0xec, 0xd2, 0xe2, 0x0d, 0xd2, 0x8c, 0x13, 0xa5, 0x31, 0xcf, 0x2b, 0x81, 0x47, 0x05,
-	0x15, 0xd6, 0xb8, 0x5a, 0xd5, 0x1d, 0xff, 0xb6, 0xe1, 0xac, 0xb4, 0xa5, 0x50, 0x43, 0xef, 0x8c,
-	0x64, 0x44, 0xb2, 0x61, 0xbf, 0xd0, 0x63, 0x21, 0xd9, 0x25, 0xd6, 0x4c, 0x64, 0xee, 0x6d, 0xd8,
-	0xe2, 0x8a, 0xb6, 0x41, 0x17, 0x1c, 0x1f, 0x24, 0xab, 0xe5, 0xe3, 0xe7, 0x9f, 0xa7, 0xbd, 0x70,
-	0xdb, 0x3b, 0x44, 0x0d, 0xe7, 0xfb, 0x5f, 0x1f, 0x1f, 0x04, 0x56, 0xd6, 0x53, 0xa3, 0xb7, 0x68,
-	0x5b, 0xf7, 0xf0, 0x13, 0x80, 0xd7, 0xce, 0x24, 0xce, 0xb4, 0x9b, 0xc2, 0x23, 0xbc, 0x5e, 0x32,
-	0x13, 0x0f, 0x4f, 0xbd, 0xc8, 0x46, 0x8e, 0xea, 0xc8, 0x51, 0x3f, 0x2b, 0xe3, 0xfb, 0xbb, 0x45,
-	0x48, 0x9a, 0x2d, 0xdd, 0xa7, 0x10, 0x92, 0x8b, 0x9c, 0x49, 0x3b, 0x60, 0xcf, 0x0c, 0xe8, 0x6c,
-	0x0c, 0x38, 0xaf, 0x51, 0xc6, 0x37, 0x67, 0xdf, 0x02, 0x70, 0xf5, 0x3d, 0x00, 0xc9, 0x9a, 0x2f,
-	0xfc, 0xb0, 0x07, 0x5d, 0x93, 0xb9, 0x09, 0xea, 0x14, 0xde, 0xa0, 0xab, 0x53, 0x22, 0x2d, 0xac,
-	0xb8, 0xfd, 0x65, 0xda, 0xab, 0xbf, 0x75, 0x7f, 0x34, 0x92, 0x44, 0xa9, 0x97, 0x5a, 0xb2, 0x8c,
-	0x26, 0xb5, 0xf0, 0x8f, 0x87, 0x98, 0x34, 0x3b, 0x78, 0xc8, 0x26, 0xa8, 0xd6, 0xff, 0x07, 0xf5,
-	0xa4, 0x01, 0x6a, 0xff, 0x9f, 0xa0, 0xf6, 0x37, 0x20, 0x3d, 0x82, 0xb7, 0x0c, 0xa3, 0x17, 0x05,
-	0x29, 0xc8, 0x33, 0x4d, 0xb8, 0x1b, 0xc2, 0x23, 0xae, 0xe8, 0x40, 0x97, 0x39, 0x19, 0x14, 0x72,
-	0xa2, 0xda, 0xa0, 0xdb, 0x3a, 0x3e, 0x48, 0x0e, 0xb9, 0xa2, 0xe7, 0x65, 0x4e, 0x5e, 0xc9, 0x89,
-	0x8a, 0xe3, 0xd9, 0x4f, 0xdf, 0x99, 0x2d, 0x7c, 0x30, 0x5f, 0xf8, 0xe0, 0xc7, 0xc2, 0x07, 0x57,
-	0x4b, 0xdf, 0x99, 0x2f, 0x7d, 0xe7, 0xeb, 0xd2, 0x77, 0x5e, 0xdf, 0xa3, 0x4c, 0x8f, 0x8b, 0x34,
-	0x1a, 0x0a, 0x5e, 0xdd, 0x06, 0xb4, 0xf6, 0x7f, 0x5d, 0xd8, 0x4b, 0x96, 0x5e, 0x37, 0xf9, 0x1e,
-	0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x50, 0x89, 0xaf, 0x02, 0x89, 0x03, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x6f, 0x1a, 0x49,
+	0x18, 0xc6, 0x19, 0xc0, 0xd8, 0x0c, 0x67, 0xc4, 0xcd, 0x61, 0xdf, 0xda, 0x96, 0x16, 0x84, 0xac,
+	0x13, 0xb2, 0xe4, 0x5d, 0x99, 0xbb, 0xca, 0x95, 0xc1, 0x06, 0x8b, 0x3b, 0xce, 0x7f, 0xd6, 0xb8,
+	0xb9, 0x06, 0x2d, 0x30, 0x5e, 0x56, 0xb7, 0xcb, 0xac, 0x66, 0x66, 0x2d, 0x70, 0x91, 0x2a, 0x55,
+	0x2a, 0x57, 0x51, 0xea, 0x54, 0x29, 0x1d, 0xc9, 0x1f, 0x02, 0xa5, 0xb2, 0x52, 0xa5, 0x72, 0x12,
+	0xbb, 0xf0, 0xd7, 0x88, 0x76, 0x67, 0x71, 0xf8, 0x17, 0xd9, 0x45, 0x1a, 0x34, 0xef, 0xbc, 0xcf,
+	0xf3, 0xec, 0xf0, 0x7b, 0x87, 0x05, 0x66, 0x5b, 0x84, 0xd9, 0x84, 0xa9, 0xba, 0xcb, 0x3b, 0x17,
+	0xea, 0xf9, 0x56, 0x13, 0x73, 0x7d, 0x4b, 0x54, 0x8a, 0x43, 0x09, 0x27, 0x28, 0x2d, 0x14, 0x8a,
+	0xd8, 0x0b, 0x14, 0xab, 0xbf, 0xea, 0xb6, 0xd9, 0x25, 0xaa, 0xff, 0x29, 0x84, 0xab, 0x2b, 0x42,
+	0xd8, 0xf0, 0x2b, 0x35, 0x70, 0x89, 0x56, 0xc6, 0x20, 0xc4, 0xb0, 0xb0, 0xea, 0x57, 0x4d, 0xf7,
+	0x4c, 0xe5, 0xa6, 0x8d, 0x19, 0xd7, 0x6d, 0x27, 0x10, 0xc8, 0x93, 0x82, 0xb6, 0x4b, 0x75, 0x6e,
+	0x92, 0x6e, 0xd0, 0x4f, 0x1b, 0xc4, 0x20, 0x22, 0xd8, 0x5b, 0x0d, 0x9f, 0x38, 0xe9, 0xd2, 0xbb,
+	0x7d, 0xd1, 0xca, 0x71, 0x98, 0xde, 0xc7, 0x5d, 0x4c, 0xcd, 0x56, 0xd1, 0xe5, 0x1d, 0x42, 0xcd,
+	0x0b, 0x3f, 0x0e, 0xa5, 0x60, 0xc4, 0x66, 0x86, 0x04, 0xb2, 0x20, 0x1f, 0xd7, 0xbc, 0xe5, 0xf6,
+	0xdf, 0x1f, 0xae, 0x37, 0x73, 0xb3, 0xbe, 0xa3, 0x32, 0xe6, 0x7c, 0xf5, 0x70, 0xb5, 0x91, 0x11,
+	0xb2, 0x4d, 0xd6, 0xfe, 0x5f, 0x9d, 0x95, 0x9e, 0x7b, 0x19, 0x81, 0x4b, 0x15, 0xd3, 0xe2, 0x98,
+	0xe2, 0xf6, 0x13, 0xcf, 0x45, 0x55, 0x38, 0x7f, 0xe6, 0x4b, 0x99, 0x14, 0xce, 0x46, 0xf2, 0x89,
+	0xc2, 0xba, 0x32, 0xf3, 0x14, 0xff, 0x32, 0xa3, 0x62, 0x62, 0xab, 0x2d, 0x72, 0x4b, 0xf1, 0xc1,
+	0x6d, 0x26, 0xf4, 0xee, 0xe1, 0x6a, 0x03, 0x68, 0x43, 0x3f, 0x5a, 0x83, 0x71, 0x5b, 0xef, 0x35,
+	0x5a, 0xba, 0x65, 0x31, 0x29, 0x92, 0x05, 0xf9, 0xa8, 0xb6, 0x60, 0xeb, 0xbd, 0x5d, 0xaf, 0x46,
+	0x3b, 0x30, 0xe6, 0x60, 0x6a, 0x92, 0xb6, 0x14, 0xcd, 0x82, 0x7c, 0xa2, 0xb0, 0xa2, 0x08, 0x6a,
+	0xca, 0x90, 0x9a, 0xb2, 0x17, 0xb0, 0x2e, 0x2d, 0x7a, 0xd9, 0x6f, 0x3e, 0x67, 0x80, 0xc8, 0x0f,
+	0x7c, 0x28, 0x0d, 0xe7, 0x44, 0xf4, 0x9c, 0x1f, 0x2d, 0x0a, 0x54, 0x83, 0xbf, 0x88, 0x7e, 0x83,
+	0x62, 0x86, 0xb9, 0x14, 0xf3, 0xd3, 0x57, 0xa7, 0xd2, 0xeb, 0xc3, 0x51, 0x8b, 0xf8, 0xcb, 0xc7,
+	0xf8, 0x84, 0xb0, 0x6b, 0x9e, 0x7b, 0xfb, 0x9f, 0xe7, 0x4f, 0x21, 0x3b, 0x32, 0x85, 0x99, 0xb0,
+	0x73, 0x2f, 0x60, 0x72, 0x9c, 0x1a, 0x42, 0x30, 0xea, 0xe8, 0xbc, 0x13, 0xf0, 0xf7, 0xd7, 0x68,
+	0x07, 0x2e, 0x10, 0x07, 0x53, 0x9d, 0x13, 0x2a, 0x85, 0xb3, 0x20, 0x9f, 0xfc, 0xd1, 0x04, 0x44,
+	0xc6, 0x61, 0xa0, 0xd5, 0x1e, 0x5d, 0x68, 0x19, 0xc6, 0xce, 0x75, 0xcb, 0xc5, 0x1e, 0xf4, 0x48,
+	0x3e, 0xae, 0x05, 0x55, 0xee, 0x3d, 0x80, 0x73, 0xfb, 0x54, 0xef, 0x72, 0xd4, 0x84, 0x8b, 0xfa,
+	0xe8, 0xd1, 0xfc, 0x03, 0x24, 0x0a, 0xe9, 0x29, 0x4a, 0xc5, 0x6e, 0xbf, 0xf4, 0xc7, 0xf3, 0x18,
+	0x68, 0xe3, 0x91, 0x68, 0x0f, 0x42, 0xdc, 0x73, 0x4c, 0x31, 0x43, 0x29, 0xfc, 0xe4, 0x18, 0x16,
+	0x06, 0xb7, 0x19, 0xe0, 0x8d, 0x41, 0x1b, 0xf1, 0xe5, 0xde, 0x86, 0x21, 0xf2, 0xcf, 0x3c, 0x7e,
+	0x6f, 0x0b, 0x70, 0xde, 0xf0, 0x76, 0x31, 0x15, 0xec, 0x4a, 0xd2, 0xc7, 0xeb, 0xcd, 0xe1, 0x2b,
+	0xa1, 0xd8, 0x6e, 0x53, 0xcc, 0xd8, 0x09, 0xa7, 0x66, 0xd7, 0xd0, 0x86, 0xc2, 0xef, 0x1e, 0x2c,
+	0x85, 0x9f, 0xe7, 0xc1, 0xd3, 0xa0, 0x22, 0x3f, 0x1f, 0xd4, 0xce, 0x18, 0xa8, 0xe8, 0x93, 0xa0,
+	0xa2, 0x53, 0x90, 0xfe, 0x82, 0x49, 0x9f, 0xd1, 0xb1, 0x8b, 0x5d, 0x5c, 0xe5, 0xd8, 0x46, 0x39,
+	0xb8, 0x68, 0x33, 0xa3, 0xc1, 0xfb, 0x0e, 0x6e, 0xb8, 0xd4, 0x62, 0x12, 0xf0, 0x6f, 0x42, 0xc2,
+	0x66, 0x46, 0xbd, 0xef, 0xe0, 0x53, 0x6a, 0xb1, 0x8d, 0xd7, 0x00, 0x26, 0xc7, 0xef, 0x10, 0xca,
+	0xc0, 0xb5, 0x4a, 0xb5, 0x56, 0x2f, 0x6b, 0x8d, 0xc3, 0xa3, 0xb2, 0x56, 0xac, 0x1f, 0x6a, 0x8d,
+	0xd3, 0x83, 0x93, 0xa3, 0xf2, 0x6e, 0xb5, 0x52, 0x2d, 0xef, 0xa5, 0x42, 0x68, 0x05, 0x2e, 0x4d,
+	0x0a, 0xca, 0xc7, 0xa7, 0xc5, 0x5a, 0x0a, 0xa0, 0x65, 0x88, 0x26, 0x5b, 0xd5, 0x83, 0x54, 0x18,
+	0xfd, 0x0e, 0x7f, 0x9b, 0xdc, 0xaf, 0xd5, 0xcb, 0xa9, 0xc8, 0xac, 0xc6, 0x7e, 0xbd, 0x9c, 0x8a,
+	0x96, 0x4a, 0x83, 0xaf, 0x72, 0x68, 0x70, 0x27, 0x83, 0x9b, 0x3b, 0x19, 0x7c, 0xb9, 0x93, 0xc1,
+	0xe5, 0xbd, 0x1c, 0xba, 0xb9, 0x97, 0x43, 0x9f, 0xee, 0xe5, 0xd0, 0x7f, 0xeb, 0x86, 0xc9, 0x3b,
+	0x6e, 0x53, 0x69, 0x11, 0x3b, 0x78, 0x9b, 0xab, 0x23, 0xbf, 0xbc, 0x9e, 0xf8, 0x93, 0x68, 0xc6,
+	0x7c, 0x70, 0x7f, 0x7e, 0x1b, 0x00, 0xda, 0xf5, 0x37, 0x04, 0x49, 0x06, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +426,120 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FilteredAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilteredAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilteredAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthz(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Calls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAuthz(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCalls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFieldFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFieldFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFieldFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,12 +561,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -331,12 +606,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err5 != nil {
+			return 0, err5
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n5
+		i = encodeVarintAuthz(dAtA, i, uint64(n5))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +700,57 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *FilteredAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCalls))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if m.Calls != 0 {
+		n += 1 + sovAuthz(uint64(m.Calls))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
+	return n
+}
+
+func (m *MsgFieldFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovAuthz(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +896,359 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FilteredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilteredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilteredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, MsgFieldFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+			}
+			m.MaxCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFieldFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFieldFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFieldFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= FilterOperator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,13 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
+	FlagFilter            = "filter"
+	FlagMaxCalls          = "max-calls"
+	FlagPeriod            = "period"
+	FlagPeriodSpendLimit  = "period-spend-limit"
+	FlagMaxPerTx          = "max-per-tx"
+	FlagGrantee           = "grantee"
+	FlagExpirationBefore  = "expiration-before"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
 	unbond                = "unbond"
@@ -46,6 +53,7 @@ func GetTxCmd() *cobra.Command {
 	authorizationTxCmd.AddCommand(
 		NewCmdGrantAuthorization(),
 		NewCmdExecAuthorization(),
+		NewCmdRevokeAllAuthorizations(),
 	)
 
 	return authorizationTxCmd
@@ -91,12 +99,14 @@ func NewCmdExecAuthorization() *cobra.Command {
 // Migrating this command to AutoCLI is possible but would be CLI breaking.
 func NewCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
+		Use:   "grant [grantee] <authorization_type=\"send\"|\"generic\"|\"filtered\"|\"delegate\"|\"unbond\"|\"redelegate\"> --from [granter]",
 		Short: "Grant authorization to an address",
 		Long: fmt.Sprintf(`create a new grant authorization to an address to execute a transaction on your behalf:
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
+ $ %[1]s tx authz grant cosmos1skjw.. send --period-spend-limit=100stake --period=604800 --max-per-tx=50stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. filtered --msg-type=/cosmos.bank.v1beta1.MsgSend --filter=to_address:in:cosmos1ab..,cosmos1cd.. --filter=amount.amount:lte:1000 --max-calls=10 --period=86400 --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -127,10 +137,43 @@ Examples:
 					return err
 				}
 
-				if !spendLimit.IsAllPositive() {
+				period, err := cmd.Flags().GetInt64(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				// the spend limit is optional for periodic send authorizations
+				if (period == 0 || !spendLimit.Empty()) && !spendLimit.IsAllPositive() {
 					return fmt.Errorf("spend-limit should be greater than zero")
 				}
 
+				var periodSpendLimit sdk.Coins
+				if period > 0 {
+					periodLimit, err := cmd.Flags().GetString(FlagPeriodSpendLimit)
+					if err != nil {
+						return err
+					}
+
+					periodSpendLimit, err = sdk.ParseCoinsNormalized(periodLimit)
+					if err != nil {
+						return err
+					}
+
+					if !periodSpendLimit.IsAllPositive() {
+						return fmt.Errorf("period-spend-limit should be greater than zero")
+					}
+				}
+
+				maxPerTxLimit, err := cmd.Flags().GetString(FlagMaxPerTx)
+				if err != nil {
+					return err
+				}
+
+				maxPerTx, err := sdk.ParseCoinsNormalized(maxPerTxLimit)
+				if err != nil {
+					return err
+				}
+
 				allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
 				if err != nil {
 					return err
@@ -150,7 +193,13 @@ Examples:
 					return err
 				}
 
-				authorization = bank.NewSendAuthorization(spendLimit, allowed)
+				if period > 0 {
+					authorization = bank.NewPeriodicSendAuthorization(spendLimit, periodSpendLimit, time.Duration(period)*time.Second, maxPerTx, allowed)
+				} else {
+					sendAuthorization := bank.NewSendAuthorization(spendLimit, allowed)
+					sendAuthorization.MaxPerTx = maxPerTx
+					authorization = sendAuthorization
+				}
 
 			case "generic":
 				msgType, err := cmd.Flags().GetString(FlagMsgType)
@@ -159,6 +208,38 @@ Examples:
 				}
 
 				authorization = authz.NewGenericAuthorization(msgType)
+
+			case "filtered":
+				msgType, err := cmd.Flags().GetString(FlagMsgType)
+				if err != nil {
+					return err
+				}
+
+				rawFilters, err := cmd.Flags().GetStringArray(FlagFilter)
+				if err != nil {
+					return err
+				}
+
+				filters, err := parseMsgFieldFilters(rawFilters)
+				if err != nil {
+					return err
+				}
+
+				maxCalls, err := cmd.Flags().GetUint64(FlagMaxCalls)
+				if err != nil {
+					return err
+				}
+
+				period, err := cmd.Flags().GetInt64(FlagPeriod)
+				if err != nil {
+					return err
+				}
+
+				authorization = authz.NewFilteredAuthorization(msgType, filters, maxCalls, time.Duration(period)*time.Second)
+				if err := authorization.ValidateBasic(); err != nil {
+					return err
+				}
+
 			case delegate, unbond, redelegate:
 				limit, err := cmd.Flags().GetString(FlagSpendLimit)
 				if err != nil {
@@ -238,15 +319,116 @@ Examples:
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization")
+	cmd.Flags().String(FlagMsgType, "", "The Msg method name for which we are creating a GenericAuthorization or FilteredAuthorization")
 	cmd.Flags().String(FlagSpendLimit, "", "SpendLimit for Send Authorization, an array of Coins allowed spend")
 	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Allowed validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
+	cmd.Flags().StringArray(FlagFilter, []string{}, "Filter of a FilteredAuthorization as <field_path>:<eq|in|lte|gte>:<value>[,<value>...], can be repeated")
+	cmd.Flags().Uint64(FlagMaxCalls, 0, "Maximum number of executions of a FilteredAuthorization per period. Set zero (0) for no limit.")
+	cmd.Flags().Int64(FlagPeriod, 0, "Period (in seconds) after which the executions of a FilteredAuthorization or the period spend limit of a Send Authorization are reset. Set zero (0) for a lifetime limit.")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "PeriodSpendLimit for Send Authorization, an array of Coins allowed spend per period")
+	cmd.Flags().String(FlagMaxPerTx, "", "MaxPerTx for Send Authorization, an array of Coins allowed spend per transaction")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
 	return cmd
 }
 
+// NewCmdRevokeAllAuthorizations returns a CLI command handler for creating a MsgRevokeAll transaction.
+func NewCmdRevokeAllAuthorizations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-all --from [granter]",
+		Short: "Revoke all authorizations of a granter matching the given filters",
+		Long: fmt.Sprintf(`revoke all authorizations granted by the granter, optionally only those to a grantee,
+for a msg type or expiring before a given time:
+Examples:
+ $ %[1]s tx authz revoke-all --grantee=cosmos1skjw.. --from=cosmos1skl..
+ $ %[1]s tx authz revoke-all --msg-type=/cosmos.gov.v1.MsgVote --expiration-before=1735689600 --from=cosmos1skl..
+	`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			granteeStr, err := cmd.Flags().GetString(FlagGrantee)
+			if err != nil {
+				return err
+			}
+
+			var grantee sdk.AccAddress
+			if granteeStr != "" {
+				if strings.EqualFold(granteeStr, clientCtx.GetFromAddress().String()) {
+					return errors.New("grantee and granter should be different")
+				}
+
+				grantee, err = clientCtx.AddressCodec.StringToBytes(granteeStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msgType, err := cmd.Flags().GetString(FlagMsgType)
+			if err != nil {
+				return err
+			}
+
+			var expirationBefore *time.Time
+			before, err := cmd.Flags().GetInt64(FlagExpirationBefore)
+			if err != nil {
+				return err
+			}
+			if before != 0 {
+				t := time.Unix(before, 0)
+				expirationBefore = &t
+			}
+
+			msg := authz.NewMsgRevokeAll(clientCtx.GetFromAddress(), grantee, msgType, expirationBefore)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagGrantee, "", "Only revoke authorizations granted to the grantee")
+	cmd.Flags().String(FlagMsgType, "", "Only revoke authorizations for the Msg method name")
+	cmd.Flags().Int64(FlagExpirationBefore, 0, "Only revoke authorizations expiring before the Unix timestamp. Set zero (0) to ignore expiration")
+
+	return cmd
+}
+
+// filterOperators maps the CLI filter operators to their FilterOperator.
+var filterOperators = map[string]authz.FilterOperator{
+	"eq":  authz.FilterOperator_FILTER_OPERATOR_EQUAL,
+	"in":  authz.FilterOperator_FILTER_OPERATOR_IN,
+	"lte": authz.FilterOperator_FILTER_OPERATOR_LTE,
+	"gte": authz.FilterOperator_FILTER_OPERATOR_GTE,
+}
+
+// parseMsgFieldFilters parses filters given as <field_path>:<operator>:<value>[,<value>...].
+func parseMsgFieldFilters(rawFilters []string) ([]authz.MsgFieldFilter, error) {
+	filters := make([]authz.MsgFieldFilter, 0, len(rawFilters))
+	for _, raw := range rawFilters {
+		parts := strings.SplitN(raw, ":", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid filter %s, expected <field_path>:<operator>:<values>", raw)
+		}
+
+		operator, ok := filterOperators[parts[1]]
+		if !ok {
+			return nil, fmt.Errorf("invalid filter operator %s, expected one of eq, in, lte, gte", parts[1])
+		}
+
+		filters = append(filters, authz.MsgFieldFilter{
+			Path:     parts[0],
+			Operator: operator,
+			Values:   strings.Split(parts[2], ","),
+		})
+	}
+
+	return filters, nil
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
@@ -389,6 +389,38 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx periodic send authorization",
+			[]string{
+				grantee.String(),
+				"send",
+				fmt.Sprintf("--%s=100stake", cli.FlagPeriodSpendLimit),
+				fmt.Sprintf("--%s=604800", cli.FlagPeriod),
+				fmt.Sprintf("--%s=50stake", cli.FlagMaxPerTx),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"Invalid tx periodic send authorization without period spend limit",
+			[]string{
+				grantee.String(),
+				"send",
+				fmt.Sprintf("--%s=604800", cli.FlagPeriod),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			true,
+			"period-spend-limit should be greater than zero",
+		},
 		{
 			"Invalid tx send authorization with duplicate allow list",
 			[]string{
@@ -420,6 +452,41 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 			false,
 			"",
 		},
+		{
+			"Valid tx filtered authorization",
+			[]string{
+				grantee.String(),
+				"filtered",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, sdk.MsgTypeURL(&banktypes.MsgSend{})),
+				fmt.Sprintf("--%s=to_address:in:%s", cli.FlagFilter, s.grantee[1]),
+				fmt.Sprintf("--%s=amount.amount:lte:100", cli.FlagFilter),
+				fmt.Sprintf("--%s=5", cli.FlagMaxCalls),
+				fmt.Sprintf("--%s=3600", cli.FlagPeriod),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			false,
+			"",
+		},
+		{
+			"invalid filter operator for tx filtered authorization",
+			[]string{
+				grantee.String(),
+				"filtered",
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, sdk.MsgTypeURL(&banktypes.MsgSend{})),
+				fmt.Sprintf("--%s=amount.amount:lt:100", cli.FlagFilter),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%d", cli.FlagExpiration, twoHours),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+			},
+			true,
+			"invalid filter operator",
+		},
 		{
 			"fail when granter = grantee",
 			[]string{
@@ -469,3 +536,76 @@ func (s *CLITestSuite) TestCLITxGrantAuthorization() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestCLITxRevokeAllAuthorizations() {
+	val := testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+	grantee := s.grantee[0]
+	twoHours := time.Now().Add(time.Minute * 120).Unix()
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			"invalid grantee address",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagGrantee, "grantee_addr"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			true,
+			"invalid separator index",
+		},
+		{
+			"identical grantee and granter",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagGrantee, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+			},
+			true,
+			"grantee and granter should be different",
+		},
+		{
+			"valid tx with all filters",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagGrantee, grantee.String()),
+				fmt.Sprintf("--%s=%s", cli.FlagMsgType, typeMsgVote),
+				fmt.Sprintf("--%s=%d", cli.FlagExpirationBefore, twoHours),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			false,
+			"",
+		},
+		{
+			"valid tx without filters",
+			[]string{
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cli.NewCmdRevokeAllAuthorizations(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err, out)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				var txResp sdk.TxResponse
+				s.Require().NoError(err)
+				s.Require().NoError(s.clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			}
+		})
+	}
+}
@@ -15,10 +15,12 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGrant{}, "cosmos-sdk/MsgGrant")
 	legacy.RegisterAminoMsg(cdc, &MsgRevoke{}, "cosmos-sdk/MsgRevoke")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeAll{}, "cosmos-sdk/MsgRevokeAll")
 	legacy.RegisterAminoMsg(cdc, &MsgExec{}, "cosmos-sdk/MsgExec")
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization", nil)
+	cdc.RegisterConcrete(&FilteredAuthorization{}, "cosmos-sdk/FilteredAuthorization", nil)
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -26,6 +28,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgGrant{},
 		&MsgRevoke{},
+		&MsgRevokeAll{},
 		&MsgExec{},
 	)
 
@@ -37,6 +40,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&FilteredAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
@@ -0,0 +1,328 @@
+package authz
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerFilterValue is the gas consumed for every field value a filter is
+// evaluated against.
+const gasCostPerFilterValue = uint64(10)
+
+var _ Authorization = &FilteredAuthorization{}
+
+// NewFilteredAuthorization creates a new FilteredAuthorization object. The
+// number of executions is limited to maxCalls per period if maxCalls is not zero.
+func NewFilteredAuthorization(msgTypeURL string, filters []MsgFieldFilter, maxCalls uint64, period time.Duration) *FilteredAuthorization {
+	return &FilteredAuthorization{
+		Msg:      msgTypeURL,
+		Filters:  filters,
+		MaxCalls: maxCalls,
+		Period:   period,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a FilteredAuthorization) MsgTypeURL() string {
+	return a.Msg
+}
+
+// Accept implements Authorization.Accept.
+func (a FilteredAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.Msg {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	m, err := protoReflectMsg(msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, f := range a.Filters {
+		if err := f.evaluate(sdkCtx, m); err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("filter on %s: %s", f.Path, err)
+		}
+	}
+
+	if a.MaxCalls == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	if a.Period > 0 {
+		a.tryResetPeriod(sdkCtx.HeaderInfo().Time)
+	}
+
+	if a.Calls >= a.MaxCalls {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("call limit of %d reached", a.MaxCalls)
+	}
+	a.Calls++
+
+	if a.Period == 0 && a.Calls == a.MaxCalls {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// tryResetPeriod resets the number of executed Msgs once PeriodReset has been
+// hit, stepping PeriodReset like feegrant's PeriodicAllowance does.
+func (a *FilteredAuthorization) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	a.Calls = 0
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if blockTime.After(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a FilteredAuthorization) ValidateBasic() error {
+	desc, err := msgDescriptor(a.Msg)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range a.Filters {
+		if err := f.validate(desc); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid filter on %s: %s", f.Path, err)
+		}
+	}
+
+	if a.Period < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period cannot be negative")
+	}
+	if a.Period > 0 && a.MaxCalls == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period requires max calls to be set")
+	}
+	if a.MaxCalls > 0 && a.Calls > a.MaxCalls {
+		return sdkerrors.ErrInvalidRequest.Wrapf("calls %d exceed max calls %d", a.Calls, a.MaxCalls)
+	}
+
+	return nil
+}
+
+// validate checks that the filter operands are well formed and that its path
+// leads to a scalar field of the Msg described by desc.
+func (f MsgFieldFilter) validate(desc protoreflect.MessageDescriptor) error {
+	fd, err := resolvePath(desc, f.Path)
+	if err != nil {
+		return err
+	}
+
+	if len(f.Values) == 0 {
+		return fmt.Errorf("values cannot be empty")
+	}
+
+	switch f.Operator {
+	case FilterOperator_FILTER_OPERATOR_IN:
+		return nil
+	case FilterOperator_FILTER_OPERATOR_EQUAL:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("operator %s expects a single value", f.Operator)
+		}
+		return nil
+	case FilterOperator_FILTER_OPERATOR_LTE, FilterOperator_FILTER_OPERATOR_GTE:
+		if len(f.Values) != 1 {
+			return fmt.Errorf("operator %s expects a single value", f.Operator)
+		}
+		if !isNumericKind(fd.Kind()) {
+			return fmt.Errorf("operator %s expects a numeric field, got %s", f.Operator, fd.Kind())
+		}
+		if _, err := math.LegacyNewDecFromStr(f.Values[0]); err != nil {
+			return fmt.Errorf("invalid numeric value %s: %w", f.Values[0], err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown operator %s", f.Operator)
+	}
+}
+
+// evaluate checks that the field at the filter path of m satisfies the filter.
+func (f MsgFieldFilter) evaluate(ctx sdk.Context, m protoreflect.Message) error {
+	return f.evaluatePath(ctx, m, strings.Split(f.Path, "."))
+}
+
+func (f MsgFieldFilter) evaluatePath(ctx sdk.Context, m protoreflect.Message, path []string) error {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return fmt.Errorf("unknown field %s in %s", path[0], m.Descriptor().FullName())
+	}
+
+	evaluateValue := func(v protoreflect.Value) error {
+		if len(path) > 1 {
+			return f.evaluatePath(ctx, v.Message(), path[1:])
+		}
+		return f.compare(ctx, fd, v)
+	}
+
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		for i := 0; i < list.Len(); i++ {
+			if err := evaluateValue(list.Get(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return evaluateValue(m.Get(fd))
+}
+
+// compare applies the filter operator to the scalar value v of field fd.
+func (f MsgFieldFilter) compare(ctx sdk.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	value := scalarString(fd, v)
+
+	switch f.Operator {
+	case FilterOperator_FILTER_OPERATOR_EQUAL, FilterOperator_FILTER_OPERATOR_IN:
+		for _, allowed := range f.Values {
+			ctx.GasMeter().ConsumeGas(gasCostPerFilterValue, "filtered authorization")
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s is not allowed", value)
+
+	case FilterOperator_FILTER_OPERATOR_LTE, FilterOperator_FILTER_OPERATOR_GTE:
+		ctx.GasMeter().ConsumeGas(gasCostPerFilterValue, "filtered authorization")
+		actual, err := math.LegacyNewDecFromStr(value)
+		if err != nil {
+			return fmt.Errorf("invalid numeric field %s: %w", value, err)
+		}
+		bound, err := math.LegacyNewDecFromStr(f.Values[0])
+		if err != nil {
+			return fmt.Errorf("invalid numeric value %s: %w", f.Values[0], err)
+		}
+
+		if f.Operator == FilterOperator_FILTER_OPERATOR_LTE && actual.GT(bound) {
+			return fmt.Errorf("%s is greater than %s", value, f.Values[0])
+		}
+		if f.Operator == FilterOperator_FILTER_OPERATOR_GTE && actual.LT(bound) {
+			return fmt.Errorf("%s is lower than %s", value, f.Values[0])
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unknown operator %s", f.Operator)
+	}
+}
+
+// resolvePath returns the descriptor of the scalar field at the dot separated
+// path of the message described by desc.
+func resolvePath(desc protoreflect.MessageDescriptor, path string) (protoreflect.FieldDescriptor, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path cannot be empty")
+	}
+
+	names := strings.Split(path, ".")
+	for i, name := range names {
+		fd := desc.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return nil, fmt.Errorf("unknown field %s in %s", name, desc.FullName())
+		}
+		if fd.IsMap() {
+			return nil, fmt.Errorf("map field %s is not supported", name)
+		}
+
+		if i == len(names)-1 {
+			if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+				return nil, fmt.Errorf("field %s is not a scalar", name)
+			}
+			return fd, nil
+		}
+
+		if fd.Kind() != protoreflect.MessageKind {
+			return nil, fmt.Errorf("field %s is not a message", name)
+		}
+		desc = fd.Message()
+	}
+
+	return nil, fmt.Errorf("path cannot be empty")
+}
+
+// isNumericKind reports whether fields of kind k can be compared numerically.
+// String fields are expected to hold math.Int or math.LegacyDec values.
+func isNumericKind(k protoreflect.Kind) bool {
+	switch k {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind,
+		protoreflect.StringKind:
+		return true
+	default:
+		return false
+	}
+}
+
+// scalarString returns the string representation filter values of field fd are
+// given in: enums by name and bytes in base64 as in proto JSON.
+func scalarString(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	default:
+		return v.String()
+	}
+}
+
+// msgDescriptor resolves the descriptor of the Msg with the given type URL.
+func msgDescriptor(msgTypeURL string) (protoreflect.MessageDescriptor, error) {
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msgTypeURL, "/")))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("unknown msg type %s", msgTypeURL)
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("%s is not a message", msgTypeURL)
+	}
+	return md, nil
+}
+
+// protoReflectMsg converts msg to a protoreflect message, decoding gogoproto
+// messages into dynamic messages like the x/tx signing context does.
+func protoReflectMsg(msg sdk.Msg) (protoreflect.Message, error) {
+	if msgV2, ok := msg.(proto.Message); ok {
+		return msgV2.ProtoReflect(), nil
+	}
+
+	desc, err := msgDescriptor(sdk.MsgTypeURL(msg))
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := gogoproto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicMsg := dynamicpb.NewMessage(desc)
+	if err := proto.Unmarshal(bz, dynamicMsg); err != nil {
+		return nil, err
+	}
+	return dynamicMsg, nil
+}
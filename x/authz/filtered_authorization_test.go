@@ -0,0 +1,151 @@
+package authz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFilteredAuthorizationValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	testCases := []struct {
+		name   string
+		auth   *authz.FilteredAuthorization
+		errMsg string
+	}{
+		{
+			"valid",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_IN, Values: []string{"a", "b"}},
+				{Path: "amount.amount", Operator: authz.FilterOperator_FILTER_OPERATOR_LTE, Values: []string{"100"}},
+			}, 2, time.Hour),
+			"",
+		},
+		{"unknown msg", authz.NewFilteredAuthorization("/cosmos.bank.v1beta1.MsgUnknown", nil, 0, 0), "unknown msg type"},
+		{
+			"unknown field",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "recipient", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"a"}},
+			}, 0, 0),
+			"unknown field recipient",
+		},
+		{
+			"message leaf",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "amount", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"a"}},
+			}, 0, 0),
+			"not a scalar",
+		},
+		{
+			"no values",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_IN},
+			}, 0, 0),
+			"values cannot be empty",
+		},
+		{
+			"non numeric bound",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "amount.amount", Operator: authz.FilterOperator_FILTER_OPERATOR_GTE, Values: []string{"ten"}},
+			}, 0, 0),
+			"invalid numeric value",
+		},
+		{
+			"unspecified operator",
+			authz.NewFilteredAuthorization(sendURL, []authz.MsgFieldFilter{
+				{Path: "to_address", Values: []string{"a"}},
+			}, 0, 0),
+			"unknown operator",
+		},
+		{"period without max calls", authz.NewFilteredAuthorization(sendURL, nil, 0, time.Hour), "period requires max calls"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestFilteredAuthorizationAccept(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: now})
+
+	from := sdk.AccAddress("_____from _____")
+	allowed := sdk.AccAddress("_____allowed_____")
+	other := sdk.AccAddress("______other______")
+	send := func(to sdk.AccAddress, amount int64) *banktypes.MsgSend {
+		return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(amount))))
+	}
+
+	filters := []authz.MsgFieldFilter{
+		{Path: "to_address", Operator: authz.FilterOperator_FILTER_OPERATOR_IN, Values: []string{allowed.String()}},
+		{Path: "amount.denom", Operator: authz.FilterOperator_FILTER_OPERATOR_EQUAL, Values: []string{"stake"}},
+		{Path: "amount.amount", Operator: authz.FilterOperator_FILTER_OPERATOR_LTE, Values: []string{"100"}},
+	}
+
+	t.Log("verify filters are enforced")
+	auth := authz.NewFilteredAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), filters, 0, 0)
+	require.NoError(t, auth.ValidateBasic())
+
+	resp, err := auth.Accept(ctx, send(allowed, 100))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	require.Nil(t, resp.Updated)
+
+	_, err = auth.Accept(ctx, send(other, 100))
+	require.ErrorContains(t, err, "is not allowed")
+
+	_, err = auth.Accept(ctx, send(allowed, 101))
+	require.ErrorContains(t, err, "is greater than 100")
+
+	_, err = auth.Accept(ctx, banktypes.NewMsgSend(from, allowed, sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(1)))))
+	require.ErrorContains(t, err, "atom is not allowed")
+
+	_, err = auth.Accept(ctx, &banktypes.MsgMultiSend{})
+	require.ErrorContains(t, err, "type mismatch")
+
+	t.Log("verify calls are limited per period")
+	auth = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), filters, 2, time.Hour)
+	for i := 0; i < 2; i++ {
+		resp, err = auth.Accept(ctx, send(allowed, 1))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		auth = resp.Updated.(*authz.FilteredAuthorization)
+	}
+	require.Equal(t, uint64(2), auth.Calls)
+	require.Equal(t, now.Add(time.Hour), auth.PeriodReset)
+
+	_, err = auth.Accept(ctx, send(allowed, 1))
+	require.ErrorContains(t, err, "call limit of 2 reached")
+
+	resp, err = auth.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)}), send(allowed, 1))
+	require.NoError(t, err)
+	auth = resp.Updated.(*authz.FilteredAuthorization)
+	require.Equal(t, uint64(1), auth.Calls)
+	require.Equal(t, now.Add(2*time.Hour), auth.PeriodReset)
+
+	t.Log("verify the grant is deleted once a lifetime call limit is reached")
+	auth = authz.NewFilteredAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{}), nil, 1, 0)
+	resp, err = auth.Accept(ctx, send(other, 1000))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+}
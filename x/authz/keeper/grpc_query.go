@@ -12,6 +12,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -192,3 +193,117 @@ func (k Keeper) GranteeGrants(ctx context.Context, req *authz.QueryGranteeGrants
 		Pagination: pageRes,
 	}, nil
 }
+
+// ExpiringGrants implements the Query/ExpiringGrants gRPC method.
+// It walks the grant queue, which is ordered by expiration, so pagination applies
+// to grant queue entries: the grants of a granter-grantee pair expiring at the
+// same time are always returned in the same page.
+func (k Keeper) ExpiringGrants(ctx context.Context, req *authz.QueryExpiringGrantsRequest) (*authz.QueryExpiringGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ExpirationBefore.IsZero() {
+		return nil, status.Error(codes.InvalidArgument, "expiration before cannot be empty")
+	}
+
+	var granter, grantee sdk.AccAddress
+	var err error
+	if req.Granter != "" {
+		if granter, err = k.authKeeper.AddressCodec().StringToBytes(req.Granter); err != nil {
+			return nil, err
+		}
+	}
+	if req.Grantee != "" {
+		if grantee, err = k.authKeeper.AddressCodec().StringToBytes(req.Grantee); err != nil {
+			return nil, err
+		}
+	}
+
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return nil, status.Error(codes.InvalidArgument, "reverse pagination is not supported")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), GrantQueuePrefix)
+	iter := store.Iterator(pageReq.Key, sdk.FormatTimeBytes(req.ExpirationBefore))
+	defer iter.Close()
+
+	var (
+		grants  []*authz.GrantAuthorization
+		count   uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		_, entryGranter, entryGrantee, err := parseGrantQueueKey(append(append([]byte{}, GrantQueuePrefix...), iter.Key()...))
+		if err != nil {
+			return nil, err
+		}
+		if (granter != nil && !granter.Equals(entryGranter)) || (grantee != nil && !grantee.Equals(entryGrantee)) {
+			continue
+		}
+
+		count++
+		if count <= pageReq.Offset {
+			continue
+		}
+		if count > pageReq.Offset+limit {
+			if nextKey == nil {
+				nextKey = bytes.Clone(iter.Key())
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iter.Value(), &queueItem); err != nil {
+			return nil, err
+		}
+
+		granterAddr, err := k.authKeeper.AddressCodec().BytesToString(entryGranter)
+		if err != nil {
+			return nil, err
+		}
+		granteeAddr, err := k.authKeeper.AddressCodec().BytesToString(entryGrantee)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			grant, found := k.getGrant(ctx, grantStoreKey(entryGrantee, entryGranter, typeURL))
+			if !found {
+				continue
+			}
+
+			grants = append(grants, &authz.GrantAuthorization{
+				Granter:       granterAddr,
+				Grantee:       granteeAddr,
+				Authorization: grant.Authorization,
+				Expiration:    grant.Expiration,
+			})
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return &authz.QueryExpiringGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
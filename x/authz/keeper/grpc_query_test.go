@@ -276,6 +276,108 @@ func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
 	}
 }
 
+func (suite *TestSuite) TestGRPCQueryExpiringGrants() {
+	require := suite.Require()
+	queryClient, addrs := suite.queryClient, suite.addrs
+	now := suite.ctx.HeaderInfo().Time
+
+	saveGrant := func(grantee, granter sdk.AccAddress, msgType string, expiration *time.Time) {
+		err := suite.authzKeeper.SaveGrant(suite.ctx, grantee, granter, authz.NewGenericAuthorization(msgType), expiration)
+		require.NoError(err)
+	}
+	inOneHour, inTwoHours, inThreeHours := now.Add(time.Hour), now.Add(2*time.Hour), now.Add(3*time.Hour)
+	saveGrant(addrs[1], addrs[0], bankSendAuthMsgType, &inTwoHours)
+	saveGrant(addrs[1], addrs[0], "/cosmos.gov.v1.MsgVote", &inTwoHours)
+	saveGrant(addrs[2], addrs[0], bankSendAuthMsgType, &inOneHour)
+	saveGrant(addrs[1], addrs[3], bankSendAuthMsgType, &inThreeHours)
+	saveGrant(addrs[2], addrs[3], bankSendAuthMsgType, nil)
+
+	testCases := []struct {
+		msg         string
+		request     authz.QueryExpiringGrantsRequest
+		expError    string
+		expGrantees []sdk.AccAddress
+		expNextKey  bool
+	}{
+		{
+			msg:      "fail invalid granter addr",
+			request:  authz.QueryExpiringGrantsRequest{ExpirationBefore: inThreeHours, Granter: "invalid"},
+			expError: "invalid bech32 string",
+		},
+		{
+			msg:      "fail empty expiration before",
+			request:  authz.QueryExpiringGrantsRequest{Granter: addrs[0].String()},
+			expError: "expiration before cannot be empty",
+		},
+		{
+			msg:     "no grant expiring before",
+			request: authz.QueryExpiringGrantsRequest{ExpirationBefore: inOneHour},
+		},
+		{
+			msg:         "ordered by expiration",
+			request:     authz.QueryExpiringGrantsRequest{ExpirationBefore: inThreeHours},
+			expGrantees: []sdk.AccAddress{addrs[2], addrs[1], addrs[1]},
+		},
+		{
+			msg:         "granter and grantee filters",
+			request:     authz.QueryExpiringGrantsRequest{ExpirationBefore: inThreeHours.Add(time.Second), Granter: addrs[3].String(), Grantee: addrs[1].String()},
+			expGrantees: []sdk.AccAddress{addrs[1]},
+		},
+		{
+			msg: "pagination keeps the grants of a queue entry together",
+			request: authz.QueryExpiringGrantsRequest{
+				ExpirationBefore: inThreeHours.Add(time.Second),
+				Pagination:       &query.PageRequest{Offset: 1, Limit: 1},
+			},
+			expGrantees: []sdk.AccAddress{addrs[1], addrs[1]},
+			expNextKey:  true,
+		},
+		{
+			msg: "fail reverse pagination",
+			request: authz.QueryExpiringGrantsRequest{
+				ExpirationBefore: inThreeHours,
+				Pagination:       &query.PageRequest{Reverse: true},
+			},
+			expError: "reverse pagination is not supported",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			result, err := queryClient.ExpiringGrants(gocontext.Background(), &tc.request)
+			if tc.expError != "" {
+				require.ErrorContains(err, tc.expError)
+				return
+			}
+			require.NoError(err)
+			require.Len(result.Grants, len(tc.expGrantees))
+			for i, grant := range result.Grants {
+				require.Equal(tc.expGrantees[i].String(), grant.Grantee)
+				require.True(grant.Expiration.Before(tc.request.ExpirationBefore))
+			}
+			require.Equal(tc.expNextKey, result.Pagination.NextKey != nil)
+		})
+	}
+
+	suite.Run("Case next key", func() {
+		req := &authz.QueryExpiringGrantsRequest{
+			ExpirationBefore: inThreeHours.Add(time.Second),
+			Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+		}
+		result, err := queryClient.ExpiringGrants(gocontext.Background(), req)
+		require.NoError(err)
+		require.Len(result.Grants, 3)
+		require.Equal(uint64(3), result.Pagination.Total)
+
+		req.Pagination = &query.PageRequest{Key: result.Pagination.NextKey}
+		result, err = queryClient.ExpiringGrants(gocontext.Background(), req)
+		require.NoError(err)
+		require.Len(result.Grants, 1)
+		require.Equal(addrs[3].String(), result.Grants[0].Granter)
+		require.Nil(result.Pagination.NextKey)
+	})
+}
+
 func (suite *TestSuite) createSendAuthorization(grantee, granter sdk.AccAddress) authz.Authorization {
 	exp := suite.ctx.HeaderInfo().Time.Add(time.Hour)
 	newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
@@ -20,6 +20,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
@@ -259,6 +260,112 @@ func (k Keeper) DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress
 	})
 }
 
+// DeleteGrants revokes all grants of the granter matching the given filters
+// and returns the number of revoked grants. An empty grantee or msgType matches
+// any grant, a nil expirationBefore matches grants regardless of their
+// expiration, otherwise only grants expiring before it are revoked.
+func (k Keeper) DeleteGrants(ctx context.Context, granter, grantee sdk.AccAddress, msgType string, expirationBefore *time.Time) (uint64, error) {
+	var (
+		keys [][]byte
+		err  error
+	)
+	if expirationBefore != nil {
+		keys, err = k.expiringGrantKeys(ctx, granter, grantee, msgType, *expirationBefore)
+	} else {
+		keys, err = k.granterGrantKeys(ctx, granter, grantee, msgType)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		_, granteeAddr, typeURL := parseGrantStoreKey(key)
+		if err := k.DeleteGrant(ctx, granteeAddr, granter, typeURL); err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(keys)), nil
+}
+
+// granterGrantKeys returns the store keys of the grants of the granter, and of
+// the grantee if set, for msgType if set.
+func (k Keeper) granterGrantKeys(ctx context.Context, granter, grantee sdk.AccAddress, msgType string) ([][]byte, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// grants of a granter, and of a granter-grantee pair, share a key prefix
+	keyPrefix := sdk.AppendLengthPrefixedBytes(GrantKey, address.MustLengthPrefix(granter))
+	if len(grantee) > 0 {
+		keyPrefix = grantStoreKey(grantee, granter, "")
+	}
+
+	iter := storetypes.KVStorePrefixIterator(store, keyPrefix)
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "revoke grants")
+
+		_, _, typeURL := parseGrantStoreKey(iter.Key())
+		if msgType != "" && typeURL != msgType {
+			continue
+		}
+
+		keys = append(keys, bytes.Clone(iter.Key()))
+	}
+
+	return keys, nil
+}
+
+// expiringGrantKeys returns the store keys of the grants of the granter, and of
+// the grantee and msgType if set, expiring before expirationBefore. It walks the
+// grant queue from the block time on: grants that already expired can't be
+// executed anymore and are pruned by the begin blocker.
+func (k Keeper) expiringGrantKeys(ctx context.Context, granter, grantee sdk.AccAddress, msgType string, expirationBefore time.Time) ([][]byte, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	start := GrantQueueTimePrefix(sdkCtx.HeaderInfo().Time)
+	end := GrantQueueTimePrefix(expirationBefore)
+	if bytes.Compare(start, end) >= 0 {
+		return nil, nil
+	}
+
+	iter, err := store.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "revoke grants")
+
+		_, entryGranter, entryGrantee, err := parseGrantQueueKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if !granter.Equals(entryGranter) || (len(grantee) > 0 && !grantee.Equals(entryGrantee)) {
+			continue
+		}
+
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iter.Value(), &queueItem); err != nil {
+			return nil, err
+		}
+
+		for _, typeURL := range queueItem.MsgTypeUrls {
+			if msgType != "" && typeURL != msgType {
+				continue
+			}
+			keys = append(keys, grantStoreKey(entryGrantee, entryGranter, typeURL))
+		}
+	}
+
+	return keys, nil
+}
+
 // GetAuthorizations Returns list of `Authorizations` granted to the grantee by the granter.
 func (k Keeper) GetAuthorizations(ctx context.Context, grantee, granter sdk.AccAddress) ([]authz.Authorization, error) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
@@ -356,6 +356,44 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchPeriodicSendAction() {
+	require := s.Require()
+	granterAddr, granteeAddr, recipientAddr := s.addrs[0], s.addrs[1], s.addrs[2]
+	now := s.ctx.HeaderInfo().Time
+	expiration := now.Add(24 * time.Hour)
+
+	a := banktypes.NewPeriodicSendAuthorization(nil, coins10, time.Hour, nil, nil)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, &expiration))
+
+	send := []sdk.Msg{&banktypes.MsgSend{
+		Amount:      coins10,
+		FromAddress: granterAddr.String(),
+		ToAddress:   recipientAddr.String(),
+	}}
+	_, err := s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send)
+	require.NoError(err)
+
+	// the spent period limit is surfaced through the grants query
+	res, err := s.queryClient.Grants(s.ctx, &authz.QueryGrantsRequest{
+		Granter:    granterAddr.String(),
+		Grantee:    granteeAddr.String(),
+		MsgTypeUrl: bankSendAuthMsgType,
+	})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	var updated authz.Authorization
+	require.NoError(s.encCfg.InterfaceRegistry.UnpackAny(res.Grants[0].Authorization, &updated))
+	require.True(updated.(*banktypes.SendAuthorization).PeriodCanSpend.IsZero())
+	require.Equal(now.Add(time.Hour), *updated.(*banktypes.SendAuthorization).PeriodReset)
+
+	_, err = s.authzKeeper.DispatchActions(s.ctx, granteeAddr, send)
+	require.ErrorContains(err, "requested amount is more than period spend limit")
+
+	ctx := s.ctx.WithHeaderInfo(header.Info{Time: now.Add(time.Hour)})
+	_, err = s.authzKeeper.DispatchActions(ctx, granteeAddr, send)
+	require.NoError(err)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
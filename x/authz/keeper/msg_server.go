@@ -86,6 +86,33 @@ func (k Keeper) Revoke(ctx context.Context, msg *authz.MsgRevoke) (*authz.MsgRev
 	return &authz.MsgRevokeResponse{}, nil
 }
 
+// RevokeAll implements the MsgServer.RevokeAll method.
+func (k Keeper) RevokeAll(ctx context.Context, msg *authz.MsgRevokeAll) (*authz.MsgRevokeAllResponse, error) {
+	granter, err := k.authKeeper.AddressCodec().StringToBytes(msg.Granter)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+
+	var grantee []byte
+	if msg.Grantee != "" {
+		if strings.EqualFold(msg.Grantee, msg.Granter) {
+			return nil, authz.ErrGranteeIsGranter
+		}
+
+		grantee, err = k.authKeeper.AddressCodec().StringToBytes(msg.Grantee)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+		}
+	}
+
+	revoked, err := k.DeleteGrants(ctx, granter, grantee, msg.MsgTypeUrl, msg.ExpirationBefore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.MsgRevokeAllResponse{Revoked: revoked}, nil
+}
+
 // Exec implements the MsgServer.Exec method.
 func (k Keeper) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
 	if msg.Grantee == "" {
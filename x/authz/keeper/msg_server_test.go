@@ -305,6 +305,100 @@ func (suite *TestSuite) TestRevoke() {
 	}
 }
 
+func (suite *TestSuite) TestRevokeAll() {
+	require := suite.Require()
+	granter, grantee1, grantee2, otherGranter := suite.addrs[0], suite.addrs[1], suite.addrs[2], suite.addrs[3]
+	now := suite.ctx.HeaderInfo().Time
+	inOneHour, inTwoHours := now.Add(time.Hour), now.Add(2*time.Hour)
+	voteMsgType := "/cosmos.gov.v1.MsgVote"
+
+	sendAuth := banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("steak", 100)), nil)
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee1, granter, sendAuth, &inOneHour))
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee1, granter, authz.NewGenericAuthorization(voteMsgType), &inTwoHours))
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee2, granter, sendAuth, nil))
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee2, granter, authz.NewGenericAuthorization(voteMsgType), &inOneHour))
+	require.NoError(suite.authzKeeper.SaveGrant(suite.ctx, grantee1, otherGranter, sendAuth, &inOneHour))
+
+	testCases := []struct {
+		name       string
+		msg        *authz.MsgRevokeAll
+		expRevoked uint64
+		errMsg     string
+		ctx        sdk.Context
+	}{
+		{
+			name:   "invalid granter",
+			msg:    &authz.MsgRevokeAll{Granter: "invalid"},
+			errMsg: "invalid bech32 string",
+		},
+		{
+			name:   "invalid grantee",
+			msg:    &authz.MsgRevokeAll{Granter: granter.String(), Grantee: "invalid"},
+			errMsg: "invalid bech32 string",
+		},
+		{
+			name:   "identical grantee and granter",
+			msg:    authz.NewMsgRevokeAll(granter, granter, "", nil),
+			errMsg: "grantee and granter should be different",
+		},
+		{
+			name:       "no grant expiring before",
+			msg:        authz.NewMsgRevokeAll(granter, nil, "", &now),
+			expRevoked: 0,
+		},
+		{
+			name:       "expired grants are left to the begin blocker",
+			msg:        authz.NewMsgRevokeAll(granter, nil, "", &inTwoHours),
+			expRevoked: 0,
+			ctx:        suite.ctx.WithHeaderInfo(header.Info{Time: inTwoHours}),
+		},
+		{
+			name:       "grantee and msg type",
+			msg:        authz.NewMsgRevokeAll(granter, grantee1, voteMsgType, nil),
+			expRevoked: 1,
+		},
+		{
+			name:       "expiring before",
+			msg:        authz.NewMsgRevokeAll(granter, nil, "", &inTwoHours),
+			expRevoked: 2,
+		},
+		{
+			name:       "all remaining grants",
+			msg:        authz.NewMsgRevokeAll(granter, nil, "", nil),
+			expRevoked: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx := suite.ctx
+			if tc.ctx.Context() != nil {
+				ctx = tc.ctx
+			}
+			res, err := suite.msgSrvr.RevokeAll(ctx, tc.msg)
+			if tc.errMsg != "" {
+				require.ErrorContains(err, tc.errMsg)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expRevoked, res.Revoked)
+		})
+	}
+
+	var grants int
+	suite.authzKeeper.IterateGrants(suite.ctx, func(granterAddr, _ sdk.AccAddress, _ authz.Grant) bool {
+		require.Equal(otherGranter, granterAddr)
+		grants++
+		return false
+	})
+	require.Equal(1, grants)
+
+	res, err := suite.queryClient.ExpiringGrants(suite.ctx, &authz.QueryExpiringGrantsRequest{ExpirationBefore: inTwoHours})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	require.Equal(otherGranter.String(), res.Grants[0].Granter)
+}
+
 func (suite *TestSuite) TestExec() {
 	addrs := suite.createAccounts(2)
 
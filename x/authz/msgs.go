@@ -13,6 +13,7 @@ import (
 var (
 	_ sdk.Msg = &MsgGrant{}
 	_ sdk.Msg = &MsgRevoke{}
+	_ sdk.Msg = &MsgRevokeAll{}
 	_ sdk.Msg = &MsgExec{}
 
 	_ cdctypes.UnpackInterfacesMessage = &MsgGrant{}
@@ -79,6 +80,20 @@ func NewMsgRevoke(granter, grantee sdk.AccAddress, msgTypeURL string) MsgRevoke
 	}
 }
 
+// NewMsgRevokeAll creates a new MsgRevokeAll. An empty grantee or msgTypeURL
+// and a nil expirationBefore match any grant of the granter.
+func NewMsgRevokeAll(granter, grantee sdk.AccAddress, msgTypeURL string, expirationBefore *time.Time) *MsgRevokeAll {
+	m := &MsgRevokeAll{
+		Granter:          granter.String(),
+		MsgTypeUrl:       msgTypeURL,
+		ExpirationBefore: expirationBefore,
+	}
+	if !grantee.Empty() {
+		m.Grantee = grantee.String()
+	}
+	return m
+}
+
 // NewMsgExec creates a new MsgExecAuthorized
 func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
 	msgsAny := make([]*cdctypes.Any, len(msgs))
@@ -13,18 +13,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -270,6 +274,91 @@ func (m *MsgRevokeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRevokeResponse proto.InternalMessageInfo
 
+// MsgRevokeAll revokes all authorizations on the granter's account matching
+// every provided filter. Filters left empty match any grant.
+type MsgRevokeAll struct {
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee, when set, only revokes authorizations granted to the grantee.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	// msg_type_url, when set, only revokes authorizations for the given msg type.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// expiration_before, when set, only revokes authorizations expiring before
+	// the given time. Authorizations without an expiration never match it.
+	ExpirationBefore *time.Time `protobuf:"bytes,4,opt,name=expiration_before,json=expirationBefore,proto3,stdtime" json:"expiration_before,omitempty"`
+}
+
+func (m *MsgRevokeAll) Reset()         { *m = MsgRevokeAll{} }
+func (m *MsgRevokeAll) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAll) ProtoMessage()    {}
+func (*MsgRevokeAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{6}
+}
+func (m *MsgRevokeAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAll.Merge(m, src)
+}
+func (m *MsgRevokeAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAll proto.InternalMessageInfo
+
+// MsgRevokeAllResponse defines the Msg/MsgRevokeAllResponse response type.
+type MsgRevokeAllResponse struct {
+	// revoked is the number of revoked authorizations.
+	Revoked uint64 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+}
+
+func (m *MsgRevokeAllResponse) Reset()         { *m = MsgRevokeAllResponse{} }
+func (m *MsgRevokeAllResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAllResponse) ProtoMessage()    {}
+func (*MsgRevokeAllResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ceddab7d8589ad1, []int{7}
+}
+func (m *MsgRevokeAllResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAllResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAllResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAllResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAllResponse.Merge(m, src)
+}
+func (m *MsgRevokeAllResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAllResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAllResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAllResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGrant)(nil), "cosmos.authz.v1beta1.MsgGrant")
 	proto.RegisterType((*MsgGrantResponse)(nil), "cosmos.authz.v1beta1.MsgGrantResponse")
@@ -277,47 +366,56 @@ func init() {
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.authz.v1beta1.MsgExecResponse")
 	proto.RegisterType((*MsgRevoke)(nil), "cosmos.authz.v1beta1.MsgRevoke")
 	proto.RegisterType((*MsgRevokeResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeResponse")
+	proto.RegisterType((*MsgRevokeAll)(nil), "cosmos.authz.v1beta1.MsgRevokeAll")
+	proto.RegisterType((*MsgRevokeAllResponse)(nil), "cosmos.authz.v1beta1.MsgRevokeAllResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/tx.proto", fileDescriptor_3ceddab7d8589ad1) }
 
 var fileDescriptor_3ceddab7d8589ad1 = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbf, 0x6e, 0x13, 0x4f,
-	0x10, 0xf6, 0xc6, 0x71, 0xfc, 0xf3, 0x26, 0xd2, 0x8f, 0x5c, 0x2c, 0x71, 0xb9, 0x28, 0x97, 0xd3,
-	0x91, 0x80, 0x65, 0xe4, 0x5d, 0xd9, 0x74, 0x16, 0x4d, 0x2c, 0x45, 0x34, 0x58, 0x48, 0x07, 0x34,
-	0x34, 0xd6, 0xd9, 0x5e, 0x36, 0x56, 0x7c, 0xb7, 0xd6, 0xed, 0xd9, 0xb2, 0xa9, 0x10, 0x25, 0x15,
-	0x8f, 0x01, 0x9d, 0x8b, 0x94, 0x3c, 0x80, 0x45, 0x15, 0x51, 0x20, 0x2a, 0x04, 0x76, 0xe1, 0xc7,
-	0x00, 0xdd, 0xfe, 0x31, 0x0e, 0x72, 0x42, 0x2a, 0x9a, 0xbb, 0x99, 0xf9, 0xbe, 0xd9, 0x9d, 0x6f,
-	0x66, 0xb4, 0x70, 0xbf, 0xc5, 0x78, 0xc0, 0x38, 0xf6, 0xfb, 0xf1, 0xe9, 0x2b, 0x3c, 0x28, 0x37,
-	0x49, 0xec, 0x97, 0x71, 0x3c, 0x44, 0xbd, 0x88, 0xc5, 0xcc, 0xc8, 0x4b, 0x18, 0x09, 0x18, 0x29,
-	0xd8, 0xda, 0x95, 0xd1, 0x86, 0xe0, 0x60, 0x45, 0x11, 0x8e, 0x95, 0xa7, 0x8c, 0x32, 0x19, 0x4f,
-	0x2c, 0x15, 0xdd, 0xa5, 0x8c, 0xd1, 0x2e, 0xc1, 0xc2, 0x6b, 0xf6, 0x5f, 0x62, 0x3f, 0x1c, 0x29,
-	0xc8, 0x59, 0x59, 0x80, 0xbc, 0x4f, 0x32, 0x6e, 0x2b, 0x46, 0xc0, 0x29, 0x1e, 0x94, 0x93, 0x9f,
-	0x02, 0xb6, 0xfd, 0xa0, 0x13, 0x32, 0x2c, 0xbe, 0x32, 0xe4, 0x7e, 0x01, 0xf0, 0xbf, 0x3a, 0xa7,
-	0x8f, 0x22, 0x3f, 0x8c, 0x8d, 0x0a, 0xcc, 0xd2, 0xc4, 0x20, 0x91, 0x09, 0x1c, 0x50, 0xc8, 0xd5,
-	0xcc, 0xcf, 0xe7, 0x25, 0xad, 0xe8, 0xb8, 0xdd, 0x8e, 0x08, 0xe7, 0x4f, 0xe3, 0xa8, 0x13, 0x52,
-	0x4f, 0x13, 0x7f, 0xe7, 0x10, 0x73, 0xed, 0x66, 0x39, 0xc4, 0x78, 0x08, 0x33, 0xc2, 0x34, 0xd3,
-	0x0e, 0x28, 0x6c, 0x56, 0xf6, 0xd0, 0xaa, 0xa6, 0x21, 0x51, 0x53, 0x2d, 0x37, 0xf9, 0x76, 0x90,
-	0x7a, 0x3f, 0x1f, 0x17, 0x81, 0x27, 0x93, 0xaa, 0x87, 0x6f, 0xe6, 0xe3, 0xa2, 0xbe, 0xff, 0xed,
-	0x7c, 0x5c, 0xdc, 0x91, 0xe9, 0x25, 0xde, 0x3e, 0xc3, 0x5a, 0x8b, 0x6b, 0xc0, 0x5b, 0xda, 0xf6,
-	0x08, 0xef, 0xb1, 0x90, 0x13, 0xf7, 0x03, 0x80, 0xd9, 0x3a, 0xa7, 0x27, 0x43, 0xd2, 0x5a, 0xae,
-	0x1b, 0xdc, 0xb4, 0xee, 0x13, 0xb8, 0x1e, 0x70, 0xca, 0xcd, 0x35, 0x27, 0x5d, 0xd8, 0xac, 0xe4,
-	0x91, 0x1c, 0x12, 0xd2, 0x43, 0x42, 0xc7, 0xe1, 0xa8, 0xb6, 0xf7, 0xe9, 0xbc, 0xa4, 0x06, 0x80,
-	0x9a, 0x3e, 0x27, 0x0b, 0x39, 0x75, 0x4e, 0x3d, 0x91, 0x5e, 0xbd, 0xb3, 0x24, 0x80, 0x24, 0x02,
-	0x8c, 0xcb, 0x02, 0x92, 0xfa, 0xdc, 0xfb, 0xf0, 0x7f, 0x65, 0xea, 0xf2, 0x0d, 0x13, 0x66, 0x23,
-	0xc2, 0xfb, 0xdd, 0x98, 0x9b, 0xc0, 0x49, 0x17, 0xb6, 0x3c, 0xed, 0xba, 0x1f, 0x01, 0xcc, 0x25,
-	0xe7, 0x93, 0x01, 0x3b, 0x23, 0xff, 0x6c, 0x8c, 0x0e, 0xdc, 0x0a, 0x38, 0x6d, 0xc4, 0xa3, 0x1e,
-	0x69, 0xf4, 0xa3, 0xae, 0x98, 0x66, 0xce, 0x83, 0x01, 0xa7, 0xcf, 0x46, 0x3d, 0xf2, 0x3c, 0xea,
-	0x56, 0x8f, 0xfe, 0x1c, 0x55, 0xfe, 0xb2, 0x52, 0x59, 0xb0, 0xbb, 0x03, 0xb7, 0x17, 0x8e, 0x56,
-	0x5b, 0xf9, 0x09, 0x60, 0xba, 0xce, 0xa9, 0xf1, 0x04, 0x66, 0xe4, 0x76, 0xda, 0xab, 0xd7, 0x44,
-	0x4f, 0xd9, 0xba, 0x7b, 0x3d, 0xbe, 0x68, 0xe3, 0x63, 0xb8, 0x2e, 0x36, 0x60, 0xff, 0x4a, 0x7e,
-	0x02, 0x5b, 0x47, 0xd7, 0xc2, 0x8b, 0xd3, 0x3c, 0xb8, 0xa1, 0xda, 0x7e, 0x70, 0x65, 0x82, 0x24,
-	0x58, 0xf7, 0xfe, 0x42, 0xd0, 0x67, 0x5a, 0x99, 0xd7, 0xc9, 0xbe, 0xd7, 0x6a, 0x93, 0x1f, 0x76,
-	0x6a, 0x32, 0xb5, 0xc1, 0xc5, 0xd4, 0x06, 0xdf, 0xa7, 0x36, 0x78, 0x37, 0xb3, 0x53, 0x17, 0x33,
-	0x3b, 0xf5, 0x75, 0x66, 0xa7, 0x5e, 0x1c, 0xd2, 0x4e, 0x7c, 0xda, 0x6f, 0xa2, 0x16, 0x0b, 0xd4,
-	0x8b, 0x82, 0x97, 0x9a, 0x3b, 0x94, 0x2f, 0x42, 0x73, 0x43, 0x2c, 0xe7, 0x83, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x13, 0x38, 0x6d, 0x34, 0xb7, 0x04, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x4d, 0x4f, 0xdb, 0x4c,
+	0x10, 0xce, 0x26, 0x01, 0xde, 0x2c, 0x48, 0x2f, 0x98, 0x48, 0x18, 0x23, 0x9c, 0xc8, 0x85, 0x36,
+	0x4a, 0x85, 0x5d, 0xd2, 0x5b, 0xd4, 0x4b, 0x2c, 0xa1, 0x5e, 0x1a, 0x55, 0x72, 0xe9, 0xa5, 0x3d,
+	0x44, 0x0e, 0x59, 0x16, 0x0b, 0xdb, 0x6b, 0x79, 0x37, 0x28, 0xe9, 0xa9, 0xea, 0xb1, 0x27, 0xfa,
+	0x2f, 0xda, 0x1b, 0x07, 0x8e, 0xfd, 0x01, 0xa8, 0x27, 0xd4, 0x43, 0xd5, 0x43, 0xd5, 0x0f, 0x38,
+	0xf0, 0x37, 0x2a, 0xef, 0x7a, 0x4d, 0xa0, 0xe1, 0xe3, 0x54, 0xf5, 0x92, 0xcc, 0xcc, 0xf3, 0x3c,
+	0xeb, 0x99, 0x9d, 0x19, 0x1b, 0x2e, 0x6f, 0x11, 0x1a, 0x10, 0x6a, 0xb9, 0x7d, 0xb6, 0xf3, 0xca,
+	0xda, 0x5b, 0xef, 0x22, 0xe6, 0xae, 0x5b, 0x6c, 0x60, 0x46, 0x31, 0x61, 0x44, 0x29, 0x0b, 0xd8,
+	0xe4, 0xb0, 0x99, 0xc2, 0xda, 0xa2, 0x88, 0x76, 0x38, 0xc7, 0x4a, 0x29, 0xdc, 0xd1, 0xca, 0x98,
+	0x60, 0x22, 0xe2, 0x89, 0x95, 0x46, 0x17, 0x31, 0x21, 0xd8, 0x47, 0x16, 0xf7, 0xba, 0xfd, 0x6d,
+	0xcb, 0x0d, 0x87, 0x29, 0x54, 0xb9, 0x0c, 0x31, 0x2f, 0x40, 0x94, 0xb9, 0x41, 0x94, 0x12, 0xaa,
+	0x63, 0x33, 0x14, 0x09, 0x09, 0xc6, 0x42, 0xca, 0x08, 0x28, 0xb6, 0xf6, 0xd6, 0x93, 0xbf, 0x14,
+	0x98, 0x73, 0x03, 0x2f, 0x24, 0x16, 0xff, 0x15, 0x21, 0xe3, 0x0b, 0x80, 0xff, 0xb5, 0x29, 0x7e,
+	0x1c, 0xbb, 0x21, 0x53, 0x1a, 0x70, 0x0a, 0x27, 0x06, 0x8a, 0x55, 0x50, 0x05, 0xb5, 0x92, 0xad,
+	0x7e, 0x3e, 0x5c, 0x93, 0x25, 0xb7, 0x7a, 0xbd, 0x18, 0x51, 0xfa, 0x8c, 0xc5, 0x5e, 0x88, 0x1d,
+	0x49, 0x3c, 0xd7, 0x20, 0x35, 0x7f, 0x3b, 0x0d, 0x52, 0x1e, 0xc1, 0x09, 0x6e, 0xaa, 0x85, 0x2a,
+	0xa8, 0x4d, 0x37, 0x96, 0xcc, 0x71, 0xb7, 0x6a, 0xf2, 0x9c, 0xec, 0xd2, 0xd1, 0xf7, 0x4a, 0xee,
+	0xfd, 0xd9, 0x41, 0x1d, 0x38, 0x42, 0xd4, 0x5c, 0x79, 0x73, 0x76, 0x50, 0x97, 0xcf, 0x7f, 0x7b,
+	0x76, 0x50, 0x9f, 0x17, 0xf2, 0x35, 0xda, 0xdb, 0xb5, 0x64, 0x2d, 0x86, 0x02, 0x67, 0xa5, 0xed,
+	0x20, 0x1a, 0x91, 0x90, 0x22, 0xe3, 0x03, 0x80, 0x53, 0x6d, 0x8a, 0x37, 0x06, 0x68, 0x6b, 0x34,
+	0x6f, 0x70, 0xdb, 0xbc, 0x37, 0x60, 0x31, 0xa0, 0x98, 0xaa, 0xf9, 0x6a, 0xa1, 0x36, 0xdd, 0x28,
+	0x9b, 0xa2, 0x55, 0xa6, 0x6c, 0x95, 0xd9, 0x0a, 0x87, 0xf6, 0xd2, 0xa7, 0xc3, 0xb5, 0xb4, 0x01,
+	0x66, 0xd7, 0xa5, 0x28, 0x2b, 0xa7, 0x4d, 0xb1, 0xc3, 0xe5, 0xcd, 0x3b, 0x23, 0x05, 0xa0, 0xa4,
+	0x00, 0xe5, 0x62, 0x01, 0x49, 0x7e, 0xc6, 0x7d, 0xf8, 0x7f, 0x6a, 0xca, 0xf4, 0x15, 0x15, 0x4e,
+	0xc5, 0x88, 0xf6, 0x7d, 0x46, 0x55, 0x50, 0x2d, 0xd4, 0x66, 0x1c, 0xe9, 0x1a, 0x1f, 0x01, 0x2c,
+	0x25, 0xe7, 0xa3, 0x3d, 0xb2, 0x8b, 0xfe, 0x5a, 0x1b, 0xab, 0x70, 0x26, 0xa0, 0xb8, 0xc3, 0x86,
+	0x11, 0xea, 0xf4, 0x63, 0x9f, 0x77, 0xb3, 0xe4, 0xc0, 0x80, 0xe2, 0xcd, 0x61, 0x84, 0x9e, 0xc7,
+	0x7e, 0x73, 0xf5, 0x72, 0xab, 0xca, 0x17, 0x2b, 0x15, 0x09, 0x1b, 0xf3, 0x70, 0x2e, 0x73, 0xb2,
+	0x66, 0xbd, 0xcb, 0xc3, 0x99, 0x2c, 0xda, 0xf2, 0xfd, 0x7f, 0xa7, 0x2c, 0xa5, 0x0d, 0xe7, 0xd0,
+	0x20, 0xf2, 0x62, 0x97, 0x79, 0x24, 0xec, 0x74, 0xd1, 0x36, 0x89, 0x91, 0x5a, 0xe4, 0xb3, 0xac,
+	0xfd, 0x31, 0x14, 0x9b, 0x72, 0x7f, 0xed, 0xe2, 0xfe, 0x8f, 0x0a, 0x70, 0x66, 0xcf, 0xa5, 0x36,
+	0x57, 0x36, 0x6b, 0x97, 0x6f, 0x69, 0x61, 0xdc, 0x2d, 0xb5, 0x7c, 0xdf, 0x78, 0x00, 0xcb, 0xa3,
+	0xfe, 0xc5, 0xc9, 0x48, 0x82, 0x3d, 0x7e, 0x35, 0x45, 0x47, 0xba, 0x8d, 0x6f, 0x79, 0x58, 0x68,
+	0x53, 0xac, 0x3c, 0x85, 0x13, 0x62, 0xc7, 0xf5, 0xf1, 0xcb, 0x26, 0x77, 0x45, 0xbb, 0x7b, 0x3d,
+	0x9e, 0x3d, 0xf2, 0x09, 0x2c, 0xf2, 0x3d, 0x5a, 0xbe, 0x92, 0x9f, 0xc0, 0xda, 0xea, 0xb5, 0x70,
+	0x76, 0x9a, 0x03, 0x27, 0xd3, 0xe1, 0xad, 0x5c, 0x29, 0x10, 0x04, 0xed, 0xde, 0x0d, 0x84, 0xec,
+	0xcc, 0x97, 0xb0, 0x74, 0x3e, 0x3c, 0xc6, 0x0d, 0xaa, 0x96, 0xef, 0x6b, 0xf5, 0x9b, 0x39, 0xf2,
+	0x70, 0x6d, 0xe2, 0x75, 0xf2, 0x4a, 0xb2, 0xed, 0xa3, 0x5f, 0x7a, 0xee, 0xe8, 0x44, 0x07, 0xc7,
+	0x27, 0x3a, 0xf8, 0x79, 0xa2, 0x83, 0xfd, 0x53, 0x3d, 0x77, 0x7c, 0xaa, 0xe7, 0xbe, 0x9e, 0xea,
+	0xb9, 0x17, 0x2b, 0xd8, 0x63, 0x3b, 0xfd, 0xae, 0xb9, 0x45, 0x82, 0xf4, 0xab, 0x60, 0x8d, 0x74,
+	0x76, 0x20, 0x5e, 0xda, 0xdd, 0x49, 0x3e, 0x2a, 0x0f, 0x7f, 0x0f, 0x00, 0x33, 0x80, 0x5e, 0x2d,
+	0x7b, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +442,9 @@ type MsgClient interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.CallOption) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all authorizations on the granter's account matching the
+	// provided filters in a single message.
+	RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error)
 }
 
 type msgClient struct {
@@ -381,6 +482,15 @@ func (c *msgClient) Revoke(ctx context.Context, in *MsgRevoke, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) RevokeAll(ctx context.Context, in *MsgRevokeAll, opts ...grpc.CallOption) (*MsgRevokeAllResponse, error) {
+	out := new(MsgRevokeAllResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.authz.v1beta1.Msg/RevokeAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Grant grants the provided authorization to the grantee on the granter's
@@ -395,6 +505,9 @@ type MsgServer interface {
 	// Revoke revokes any authorization corresponding to the provided method name on the
 	// granter's account that has been granted to the grantee.
 	Revoke(context.Context, *MsgRevoke) (*MsgRevokeResponse, error)
+	// RevokeAll revokes all authorizations on the granter's account matching the
+	// provided filters in a single message.
+	RevokeAll(context.Context, *MsgRevokeAll) (*MsgRevokeAllResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -410,6 +523,9 @@ func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExec
 func (*UnimplementedMsgServer) Revoke(ctx context.Context, req *MsgRevoke) (*MsgRevokeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Revoke not implemented")
 }
+func (*UnimplementedMsgServer) RevokeAll(ctx context.Context, req *MsgRevokeAll) (*MsgRevokeAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAll not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -469,6 +585,24 @@ func _Msg_Revoke_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RevokeAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAll)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.authz.v1beta1.Msg/RevokeAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAll(ctx, req.(*MsgRevokeAll))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.authz.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -485,6 +619,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Revoke",
 			Handler:    _Msg_Revoke_Handler,
 		},
+		{
+			MethodName: "RevokeAll",
+			Handler:    _Msg_RevokeAll_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/authz/v1beta1/tx.proto",
@@ -703,6 +841,88 @@ func (m *MsgRevokeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRevokeAll) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAll) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationBefore != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAllResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAllResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAllResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revoked))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -806,6 +1026,43 @@ func (m *MsgRevokeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRevokeAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationBefore)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAllResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked != 0 {
+		n += 1 + sovTx(uint64(m.Revoked))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1403,6 +1660,257 @@ func (m *MsgRevokeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRevokeAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationBefore == nil {
+				m.ExpirationBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeAllResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeAllResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			m.Revoked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revoked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,15 +11,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,7 +33,8 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SendAuthorization allows the grantee to spend up to spend_limit coins from
-// the granter's account.
+// the granter's account, optionally limited to period_spend_limit coins per
+// period and to max_per_tx coins per MsgSend.
 //
 // Since: cosmos-sdk 0.43
 type SendAuthorization struct {
@@ -38,6 +44,21 @@ type SendAuthorization struct {
 	//
 	// Since: cosmos-sdk 0.47
 	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList,proto3" json:"allow_list,omitempty"`
+	// period specifies the time duration in which period_spend_limit coins can
+	// be spent before the period_can_spend amount is reset. If omitted, the
+	// spending is only limited by spend_limit, which is required in that case.
+	Period *time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// period_spend_limit specifies the maximum number of coins that can be spent
+	// in the period.
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit,omitempty"`
+	// period_can_spend is the number of coins left to be spent before the period_reset time.
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend,omitempty"`
+	// period_reset is the time at which this period resets and a new one begins.
+	// It is set on the first spend after the previous period ended.
+	PeriodReset *time.Time `protobuf:"bytes,6,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset,omitempty"`
+	// max_per_tx specifies an optional maximum number of coins that can be
+	// spent by a single MsgSend.
+	MaxPerTx github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=max_per_tx,json=maxPerTx,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_per_tx,omitempty"`
 }
 
 func (m *SendAuthorization) Reset()         { *m = SendAuthorization{} }
@@ -87,6 +108,41 @@ func (m *SendAuthorization) GetAllowList() []string {
 	return nil
 }
 
+func (m *SendAuthorization) GetPeriod() *time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return nil
+}
+
+func (m *SendAuthorization) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *SendAuthorization) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *SendAuthorization) GetPeriodReset() *time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return nil
+}
+
+func (m *SendAuthorization) GetMaxPerTx() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxPerTx
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SendAuthorization)(nil), "cosmos.bank.v1beta1.SendAuthorization")
 }
@@ -94,30 +150,42 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/authz.proto", fileDescriptor_a4d2a37888ea779f) }
 
 var fileDescriptor_a4d2a37888ea779f = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0xcc, 0xcb, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10,
-	0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95, 0x84, 0xe8,
-	0x8e, 0x87, 0x48, 0x40, 0x8d, 0x82, 0x48, 0xc9, 0xc1, 0x6d, 0x2e, 0x4e, 0x85, 0xdb, 0x9c, 0x9c,
-	0x9f, 0x99, 0x07, 0x91, 0x57, 0x9a, 0xc5, 0xc4, 0x25, 0x18, 0x9c, 0x9a, 0x97, 0xe2, 0x58, 0x5a,
-	0x92, 0x91, 0x5f, 0x94, 0x59, 0x95, 0x58, 0x92, 0x99, 0x9f, 0x27, 0xd4, 0xc4, 0xc8, 0xc5, 0x5d,
-	0x5c, 0x90, 0x9a, 0x97, 0x12, 0x9f, 0x93, 0x99, 0x9b, 0x59, 0x22, 0xc1, 0xa8, 0xc0, 0xac, 0xc1,
-	0x6d, 0x24, 0xa9, 0x07, 0x77, 0x65, 0x71, 0x2a, 0xcc, 0x95, 0x7a, 0xce, 0xf9, 0x99, 0x79, 0x4e,
-	0x6e, 0x27, 0xee, 0xc9, 0x33, 0xac, 0xba, 0x2f, 0xaf, 0x91, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0x0b, 0x75, 0x07, 0x94, 0xd2, 0x2d, 0x4e, 0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48,
-	0x2d, 0x06, 0x6b, 0x28, 0x9e, 0xf5, 0x7c, 0x83, 0x16, 0x4f, 0x4e, 0x6a, 0x7a, 0x62, 0x72, 0x65,
-	0x3c, 0xc8, 0x39, 0xc5, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0xe2, 0x02, 0xdb, 0xea, 0x03, 0xb2,
-	0x54, 0xc8, 0x9c, 0x8b, 0x2b, 0x31, 0x27, 0x27, 0xbf, 0x3c, 0x3e, 0x27, 0xb3, 0xb8, 0x44, 0x82,
-	0x49, 0x81, 0x59, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x2b, 0x1c, 0x53, 0x52,
-	0x8a, 0x52, 0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x38, 0xc1, 0x6a, 0x7d, 0x32,
-	0x8b, 0x4b, 0xac, 0xdc, 0x4f, 0x6d, 0xd1, 0x55, 0x82, 0x2a, 0x82, 0x04, 0x32, 0xcc, 0xad, 0x28,
-	0xbe, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x06, 0xc9, 0x75, 0x18, 0xc1, 0xe0, 0xe4, 0x7c, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0x78, 0xfd, 0x59, 0x01, 0x89, 0x68, 0xb0,
-	0x77, 0x93, 0xd8, 0xc0, 0x01, 0x6d, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x31, 0x64, 0xff, 0x07,
-	0x04, 0x02, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0xd1, 0x90, 0xd2, 0x4b, 0x85, 0xa8, 0xc9, 0xe0, 0x46, 0x95, 0x1d, 0x75, 0x0a, 0x11,
+	0xb1, 0x55, 0x18, 0x90, 0xd8, 0x9a, 0x20, 0x58, 0x3a, 0xa0, 0xa4, 0x0b, 0x2c, 0xd6, 0x25, 0x3e,
+	0x9c, 0x53, 0x7d, 0x3e, 0xcb, 0x77, 0x01, 0xa7, 0x1b, 0x8c, 0x4c, 0x15, 0x13, 0x62, 0x66, 0x40,
+	0x9d, 0x32, 0xf4, 0x47, 0x54, 0x4c, 0x15, 0x13, 0x53, 0x8b, 0x92, 0x21, 0x12, 0xbf, 0x02, 0xf9,
+	0xee, 0x9c, 0x96, 0x46, 0x22, 0x03, 0x5d, 0xec, 0xf3, 0xbd, 0xef, 0x7b, 0xef, 0xfb, 0xde, 0xf3,
+	0x1d, 0xb4, 0xfb, 0x8c, 0x53, 0xc6, 0xdd, 0x1e, 0x8a, 0x0e, 0xdc, 0xb7, 0x3b, 0x3d, 0x2c, 0xd0,
+	0x8e, 0x8b, 0x86, 0x62, 0x70, 0xe8, 0xc4, 0x09, 0x13, 0xcc, 0xb8, 0xaf, 0x00, 0x4e, 0x06, 0x70,
+	0x34, 0xa0, 0xba, 0x81, 0x28, 0x89, 0x98, 0x2b, 0x9f, 0x0a, 0x57, 0xad, 0x04, 0x2c, 0x60, 0x72,
+	0xe9, 0x66, 0x2b, 0xbd, 0xbb, 0xa9, 0xd8, 0x9e, 0x0a, 0xe8, 0x54, 0x2a, 0x64, 0xcd, 0x2b, 0x73,
+	0x3c, 0xaf, 0xdc, 0x67, 0x24, 0xca, 0xe3, 0x01, 0x63, 0x41, 0x88, 0x5d, 0xf9, 0xd5, 0x1b, 0xbe,
+	0x71, 0xfd, 0x61, 0x82, 0x04, 0x61, 0x79, 0xdc, 0xbe, 0x1e, 0x17, 0x84, 0x62, 0x2e, 0x10, 0x8d,
+	0x15, 0x60, 0xfb, 0xfd, 0x2a, 0xdc, 0xe8, 0xe2, 0xc8, 0xdf, 0x1d, 0x8a, 0x01, 0x4b, 0xc8, 0xa1,
+	0x24, 0x1b, 0x1f, 0x00, 0x2c, 0xf3, 0x18, 0x47, 0xbe, 0x17, 0x12, 0x4a, 0x84, 0x09, 0x6a, 0x2b,
+	0xf5, 0xf2, 0xa3, 0x4d, 0x67, 0x6e, 0x93, 0xe3, 0xdc, 0xa6, 0xd3, 0x66, 0x24, 0x6a, 0x3d, 0x3f,
+	0x3d, 0xb7, 0x0b, 0xc7, 0x17, 0x76, 0x3d, 0x20, 0x62, 0x30, 0xec, 0x39, 0x7d, 0x46, 0xb5, 0x11,
+	0xfd, 0x6a, 0x72, 0xff, 0xc0, 0x15, 0xa3, 0x18, 0x73, 0x49, 0xe0, 0x5f, 0x66, 0xe3, 0xc6, 0x7a,
+	0x88, 0x03, 0xd4, 0x1f, 0x79, 0x99, 0x1f, 0xfe, 0x6d, 0x36, 0x6e, 0x80, 0x0e, 0x94, 0x55, 0xf7,
+	0xb2, 0xa2, 0xc6, 0x13, 0x08, 0x51, 0x18, 0xb2, 0x77, 0x5e, 0x48, 0xb8, 0x30, 0x6f, 0xd5, 0x56,
+	0xea, 0x6b, 0x2d, 0xf3, 0xc7, 0x49, 0xb3, 0xa2, 0x55, 0xec, 0xfa, 0x7e, 0x82, 0x39, 0xef, 0x8a,
+	0x84, 0x44, 0x41, 0x67, 0x4d, 0x62, 0xf7, 0x08, 0xcf, 0x88, 0xa5, 0x18, 0x27, 0x84, 0xf9, 0xe6,
+	0x4a, 0x0d, 0x48, 0xdd, 0xaa, 0x0b, 0x4e, 0xde, 0x05, 0xe7, 0x99, 0xee, 0x52, 0xab, 0xf8, 0xf9,
+	0xc2, 0x06, 0x1d, 0x0d, 0x37, 0x8e, 0x01, 0x34, 0xd4, 0xd2, 0xbb, 0xea, 0xbe, 0xb8, 0xcc, 0x3d,
+	0xca, 0xdc, 0xff, 0x3e, 0xb7, 0xb7, 0x16, 0xc9, 0x0f, 0x19, 0x25, 0x02, 0xd3, 0x58, 0x8c, 0xfe,
+	0xab, 0x3b, 0x9d, 0x7b, 0x2a, 0x75, 0xf7, 0xb2, 0x3d, 0x5f, 0x01, 0xd4, 0x9b, 0x5e, 0x1f, 0x45,
+	0xaa, 0xa6, 0x79, 0x7b, 0x99, 0x54, 0x4f, 0x4b, 0xad, 0x5e, 0xa7, 0xde, 0x94, 0xd0, 0xbb, 0x2a,
+	0x71, 0x1b, 0x45, 0x52, 0xab, 0xd1, 0x86, 0xeb, 0xba, 0x54, 0x82, 0x39, 0x16, 0x66, 0x49, 0x8e,
+	0xa4, 0xba, 0x30, 0x92, 0xfd, 0xfc, 0xc7, 0x6c, 0x15, 0x8f, 0xb2, 0x99, 0x94, 0x15, 0xab, 0x93,
+	0x91, 0x8c, 0x4f, 0x00, 0x42, 0x8a, 0x52, 0x2f, 0xc6, 0x89, 0x27, 0x52, 0x73, 0x75, 0x99, 0xcb,
+	0x57, 0xda, 0x65, 0xe5, 0x92, 0x74, 0x53, 0xfe, 0xee, 0x50, 0x94, 0xbe, 0xc4, 0xc9, 0x7e, 0xfa,
+	0xf4, 0xc5, 0xf7, 0x93, 0xe6, 0xb6, 0x96, 0xa0, 0x2e, 0x83, 0x5c, 0xc3, 0x5f, 0x87, 0xe9, 0xe3,
+	0x6c, 0xdc, 0xd8, 0xba, 0x92, 0x7d, 0xe1, 0xb4, 0xb5, 0xda, 0xa7, 0x13, 0x0b, 0x9c, 0x4d, 0x2c,
+	0xf0, 0x6b, 0x62, 0x81, 0xa3, 0xa9, 0x55, 0x38, 0x9b, 0x5a, 0x85, 0x9f, 0x53, 0xab, 0xf0, 0xfa,
+	0xc1, 0x3f, 0x75, 0xa6, 0xea, 0x42, 0x92, 0x72, 0x7b, 0x25, 0xd9, 0xc9, 0xc7, 0x7f, 0x06, 0x00,
+	0xd6, 0x40, 0x32, 0xfa, 0xac, 0x04, 0x00, 0x00,
 }
 
 func (m *SendAuthorization) Marshal() (dAtA []byte, err error) {
@@ -140,6 +208,68 @@ func (m *SendAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxPerTx) > 0 {
+		for iNdEx := len(m.MaxPerTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxPerTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PeriodReset != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Period != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AllowList) > 0 {
 		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowList[iNdEx])
@@ -195,6 +325,32 @@ func (m *SendAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if m.Period != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.PeriodReset != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PeriodReset)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.MaxPerTx) > 0 {
+		for _, e := range m.MaxPerTx {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -299,6 +455,180 @@ func (m *SendAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Period == nil {
+				m.Period = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodReset == nil {
+				m.PeriodReset = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPerTx = append(m.MaxPerTx, types.Coin{})
+			if err := m.MaxPerTx[len(m.MaxPerTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/authz"
@@ -21,6 +22,20 @@ func NewSendAuthorization(spendLimit sdk.Coins, allowed []sdk.AccAddress) *SendA
 	}
 }
 
+// NewPeriodicSendAuthorization creates a new SendAuthorization object allowing
+// to spend up to periodSpendLimit coins per period. spendLimit optionally caps
+// the total amount of coins spent and maxPerTx the amount spent by a MsgSend.
+func NewPeriodicSendAuthorization(spendLimit, periodSpendLimit sdk.Coins, period time.Duration, maxPerTx sdk.Coins, allowed []sdk.AccAddress) *SendAuthorization {
+	return &SendAuthorization{
+		AllowList:        toBech32Addresses(allowed),
+		SpendLimit:       spendLimit,
+		Period:           &period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		MaxPerTx:         maxPerTx,
+	}
+}
+
 // MsgTypeURL implements Authorization.MsgTypeURL.
 func (a SendAuthorization) MsgTypeURL() string {
 	return sdk.MsgTypeURL(&MsgSend{})
@@ -33,15 +48,35 @@ func (a SendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Accep
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
 	}
 
-	limitLeft, isNegative := a.SpendLimit.SafeSub(mSend.Amount...)
-	if isNegative {
-		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+	if !a.MaxPerTx.Empty() && !mSend.Amount.IsAllLTE(a.MaxPerTx) {
+		return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than max per tx")
+	}
+
+	// the spend limit is optional for periodic authorizations
+	limitLeft := a.SpendLimit
+	if !a.SpendLimit.Empty() || a.Period == nil {
+		var isNegative bool
+		limitLeft, isNegative = a.SpendLimit.SafeSub(mSend.Amount...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than spend limit")
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	periodCanSpend, periodReset := a.PeriodCanSpend, a.PeriodReset
+	if a.Period != nil {
+		periodCanSpend, periodReset = a.tryResetPeriod(sdkCtx.HeaderInfo().Time)
+
+		var isNegative bool
+		periodCanSpend, isNegative = periodCanSpend.SafeSub(mSend.Amount...)
+		if isNegative {
+			return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("requested amount is more than period spend limit")
+		}
 	}
 
 	isAddrExists := false
 	toAddr := mSend.ToAddress
 	allowedList := a.GetAllowList()
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	for _, addr := range allowedList {
 		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "send authorization")
 		if addr == toAddr {
@@ -54,22 +89,59 @@ func (a SendAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Accep
 		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot send to %s address", toAddr)
 	}
 
-	if limitLeft.IsZero() {
+	if !a.SpendLimit.Empty() && limitLeft.IsZero() {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
 
-	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{SpendLimit: limitLeft, AllowList: allowedList}}, nil
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &SendAuthorization{
+		SpendLimit:       limitLeft,
+		AllowList:        allowedList,
+		Period:           a.Period,
+		PeriodSpendLimit: a.PeriodSpendLimit,
+		PeriodCanSpend:   periodCanSpend,
+		PeriodReset:      periodReset,
+		MaxPerTx:         a.MaxPerTx,
+	}}, nil
+}
+
+// tryResetPeriod returns the coins left to spend in the period and the period
+// reset time at blockTime. Once PeriodReset has been hit, PeriodCanSpend is
+// topped up to PeriodSpendLimit and PeriodReset is stepped by one Period, or
+// set to one Period from blockTime if more than one Period passed, as done by
+// feegrant's PeriodicAllowance.
+func (a SendAuthorization) tryResetPeriod(blockTime time.Time) (sdk.Coins, *time.Time) {
+	if a.PeriodReset != nil && blockTime.Before(*a.PeriodReset) {
+		return a.PeriodCanSpend, a.PeriodReset
+	}
+
+	var periodReset time.Time
+	if a.PeriodReset != nil {
+		periodReset = a.PeriodReset.Add(*a.Period)
+	}
+	if blockTime.After(periodReset) {
+		periodReset = blockTime.Add(*a.Period)
+	}
+
+	return a.PeriodSpendLimit, &periodReset
 }
 
 // ValidateBasic implements Authorization.ValidateBasic.
 func (a SendAuthorization) ValidateBasic() error {
-	if len(a.SpendLimit) == 0 {
+	if len(a.SpendLimit) == 0 && a.Period == nil {
 		return sdkerrors.ErrInvalidCoins.Wrap("spend limit cannot be nil")
 	}
-	if !a.SpendLimit.IsAllPositive() {
+	if len(a.SpendLimit) > 0 && !a.SpendLimit.IsAllPositive() {
 		return sdkerrors.ErrInvalidCoins.Wrapf("spend limit must be positive")
 	}
 
+	if err := a.validatePeriod(); err != nil {
+		return err
+	}
+
+	if !a.MaxPerTx.Empty() && (!a.MaxPerTx.IsValid() || !a.MaxPerTx.IsAllPositive()) {
+		return sdkerrors.ErrInvalidCoins.Wrapf("max per tx must be positive: %s", a.MaxPerTx)
+	}
+
 	found := make(map[string]bool, 0)
 	for i := 0; i < len(a.AllowList); i++ {
 		if found[a.AllowList[i]] {
@@ -82,6 +154,29 @@ func (a SendAuthorization) ValidateBasic() error {
 	return nil
 }
 
+// validatePeriod checks the periodic spend limit fields.
+func (a SendAuthorization) validatePeriod() error {
+	if a.Period == nil {
+		if !a.PeriodSpendLimit.Empty() || !a.PeriodCanSpend.Empty() || a.PeriodReset != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("period spend limit requires a period")
+		}
+		return nil
+	}
+
+	if *a.Period <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("period must be positive")
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsAllPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period spend limit must be positive: %s", a.PeriodSpendLimit)
+	}
+	// We allow 0 for `PeriodCanSpend`
+	if !a.PeriodCanSpend.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("period can spend amount is invalid: %s", a.PeriodCanSpend)
+	}
+
+	return nil
+}
+
 func toBech32Addresses(allowed []sdk.AccAddress) []string {
 	if len(allowed) == 0 {
 		return nil
@@ -3,6 +3,7 @@ package types_test
 import (
 	fmt "fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -109,3 +111,60 @@ func TestSendAuthorization(t *testing.T) {
 	require.True(t, resp.Accept)
 	require.Nil(t, resp.Updated)
 }
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(types.StoreKey), storetypes.NewTransientStoreKey("transient_test")).Ctx.WithHeaderInfo(header.Info{Time: now})
+	coins100 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(100)))
+	coins200 := sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(200)))
+	week := 7 * 24 * time.Hour
+
+	t.Log("verify validate basic")
+	require.NoError(t, types.NewPeriodicSendAuthorization(nil, coins500, week, nil, nil).ValidateBasic())
+	require.ErrorContains(t, types.NewPeriodicSendAuthorization(nil, nil, week, nil, nil).ValidateBasic(), "period spend limit must be positive")
+	require.ErrorContains(t, types.NewPeriodicSendAuthorization(nil, coins500, 0, nil, nil).ValidateBasic(), "period must be positive")
+	require.ErrorContains(t, (&types.SendAuthorization{SpendLimit: coins1000, PeriodSpendLimit: coins500}).ValidateBasic(), "period spend limit requires a period")
+	require.ErrorContains(t, types.NewPeriodicSendAuthorization(nil, coins500, week, sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdkmath.ZeroInt()}}, nil).ValidateBasic(), "max per tx must be positive")
+
+	t.Log("verify period spend limit and max per tx are enforced")
+	authorization := types.NewPeriodicSendAuthorization(nil, coins500, week, coins200, nil)
+	_, err := authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.ErrorContains(t, err, "requested amount is more than max per tx")
+
+	var resp authz.AcceptResponse
+	for i := 0; i < 2; i++ {
+		resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins200))
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		require.False(t, resp.Delete)
+		authorization = resp.Updated.(*types.SendAuthorization)
+	}
+	require.Equal(t, coins100, authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(week), *authorization.PeriodReset)
+
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins200))
+	require.ErrorContains(t, err, "requested amount is more than period spend limit")
+
+	t.Log("verify the period spend limit is reset after the period")
+	resp, err = authorization.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(week)}), types.NewMsgSend(fromAddr, toAddr, coins200))
+	require.NoError(t, err)
+	authorization = resp.Updated.(*types.SendAuthorization)
+	require.Equal(t, coins500.Sub(coins200...), authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(2*week), *authorization.PeriodReset)
+
+	t.Log("verify the period reset restarts after an idle period")
+	resp, err = authorization.Accept(ctx.WithHeaderInfo(header.Info{Time: now.Add(5 * week)}), types.NewMsgSend(fromAddr, toAddr, coins100))
+	require.NoError(t, err)
+	authorization = resp.Updated.(*types.SendAuthorization)
+	require.Equal(t, coins500.Sub(coins100...), authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(6*week), *authorization.PeriodReset)
+
+	t.Log("verify the total spend limit still applies")
+	authorization = types.NewPeriodicSendAuthorization(coins500, coins1000, week, nil, nil)
+	_, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins1000))
+	require.ErrorContains(t, err, "requested amount is more than spend limit")
+
+	resp, err = authorization.Accept(ctx, types.NewMsgSend(fromAddr, toAddr, coins500))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+}
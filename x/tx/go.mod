@@ -34,3 +34,6 @@ require (
 	google.golang.org/grpc v1.59.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// use the local api until the fields added to the protos since v0.7.2 are released
+replace cosmossdk.io/api => ../../api
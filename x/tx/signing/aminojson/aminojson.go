@@ -95,6 +95,7 @@ func (h SignModeHandler) GetSignBytes(_ context.Context, signerData signing.Sign
 		Memo:          body.Memo,
 		Msgs:          txData.Body.Messages,
 		Fee:           fee,
+		Unordered:     body.Unordered,
 	}
 
 	return h.encoder.Marshal(signDoc)
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/reflect/protoregistry"
 
+	authv1beta1 "cosmossdk.io/api/cosmos/auth/v1beta1"
 	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
 	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
@@ -79,6 +80,35 @@ func TestAminoJsonSignMode(t *testing.T) {
 	}
 }
 
+func TestAminoJsonSignMode_MsgUpdateParams(t *testing.T) {
+	signerData, txData, err := testutil.MakeHandlerArguments(testutil.HandlerArgumentOptions{
+		ChainID: "foo",
+		Memo:    "memo",
+		Msg: &authv1beta1.MsgUpdateParams{
+			Authority: "cosmos1abc",
+			Params: &authv1beta1.Params{
+				MaxMemoCharacters: 256,
+				FeeMarket: &authv1beta1.FeeMarketParams{
+					Denom:                    "stake",
+					MinBaseFee:               "10000000000000000",
+					TargetBlockGas:           1000,
+					BaseFeeChangeDenominator: 8,
+				},
+			},
+		},
+		AccNum:        1,
+		AccSeq:        1,
+		SignerAddress: "signerAddress",
+		Fee:           &txv1beta1.Fee{},
+	})
+	require.NoError(t, err)
+
+	handler := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{})
+	signBytes, err := handler.GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), `{"type":"cosmos-sdk/x/auth/MsgUpdateParams","value":{"authority":"cosmos1abc","params":{"fee_market":{"base_fee_change_denominator":"8","denom":"stake",`)
+}
+
 func TestNewSignModeHandler(t *testing.T) {
 	handler := aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{})
 	require.NotNil(t, handler)
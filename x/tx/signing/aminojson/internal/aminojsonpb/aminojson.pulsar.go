@@ -745,6 +745,7 @@ var (
 	fd_AminoSignDoc_memo           protoreflect.FieldDescriptor
 	fd_AminoSignDoc_fee            protoreflect.FieldDescriptor
 	fd_AminoSignDoc_msgs           protoreflect.FieldDescriptor
+	fd_AminoSignDoc_unordered      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -757,6 +758,7 @@ func init() {
 	fd_AminoSignDoc_memo = md_AminoSignDoc.Fields().ByName("memo")
 	fd_AminoSignDoc_fee = md_AminoSignDoc.Fields().ByName("fee")
 	fd_AminoSignDoc_msgs = md_AminoSignDoc.Fields().ByName("msgs")
+	fd_AminoSignDoc_unordered = md_AminoSignDoc.Fields().ByName("unordered")
 }
 
 var _ protoreflect.Message = (*fastReflection_AminoSignDoc)(nil)
@@ -866,6 +868,12 @@ func (x *fastReflection_AminoSignDoc) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.Unordered != false {
+		value := protoreflect.ValueOfBool(x.Unordered)
+		if !f(fd_AminoSignDoc_unordered, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -895,6 +903,8 @@ func (x *fastReflection_AminoSignDoc) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Fee != nil
 	case "AminoSignDoc.msgs":
 		return len(x.Msgs) != 0
+	case "AminoSignDoc.unordered":
+		return x.Unordered != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -925,6 +935,8 @@ func (x *fastReflection_AminoSignDoc) Clear(fd protoreflect.FieldDescriptor) {
 		x.Fee = nil
 	case "AminoSignDoc.msgs":
 		x.Msgs = nil
+	case "AminoSignDoc.unordered":
+		x.Unordered = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -965,6 +977,9 @@ func (x *fastReflection_AminoSignDoc) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_AminoSignDoc_7_list{list: &x.Msgs}
 		return protoreflect.ValueOfList(listValue)
+	case "AminoSignDoc.unordered":
+		value := x.Unordered
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -1001,6 +1016,8 @@ func (x *fastReflection_AminoSignDoc) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_AminoSignDoc_7_list)
 		x.Msgs = *clv.list
+	case "AminoSignDoc.unordered":
+		x.Unordered = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -1042,6 +1059,8 @@ func (x *fastReflection_AminoSignDoc) Mutable(fd protoreflect.FieldDescriptor) p
 		panic(fmt.Errorf("field chain_id of message AminoSignDoc is not mutable"))
 	case "AminoSignDoc.memo":
 		panic(fmt.Errorf("field memo of message AminoSignDoc is not mutable"))
+	case "AminoSignDoc.unordered":
+		panic(fmt.Errorf("field unordered of message AminoSignDoc is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -1071,6 +1090,8 @@ func (x *fastReflection_AminoSignDoc) NewField(fd protoreflect.FieldDescriptor)
 	case "AminoSignDoc.msgs":
 		list := []*anypb.Any{}
 		return protoreflect.ValueOfList(&_AminoSignDoc_7_list{list: &list})
+	case "AminoSignDoc.unordered":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: AminoSignDoc"))
@@ -1167,6 +1188,9 @@ func (x *fastReflection_AminoSignDoc) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.Unordered {
+			n += 2
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1196,6 +1220,16 @@ func (x *fastReflection_AminoSignDoc) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Unordered {
+			i--
+			if x.Unordered {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.Msgs) > 0 {
 			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Msgs[iNdEx])
@@ -1495,6 +1529,26 @@ func (x *fastReflection_AminoSignDoc) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unordered", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Unordered = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1620,6 +1674,7 @@ type AminoSignDoc struct {
 	Memo          string        `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 	Fee           *AminoSignFee `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
 	Msgs          []*anypb.Any  `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Unordered     bool          `protobuf:"varint,8,opt,name=unordered,proto3" json:"unordered,omitempty"`
 }
 
 func (x *AminoSignDoc) Reset() {
@@ -1691,6 +1746,13 @@ func (x *AminoSignDoc) GetMsgs() []*anypb.Any {
 	return nil
 }
 
+func (x *AminoSignDoc) GetUnordered() bool {
+	if x != nil {
+		return x.Unordered
+	}
+	return false
+}
+
 var File_aminojsonpb_aminojson_proto protoreflect.FileDescriptor
 
 var file_aminojsonpb_aminojson_proto_rawDesc = []byte{
@@ -1711,7 +1773,7 @@ var file_aminojsonpb_aminojson_proto_rawDesc = []byte{
 	0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x70, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65,
 	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x72,
-	0x22, 0x9c, 0x02, 0x0a, 0x0c, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x44, 0x6f,
+	0x22, 0xba, 0x02, 0x0a, 0x0c, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x53, 0x69, 0x67, 0x6e, 0x44, 0x6f,
 	0x63, 0x12, 0x2c, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
 	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
 	0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
@@ -1728,13 +1790,18 @@ var file_aminojsonpb_aminojson_proto_rawDesc = []byte{
 	0x65, 0x65, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x2f,
 	0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41,
-	0x6e, 0x79, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x42,
-	0x4b, 0x42, 0x0e, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x37, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x78, 0x2f, 0x74, 0x78, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x62,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x79, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x42, 0x7b, 0x0a,
+	0x04, 0x63, 0x6f, 0x6d, 0x2e, 0x42, 0x0e, 0x41, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x43, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f, 0x74, 0x78, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x62, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x70, 0x62,
+	0x3b, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x70, 0x62, 0xa2, 0x02, 0x03, 0x58,
+	0x58, 0x58, 0xaa, 0x02, 0x00, 0xca, 0x02, 0x00, 0xe2, 0x02, 0x0c, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
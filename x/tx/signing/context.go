@@ -3,6 +3,7 @@ package signing
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	cosmos_proto "github.com/cosmos/cosmos-proto"
 	"google.golang.org/protobuf/proto"
@@ -28,7 +29,7 @@ type Context struct {
 	typeResolver          protoregistry.MessageTypeResolver
 	addressCodec          address.Codec
 	validatorAddressCodec address.Codec
-	getSignersFuncs       map[protoreflect.FullName]GetSignersFunc
+	getSignersFuncs       sync.Map
 	customGetSignerFuncs  map[protoreflect.FullName]GetSignersFunc
 }
 
@@ -100,7 +101,7 @@ func NewContext(options Options) (*Context, error) {
 		typeResolver:          protoTypes,
 		addressCodec:          options.AddressCodec,
 		validatorAddressCodec: options.ValidatorAddressCodec,
-		getSignersFuncs:       map[protoreflect.FullName]GetSignersFunc{},
+		getSignersFuncs:       sync.Map{},
 		customGetSignerFuncs:  customGetSignerFuncs,
 	}
 
@@ -302,6 +303,7 @@ func (c *Context) makeGetSignersFunc(descriptor protoreflect.MessageDescriptor)
 	return func(message proto.Message) ([][]byte, error) {
 		var signers [][]byte
 		for _, getter := range fieldGetters {
+			var err error
 			signers, err = getter(message, signers)
 			if err != nil {
 				return nil, err
@@ -328,15 +330,16 @@ func (c *Context) getGetSignersFn(messageDescriptor protoreflect.MessageDescript
 	if ok {
 		return f, nil
 	}
-	f, ok = c.getSignersFuncs[messageDescriptor.FullName()]
-	if !ok {
-		var err error
-		f, err = c.makeGetSignersFunc(messageDescriptor)
-		if err != nil {
-			return nil, err
-		}
-		c.getSignersFuncs[messageDescriptor.FullName()] = f
+	loadedFn, ok := c.getSignersFuncs.Load(messageDescriptor.FullName())
+	if ok {
+		return loadedFn.(GetSignersFunc), nil
+	}
+
+	f, err := c.makeGetSignersFunc(messageDescriptor)
+	if err != nil {
+		return nil, err
 	}
+	c.getSignersFuncs.Store(messageDescriptor.FullName(), f)
 
 	return f, nil
 }
@@ -3,8 +3,10 @@ package signing
 import (
 	"encoding/hex"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
@@ -145,6 +147,40 @@ func TestGetSigners(t *testing.T) {
 	}
 }
 
+func TestGetSigners_Concurrent(t *testing.T) {
+	ctx, err := NewContext(Options{
+		AddressCodec:          dummyAddressCodec{},
+		ValidatorAddressCodec: dummyValidatorAddressCodec{},
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// every other msg has an invalid signer, which must only fail its own
+			// lookup
+			valid := i%2 == 0
+			signer := hex.EncodeToString([]byte("foo"))
+			if !valid {
+				signer = "not hex"
+			}
+
+			signers, err := ctx.GetSigners(&bankv1beta1.MsgSend{FromAddress: signer})
+			if valid {
+				assert.NoError(t, err)
+				assert.Equal(t, [][]byte{[]byte("foo")}, signers)
+			} else {
+				assert.Error(t, err)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
+
 func TestDefineCustomGetSigners(t *testing.T) {
 	customMsg := &testpb.Ballot{}
 	signers := [][]byte{[]byte("foo")}